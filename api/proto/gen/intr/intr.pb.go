@@ -20,17 +20,23 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetByIdsRequest struct {
+type Collection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Biz string  `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	Ids []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 0 是默认收藏夹
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid     int64  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Public  bool   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	ItemCnt int64  `protobuf:"varint,5,opt,name=item_cnt,json=itemCnt,proto3" json:"item_cnt,omitempty"`
+	Ctime   int64  `protobuf:"varint,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime   int64  `protobuf:"varint,7,opt,name=utime,proto3" json:"utime,omitempty"`
 }
 
-func (x *GetByIdsRequest) Reset() {
-	*x = GetByIdsRequest{}
+func (x *Collection) Reset() {
+	*x = Collection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -38,13 +44,13 @@ func (x *GetByIdsRequest) Reset() {
 	}
 }
 
-func (x *GetByIdsRequest) String() string {
+func (x *Collection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdsRequest) ProtoMessage() {}
+func (*Collection) ProtoMessage() {}
 
-func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
+func (x *Collection) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,35 +62,74 @@ func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdsRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetByIdsRequest) GetBiz() string {
+func (x *Collection) GetId() int64 {
 	if x != nil {
-		return x.Biz
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetByIdsRequest) GetIds() []int64 {
+func (x *Collection) GetPublic() bool {
 	if x != nil {
-		return x.Ids
+		return x.Public
 	}
-	return nil
+	return false
 }
 
-type GetByIdsResponse struct {
+func (x *Collection) GetItemCnt() int64 {
+	if x != nil {
+		return x.ItemCnt
+	}
+	return 0
+}
+
+func (x *Collection) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Collection) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+type CollectionItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Intrs map[int64]*Interactive `protobuf:"bytes,1,rep,name=intrs,proto3" json:"intrs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cid   int64  `protobuf:"varint,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Uid   int64  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Biz   string `protobuf:"bytes,3,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,4,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Ctime int64  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
 }
 
-func (x *GetByIdsResponse) Reset() {
-	*x = GetByIdsResponse{}
+func (x *CollectionItem) Reset() {
+	*x = CollectionItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -92,13 +137,13 @@ func (x *GetByIdsResponse) Reset() {
 	}
 }
 
-func (x *GetByIdsResponse) String() string {
+func (x *CollectionItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdsResponse) ProtoMessage() {}
+func (*CollectionItem) ProtoMessage() {}
 
-func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectionItem) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -110,30 +155,59 @@ func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdsResponse.ProtoReflect.Descriptor instead.
-func (*GetByIdsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionItem.ProtoReflect.Descriptor instead.
+func (*CollectionItem) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetByIdsResponse) GetIntrs() map[int64]*Interactive {
+func (x *CollectionItem) GetCid() int64 {
 	if x != nil {
-		return x.Intrs
+		return x.Cid
 	}
-	return nil
+	return 0
 }
 
-type GetRequest struct {
+func (x *CollectionItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type LikeRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid   int64  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Biz   string `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,3,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Utime int64  `protobuf:"varint,4,opt,name=utime,proto3" json:"utime,omitempty"`
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
+func (x *LikeRecord) Reset() {
+	*x = LikeRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -141,13 +215,13 @@ func (x *GetRequest) Reset() {
 	}
 }
 
-func (x *GetRequest) String() string {
+func (x *LikeRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequest) ProtoMessage() {}
+func (*LikeRecord) ProtoMessage() {}
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
+func (x *LikeRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,48 +233,53 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikeRecord.ProtoReflect.Descriptor instead.
+func (*LikeRecord) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetRequest) GetBiz() string {
+func (x *LikeRecord) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *LikeRecord) GetBiz() string {
 	if x != nil {
 		return x.Biz
 	}
 	return ""
 }
 
-func (x *GetRequest) GetBizId() int64 {
+func (x *LikeRecord) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *GetRequest) GetUid() int64 {
+func (x *LikeRecord) GetUtime() int64 {
 	if x != nil {
-		return x.Uid
+		return x.Utime
 	}
 	return 0
 }
 
-type Interactive struct {
+type ListLikedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Biz        string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId      int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	ReadCnt    int64  `protobuf:"varint,3,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
-	LikeCnt    int64  `protobuf:"varint,4,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
-	CollectCnt int64  `protobuf:"varint,5,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
-	Liked      bool   `protobuf:"varint,6,opt,name=liked,proto3" json:"liked,omitempty"`
-	Collected  bool   `protobuf:"varint,7,opt,name=collected,proto3" json:"collected,omitempty"`
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 为空的时候不区分业务
+	Biz    string `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Interactive) Reset() {
-	*x = Interactive{}
+func (x *ListLikedRequest) Reset() {
+	*x = ListLikedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -208,13 +287,13 @@ func (x *Interactive) Reset() {
 	}
 }
 
-func (x *Interactive) String() string {
+func (x *ListLikedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interactive) ProtoMessage() {}
+func (*ListLikedRequest) ProtoMessage() {}
 
-func (x *Interactive) ProtoReflect() protoreflect.Message {
+func (x *ListLikedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,70 +305,49 @@ func (x *Interactive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interactive.ProtoReflect.Descriptor instead.
-func (*Interactive) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLikedRequest.ProtoReflect.Descriptor instead.
+func (*ListLikedRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Interactive) GetBiz() string {
-	if x != nil {
-		return x.Biz
-	}
-	return ""
-}
-
-func (x *Interactive) GetBizId() int64 {
+func (x *ListLikedRequest) GetUid() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Uid
 	}
 	return 0
 }
 
-func (x *Interactive) GetReadCnt() int64 {
+func (x *ListLikedRequest) GetBiz() string {
 	if x != nil {
-		return x.ReadCnt
+		return x.Biz
 	}
-	return 0
+	return ""
 }
 
-func (x *Interactive) GetLikeCnt() int64 {
+func (x *ListLikedRequest) GetOffset() int32 {
 	if x != nil {
-		return x.LikeCnt
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Interactive) GetCollectCnt() int64 {
+func (x *ListLikedRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CollectCnt
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Interactive) GetLiked() bool {
-	if x != nil {
-		return x.Liked
-	}
-	return false
-}
-
-func (x *Interactive) GetCollected() bool {
-	if x != nil {
-		return x.Collected
-	}
-	return false
-}
-
-type GetResponse struct {
+type ListLikedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Intr *Interactive `protobuf:"bytes,1,opt,name=intr,proto3" json:"intr,omitempty"`
+	Likes []*LikeRecord `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes,omitempty"`
 }
 
-func (x *GetResponse) Reset() {
-	*x = GetResponse{}
+func (x *ListLikedResponse) Reset() {
+	*x = ListLikedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,13 +355,13 @@ func (x *GetResponse) Reset() {
 	}
 }
 
-func (x *GetResponse) String() string {
+func (x *ListLikedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResponse) ProtoMessage() {}
+func (*ListLikedResponse) ProtoMessage() {}
 
-func (x *GetResponse) ProtoReflect() protoreflect.Message {
+func (x *ListLikedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -315,31 +373,32 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
-func (*GetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLikedResponse.ProtoReflect.Descriptor instead.
+func (*ListLikedResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetResponse) GetIntr() *Interactive {
+func (x *ListLikedResponse) GetLikes() []*LikeRecord {
 	if x != nil {
-		return x.Intr
+		return x.Likes
 	}
 	return nil
 }
 
-type CollectRequest struct {
+type ListCollectedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Cid   int64  `protobuf:"varint,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	Uid   int64  `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 为空的时候不区分业务
+	Biz    string `protobuf:"bytes,2,opt,name=biz,proto3" json:"biz,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CollectRequest) Reset() {
-	*x = CollectRequest{}
+func (x *ListCollectedRequest) Reset() {
+	*x = ListCollectedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,13 +406,13 @@ func (x *CollectRequest) Reset() {
 	}
 }
 
-func (x *CollectRequest) String() string {
+func (x *ListCollectedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectRequest) ProtoMessage() {}
+func (*ListCollectedRequest) ProtoMessage() {}
 
-func (x *CollectRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCollectedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,47 +424,49 @@ func (x *CollectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
-func (*CollectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectedRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectedRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CollectRequest) GetBiz() string {
+func (x *ListCollectedRequest) GetUid() int64 {
 	if x != nil {
-		return x.Biz
+		return x.Uid
 	}
-	return ""
+	return 0
 }
 
-func (x *CollectRequest) GetBizId() int64 {
+func (x *ListCollectedRequest) GetBiz() string {
 	if x != nil {
-		return x.BizId
+		return x.Biz
 	}
-	return 0
+	return ""
 }
 
-func (x *CollectRequest) GetCid() int64 {
+func (x *ListCollectedRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Cid
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *CollectRequest) GetUid() int64 {
+func (x *ListCollectedRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Uid
+		return x.Limit
 	}
 	return 0
 }
 
-type CollectResponse struct {
+type ListCollectedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Items []*CollectionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *CollectResponse) Reset() {
-	*x = CollectResponse{}
+func (x *ListCollectedResponse) Reset() {
+	*x = ListCollectedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -413,13 +474,13 @@ func (x *CollectResponse) Reset() {
 	}
 }
 
-func (x *CollectResponse) String() string {
+func (x *ListCollectedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectResponse) ProtoMessage() {}
+func (*ListCollectedResponse) ProtoMessage() {}
 
-func (x *CollectResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCollectedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,12 +492,19 @@ func (x *CollectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
-func (*CollectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCollectedResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectedResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{6}
 }
 
-type CancelLikeRequest struct {
+func (x *ListCollectedResponse) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UncollectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -446,8 +514,8 @@ type CancelLikeRequest struct {
 	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *CancelLikeRequest) Reset() {
-	*x = CancelLikeRequest{}
+func (x *UncollectRequest) Reset() {
+	*x = UncollectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -455,13 +523,13 @@ func (x *CancelLikeRequest) Reset() {
 	}
 }
 
-func (x *CancelLikeRequest) String() string {
+func (x *UncollectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLikeRequest) ProtoMessage() {}
+func (*UncollectRequest) ProtoMessage() {}
 
-func (x *CancelLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *UncollectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,40 +541,40 @@ func (x *CancelLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLikeRequest.ProtoReflect.Descriptor instead.
-func (*CancelLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncollectRequest.ProtoReflect.Descriptor instead.
+func (*UncollectRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CancelLikeRequest) GetBiz() string {
+func (x *UncollectRequest) GetBiz() string {
 	if x != nil {
 		return x.Biz
 	}
 	return ""
 }
 
-func (x *CancelLikeRequest) GetBizId() int64 {
+func (x *UncollectRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *CancelLikeRequest) GetUid() int64 {
+func (x *UncollectRequest) GetUid() int64 {
 	if x != nil {
 		return x.Uid
 	}
 	return 0
 }
 
-type CancelLikeResponse struct {
+type UncollectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CancelLikeResponse) Reset() {
-	*x = CancelLikeResponse{}
+func (x *UncollectResponse) Reset() {
+	*x = UncollectResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,13 +582,13 @@ func (x *CancelLikeResponse) Reset() {
 	}
 }
 
-func (x *CancelLikeResponse) String() string {
+func (x *UncollectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLikeResponse) ProtoMessage() {}
+func (*UncollectResponse) ProtoMessage() {}
 
-func (x *CancelLikeResponse) ProtoReflect() protoreflect.Message {
+func (x *UncollectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,23 +600,25 @@ func (x *CancelLikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLikeResponse.ProtoReflect.Descriptor instead.
-func (*CancelLikeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncollectResponse.ProtoReflect.Descriptor instead.
+func (*UncollectResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{8}
 }
 
-type LikeRequest struct {
+type MoveCollectionItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
 	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 目标收藏夹
+	Cid int64 `protobuf:"varint,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Uid int64 `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *LikeRequest) Reset() {
-	*x = LikeRequest{}
+func (x *MoveCollectionItemRequest) Reset() {
+	*x = MoveCollectionItemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,13 +626,13 @@ func (x *LikeRequest) Reset() {
 	}
 }
 
-func (x *LikeRequest) String() string {
+func (x *MoveCollectionItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeRequest) ProtoMessage() {}
+func (*MoveCollectionItemRequest) ProtoMessage() {}
 
-func (x *LikeRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveCollectionItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,40 +644,47 @@ func (x *LikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
-func (*LikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveCollectionItemRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LikeRequest) GetBiz() string {
+func (x *MoveCollectionItemRequest) GetBiz() string {
 	if x != nil {
 		return x.Biz
 	}
 	return ""
 }
 
-func (x *LikeRequest) GetBizId() int64 {
+func (x *MoveCollectionItemRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *LikeRequest) GetUid() int64 {
+func (x *MoveCollectionItemRequest) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+func (x *MoveCollectionItemRequest) GetUid() int64 {
 	if x != nil {
 		return x.Uid
 	}
 	return 0
 }
 
-type LikeResponse struct {
+type MoveCollectionItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LikeResponse) Reset() {
-	*x = LikeResponse{}
+func (x *MoveCollectionItemResponse) Reset() {
+	*x = MoveCollectionItemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,13 +692,13 @@ func (x *LikeResponse) Reset() {
 	}
 }
 
-func (x *LikeResponse) String() string {
+func (x *MoveCollectionItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeResponse) ProtoMessage() {}
+func (*MoveCollectionItemResponse) ProtoMessage() {}
 
-func (x *LikeResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveCollectionItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,22 +710,21 @@ func (x *LikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
-func (*LikeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveCollectionItemResponse.ProtoReflect.Descriptor instead.
+func (*MoveCollectionItemResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{10}
 }
 
-type IncrReadCntRequest struct {
+type CreateCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
-	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
 }
 
-func (x *IncrReadCntRequest) Reset() {
-	*x = IncrReadCntRequest{}
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_intr_intr_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,13 +732,13 @@ func (x *IncrReadCntRequest) Reset() {
 	}
 }
 
-func (x *IncrReadCntRequest) String() string {
+func (x *CreateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrReadCntRequest) ProtoMessage() {}
+func (*CreateCollectionRequest) ProtoMessage() {}
 
-func (x *IncrReadCntRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_intr_intr_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,14 +750,1154 @@ func (x *IncrReadCntRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrReadCntRequest.ProtoReflect.Descriptor instead.
-func (*IncrReadCntRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *IncrReadCntRequest) GetBiz() string {
+func (x *CreateCollectionRequest) GetCollection() *Collection {
 	if x != nil {
-		return x.Biz
+		return x.Collection
+	}
+	return nil
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateCollectionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateCollectionRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{14}
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCollectionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCollectionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{16}
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// 不是 uid 本人的时候只返回公开的收藏夹
+	Viewer int64 `protobuf:"varint,2,opt,name=viewer,proto3" json:"viewer,omitempty"`
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCollectionsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetViewer() int64 {
+	if x != nil {
+		return x.Viewer
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type ListCollectionItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid    int64 `protobuf:"varint,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Viewer int64 `protobuf:"varint,2,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListCollectionItemsRequest) Reset() {
+	*x = ListCollectionItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsRequest) ProtoMessage() {}
+
+func (x *ListCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCollectionItemsRequest) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+func (x *ListCollectionItemsRequest) GetViewer() int64 {
+	if x != nil {
+		return x.Viewer
+	}
+	return 0
+}
+
+func (x *ListCollectionItemsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCollectionItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCollectionItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*CollectionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListCollectionItemsResponse) Reset() {
+	*x = ListCollectionItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsResponse) ProtoMessage() {}
+
+func (x *ListCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCollectionItemsResponse) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz string  `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	Ids []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 大于 0 的时候带上这个用户有没有点赞、收藏
+	Uid int64 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *GetByIdsRequest) Reset() {
+	*x = GetByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsRequest) ProtoMessage() {}
+
+func (x *GetByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetByIdsRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetByIdsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetByIdsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type GetByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Intrs map[int64]*Interactive `protobuf:"bytes,1,rep,name=intrs,proto3" json:"intrs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetByIdsResponse) Reset() {
+	*x = GetByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdsResponse) ProtoMessage() {}
+
+func (x *GetByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetByIdsResponse) GetIntrs() map[int64]*Interactive {
+	if x != nil {
+		return x.Intrs
+	}
+	return nil
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *GetRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GetRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type Interactive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz        string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId      int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	ReadCnt    int64  `protobuf:"varint,3,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
+	LikeCnt    int64  `protobuf:"varint,4,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
+	CollectCnt int64  `protobuf:"varint,5,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
+	Liked      bool   `protobuf:"varint,6,opt,name=liked,proto3" json:"liked,omitempty"`
+	Collected  bool   `protobuf:"varint,7,opt,name=collected,proto3" json:"collected,omitempty"`
+	CommentCnt int64  `protobuf:"varint,8,opt,name=comment_cnt,json=commentCnt,proto3" json:"comment_cnt,omitempty"`
+	// 独立访客数，定时落库，会有一点延迟
+	UvCnt int64 `protobuf:"varint,9,opt,name=uv_cnt,json=uvCnt,proto3" json:"uv_cnt,omitempty"`
+}
+
+func (x *Interactive) Reset() {
+	*x = Interactive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interactive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interactive) ProtoMessage() {}
+
+func (x *Interactive) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interactive.ProtoReflect.Descriptor instead.
+func (*Interactive) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Interactive) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *Interactive) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *Interactive) GetReadCnt() int64 {
+	if x != nil {
+		return x.ReadCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetLikeCnt() int64 {
+	if x != nil {
+		return x.LikeCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetCollectCnt() int64 {
+	if x != nil {
+		return x.CollectCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *Interactive) GetCollected() bool {
+	if x != nil {
+		return x.Collected
+	}
+	return false
+}
+
+func (x *Interactive) GetCommentCnt() int64 {
+	if x != nil {
+		return x.CommentCnt
+	}
+	return 0
+}
+
+func (x *Interactive) GetUvCnt() int64 {
+	if x != nil {
+		return x.UvCnt
+	}
+	return 0
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Intr *Interactive `protobuf:"bytes,1,opt,name=intr,proto3" json:"intr,omitempty"`
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetResponse) GetIntr() *Interactive {
+	if x != nil {
+		return x.Intr
+	}
+	return nil
+}
+
+type CollectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Cid   int64  `protobuf:"varint,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Uid   int64  `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *CollectRequest) Reset() {
+	*x = CollectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectRequest) ProtoMessage() {}
+
+func (x *CollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
+func (*CollectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CollectRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CollectRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+func (x *CollectRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type CollectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CollectResponse) Reset() {
+	*x = CollectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectResponse) ProtoMessage() {}
+
+func (x *CollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
+func (*CollectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{27}
+}
+
+type CancelLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *CancelLikeRequest) Reset() {
+	*x = CancelLikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLikeRequest) ProtoMessage() {}
+
+func (x *CancelLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLikeRequest.ProtoReflect.Descriptor instead.
+func (*CancelLikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelLikeRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *CancelLikeRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CancelLikeRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type CancelLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelLikeResponse) Reset() {
+	*x = CancelLikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLikeResponse) ProtoMessage() {}
+
+func (x *CancelLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLikeResponse.ProtoReflect.Descriptor instead.
+func (*CancelLikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{29}
+}
+
+type LikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Uid   int64  `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *LikeRequest) Reset() {
+	*x = LikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeRequest) ProtoMessage() {}
+
+func (x *LikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
+func (*LikeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LikeRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *LikeRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *LikeRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type LikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LikeResponse) Reset() {
+	*x = LikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeResponse) ProtoMessage() {}
+
+func (x *LikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
+func (*LikeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{31}
+}
+
+type IncrReadCntRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biz   string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	// 读者，用来去重和统计独立访客，未登录的时候为 0
+	Uid int64 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *IncrReadCntRequest) Reset() {
+	*x = IncrReadCntRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_intr_intr_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrReadCntRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrReadCntRequest) ProtoMessage() {}
+
+func (x *IncrReadCntRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_intr_intr_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrReadCntRequest.ProtoReflect.Descriptor instead.
+func (*IncrReadCntRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IncrReadCntRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
 	}
 	return ""
 }
@@ -693,6 +1909,13 @@ func (x *IncrReadCntRequest) GetBizId() int64 {
 	return 0
 }
 
+func (x *IncrReadCntRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
 type IncrReadCntResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -702,7 +1925,7 @@ type IncrReadCntResponse struct {
 func (x *IncrReadCntResponse) Reset() {
 	*x = IncrReadCntResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_intr_intr_proto_msgTypes[12]
+		mi := &file_api_proto_intr_intr_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +1938,7 @@ func (x *IncrReadCntResponse) String() string {
 func (*IncrReadCntResponse) ProtoMessage() {}
 
 func (x *IncrReadCntResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_intr_intr_proto_msgTypes[12]
+	mi := &file_api_proto_intr_intr_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +1951,7 @@ func (x *IncrReadCntResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrReadCntResponse.ProtoReflect.Descriptor instead.
 func (*IncrReadCntResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_intr_intr_proto_rawDescGZIP(), []int{33}
 }
 
 var File_api_proto_intr_intr_proto protoreflect.FileDescriptor
@@ -736,99 +1959,254 @@ var File_api_proto_intr_intr_proto protoreflect.FileDescriptor
 var file_api_proto_intr_intr_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x72,
 	0x2f, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x74,
-	0x72, 0x2e, 0x76, 0x31, 0x22, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
-	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x72, 0x73, 0x1a, 0x4e, 0x0a, 0x0a,
-	0x49, 0x6e, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e,
-	0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
+	0x72, 0x2e, 0x76, 0x31, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a,
+	0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5d, 0x0a,
+	0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x62, 0x69, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12,
+	0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x62, 0x69, 0x7a, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x62, 0x69, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x46, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x10, 0x55, 0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x55, 0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x19, 0x4d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x2a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e,
+	0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x42, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4c, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e,
+	0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x6e, 0x74,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x69, 0x6e, 0x74, 0x72, 0x73, 0x1a, 0x4e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xf9,
+	0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a,
+	0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x76, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x76, 0x43, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x6e, 0x74,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x04, 0x69,
+	0x6e, 0x74, 0x72, 0x22, 0x5d, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
 	0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06,
 	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69,
 	0x7a, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69, 0x6b,
-	0x65, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x69, 0x6b,
-	0x65, 0x43, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f,
-	0x63, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x6e, 0x74, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x04, 0x69, 0x6e,
-	0x74, 0x72, 0x22, 0x5d, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62,
-	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x7a,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06, 0x62,
-	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x7a,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x12, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x43, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0b, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
 	0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a, 0x06,
 	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69,
-	0x7a, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61, 0x64, 0x43,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8b, 0x03, 0x0a, 0x12, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6e, 0x74,
-	0x12, 0x1b, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52,
-	0x65, 0x61, 0x64, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x4c,
-	0x69, 0x6b, 0x65, 0x12, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x6e, 0x74, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1a,
-	0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x6e, 0x74,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x12, 0x17, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x6e,
-	0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x69,
-	0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x82, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
-	0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x49, 0x6e, 0x74, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b, 0x77, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x72, 0x3b, 0x69, 0x6e, 0x74, 0x72,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x49, 0x6e, 0x74, 0x72, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x07, 0x49, 0x6e, 0x74, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x49,
-	0x6e, 0x74, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x08, 0x49, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x7a, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x12, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61,
+	0x64, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15, 0x0a,
+	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x85, 0x09,
+	0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x61, 0x64,
+	0x43, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x63, 0x72, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52,
+	0x65, 0x61, 0x64, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x04, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69,
+	0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69, 0x6b,
+	0x65, 0x12, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x69,
+	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x13, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x55,
+	0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x69, 0x6e,
+	0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x74,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x74,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x69, 0x6e, 0x74, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x82, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e,
+	0x74, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x49, 0x6e, 0x74, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x2b, 0x77, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x72, 0x3b, 0x69, 0x6e, 0x74, 0x72, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x49, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x49, 0x6e, 0x74, 0x72, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x07, 0x49, 0x6e, 0x74, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x49, 0x6e, 0x74, 0x72,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x08, 0x49, 0x6e, 0x74, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -843,44 +2221,89 @@ func file_api_proto_intr_intr_proto_rawDescGZIP() []byte {
 	return file_api_proto_intr_intr_proto_rawDescData
 }
 
-var file_api_proto_intr_intr_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_proto_intr_intr_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_api_proto_intr_intr_proto_goTypes = []any{
-	(*GetByIdsRequest)(nil),     // 0: intr.v1.GetByIdsRequest
-	(*GetByIdsResponse)(nil),    // 1: intr.v1.GetByIdsResponse
-	(*GetRequest)(nil),          // 2: intr.v1.GetRequest
-	(*Interactive)(nil),         // 3: intr.v1.Interactive
-	(*GetResponse)(nil),         // 4: intr.v1.GetResponse
-	(*CollectRequest)(nil),      // 5: intr.v1.CollectRequest
-	(*CollectResponse)(nil),     // 6: intr.v1.CollectResponse
-	(*CancelLikeRequest)(nil),   // 7: intr.v1.CancelLikeRequest
-	(*CancelLikeResponse)(nil),  // 8: intr.v1.CancelLikeResponse
-	(*LikeRequest)(nil),         // 9: intr.v1.LikeRequest
-	(*LikeResponse)(nil),        // 10: intr.v1.LikeResponse
-	(*IncrReadCntRequest)(nil),  // 11: intr.v1.IncrReadCntRequest
-	(*IncrReadCntResponse)(nil), // 12: intr.v1.IncrReadCntResponse
-	nil,                         // 13: intr.v1.GetByIdsResponse.IntrsEntry
+	(*Collection)(nil),                  // 0: intr.v1.Collection
+	(*CollectionItem)(nil),              // 1: intr.v1.CollectionItem
+	(*LikeRecord)(nil),                  // 2: intr.v1.LikeRecord
+	(*ListLikedRequest)(nil),            // 3: intr.v1.ListLikedRequest
+	(*ListLikedResponse)(nil),           // 4: intr.v1.ListLikedResponse
+	(*ListCollectedRequest)(nil),        // 5: intr.v1.ListCollectedRequest
+	(*ListCollectedResponse)(nil),       // 6: intr.v1.ListCollectedResponse
+	(*UncollectRequest)(nil),            // 7: intr.v1.UncollectRequest
+	(*UncollectResponse)(nil),           // 8: intr.v1.UncollectResponse
+	(*MoveCollectionItemRequest)(nil),   // 9: intr.v1.MoveCollectionItemRequest
+	(*MoveCollectionItemResponse)(nil),  // 10: intr.v1.MoveCollectionItemResponse
+	(*CreateCollectionRequest)(nil),     // 11: intr.v1.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),    // 12: intr.v1.CreateCollectionResponse
+	(*UpdateCollectionRequest)(nil),     // 13: intr.v1.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),    // 14: intr.v1.UpdateCollectionResponse
+	(*DeleteCollectionRequest)(nil),     // 15: intr.v1.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),    // 16: intr.v1.DeleteCollectionResponse
+	(*ListCollectionsRequest)(nil),      // 17: intr.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),     // 18: intr.v1.ListCollectionsResponse
+	(*ListCollectionItemsRequest)(nil),  // 19: intr.v1.ListCollectionItemsRequest
+	(*ListCollectionItemsResponse)(nil), // 20: intr.v1.ListCollectionItemsResponse
+	(*GetByIdsRequest)(nil),             // 21: intr.v1.GetByIdsRequest
+	(*GetByIdsResponse)(nil),            // 22: intr.v1.GetByIdsResponse
+	(*GetRequest)(nil),                  // 23: intr.v1.GetRequest
+	(*Interactive)(nil),                 // 24: intr.v1.Interactive
+	(*GetResponse)(nil),                 // 25: intr.v1.GetResponse
+	(*CollectRequest)(nil),              // 26: intr.v1.CollectRequest
+	(*CollectResponse)(nil),             // 27: intr.v1.CollectResponse
+	(*CancelLikeRequest)(nil),           // 28: intr.v1.CancelLikeRequest
+	(*CancelLikeResponse)(nil),          // 29: intr.v1.CancelLikeResponse
+	(*LikeRequest)(nil),                 // 30: intr.v1.LikeRequest
+	(*LikeResponse)(nil),                // 31: intr.v1.LikeResponse
+	(*IncrReadCntRequest)(nil),          // 32: intr.v1.IncrReadCntRequest
+	(*IncrReadCntResponse)(nil),         // 33: intr.v1.IncrReadCntResponse
+	nil,                                 // 34: intr.v1.GetByIdsResponse.IntrsEntry
 }
 var file_api_proto_intr_intr_proto_depIdxs = []int32{
-	13, // 0: intr.v1.GetByIdsResponse.intrs:type_name -> intr.v1.GetByIdsResponse.IntrsEntry
-	3,  // 1: intr.v1.GetResponse.intr:type_name -> intr.v1.Interactive
-	3,  // 2: intr.v1.GetByIdsResponse.IntrsEntry.value:type_name -> intr.v1.Interactive
-	11, // 3: intr.v1.InteractiveService.IncrReadCnt:input_type -> intr.v1.IncrReadCntRequest
-	9,  // 4: intr.v1.InteractiveService.Like:input_type -> intr.v1.LikeRequest
-	7,  // 5: intr.v1.InteractiveService.CancelLike:input_type -> intr.v1.CancelLikeRequest
-	5,  // 6: intr.v1.InteractiveService.Collect:input_type -> intr.v1.CollectRequest
-	2,  // 7: intr.v1.InteractiveService.Get:input_type -> intr.v1.GetRequest
-	0,  // 8: intr.v1.InteractiveService.GetByIds:input_type -> intr.v1.GetByIdsRequest
-	12, // 9: intr.v1.InteractiveService.IncrReadCnt:output_type -> intr.v1.IncrReadCntResponse
-	10, // 10: intr.v1.InteractiveService.Like:output_type -> intr.v1.LikeResponse
-	8,  // 11: intr.v1.InteractiveService.CancelLike:output_type -> intr.v1.CancelLikeResponse
-	6,  // 12: intr.v1.InteractiveService.Collect:output_type -> intr.v1.CollectResponse
-	4,  // 13: intr.v1.InteractiveService.Get:output_type -> intr.v1.GetResponse
-	1,  // 14: intr.v1.InteractiveService.GetByIds:output_type -> intr.v1.GetByIdsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 0: intr.v1.ListLikedResponse.likes:type_name -> intr.v1.LikeRecord
+	1,  // 1: intr.v1.ListCollectedResponse.items:type_name -> intr.v1.CollectionItem
+	0,  // 2: intr.v1.CreateCollectionRequest.collection:type_name -> intr.v1.Collection
+	0,  // 3: intr.v1.UpdateCollectionRequest.collection:type_name -> intr.v1.Collection
+	0,  // 4: intr.v1.ListCollectionsResponse.collections:type_name -> intr.v1.Collection
+	1,  // 5: intr.v1.ListCollectionItemsResponse.items:type_name -> intr.v1.CollectionItem
+	34, // 6: intr.v1.GetByIdsResponse.intrs:type_name -> intr.v1.GetByIdsResponse.IntrsEntry
+	24, // 7: intr.v1.GetResponse.intr:type_name -> intr.v1.Interactive
+	24, // 8: intr.v1.GetByIdsResponse.IntrsEntry.value:type_name -> intr.v1.Interactive
+	32, // 9: intr.v1.InteractiveService.IncrReadCnt:input_type -> intr.v1.IncrReadCntRequest
+	30, // 10: intr.v1.InteractiveService.Like:input_type -> intr.v1.LikeRequest
+	28, // 11: intr.v1.InteractiveService.CancelLike:input_type -> intr.v1.CancelLikeRequest
+	26, // 12: intr.v1.InteractiveService.Collect:input_type -> intr.v1.CollectRequest
+	23, // 13: intr.v1.InteractiveService.Get:input_type -> intr.v1.GetRequest
+	21, // 14: intr.v1.InteractiveService.GetByIds:input_type -> intr.v1.GetByIdsRequest
+	7,  // 15: intr.v1.InteractiveService.Uncollect:input_type -> intr.v1.UncollectRequest
+	9,  // 16: intr.v1.InteractiveService.MoveCollectionItem:input_type -> intr.v1.MoveCollectionItemRequest
+	3,  // 17: intr.v1.InteractiveService.ListLiked:input_type -> intr.v1.ListLikedRequest
+	5,  // 18: intr.v1.InteractiveService.ListCollected:input_type -> intr.v1.ListCollectedRequest
+	11, // 19: intr.v1.InteractiveService.CreateCollection:input_type -> intr.v1.CreateCollectionRequest
+	13, // 20: intr.v1.InteractiveService.UpdateCollection:input_type -> intr.v1.UpdateCollectionRequest
+	15, // 21: intr.v1.InteractiveService.DeleteCollection:input_type -> intr.v1.DeleteCollectionRequest
+	17, // 22: intr.v1.InteractiveService.ListCollections:input_type -> intr.v1.ListCollectionsRequest
+	19, // 23: intr.v1.InteractiveService.ListCollectionItems:input_type -> intr.v1.ListCollectionItemsRequest
+	33, // 24: intr.v1.InteractiveService.IncrReadCnt:output_type -> intr.v1.IncrReadCntResponse
+	31, // 25: intr.v1.InteractiveService.Like:output_type -> intr.v1.LikeResponse
+	29, // 26: intr.v1.InteractiveService.CancelLike:output_type -> intr.v1.CancelLikeResponse
+	27, // 27: intr.v1.InteractiveService.Collect:output_type -> intr.v1.CollectResponse
+	25, // 28: intr.v1.InteractiveService.Get:output_type -> intr.v1.GetResponse
+	22, // 29: intr.v1.InteractiveService.GetByIds:output_type -> intr.v1.GetByIdsResponse
+	8,  // 30: intr.v1.InteractiveService.Uncollect:output_type -> intr.v1.UncollectResponse
+	10, // 31: intr.v1.InteractiveService.MoveCollectionItem:output_type -> intr.v1.MoveCollectionItemResponse
+	4,  // 32: intr.v1.InteractiveService.ListLiked:output_type -> intr.v1.ListLikedResponse
+	6,  // 33: intr.v1.InteractiveService.ListCollected:output_type -> intr.v1.ListCollectedResponse
+	12, // 34: intr.v1.InteractiveService.CreateCollection:output_type -> intr.v1.CreateCollectionResponse
+	14, // 35: intr.v1.InteractiveService.UpdateCollection:output_type -> intr.v1.UpdateCollectionResponse
+	16, // 36: intr.v1.InteractiveService.DeleteCollection:output_type -> intr.v1.DeleteCollectionResponse
+	18, // 37: intr.v1.InteractiveService.ListCollections:output_type -> intr.v1.ListCollectionsResponse
+	20, // 38: intr.v1.InteractiveService.ListCollectionItems:output_type -> intr.v1.ListCollectionItemsResponse
+	24, // [24:39] is the sub-list for method output_type
+	9,  // [9:24] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_intr_intr_proto_init() }
@@ -890,7 +2313,7 @@ func file_api_proto_intr_intr_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_proto_intr_intr_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GetByIdsRequest); i {
+			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -902,7 +2325,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetByIdsResponse); i {
+			switch v := v.(*CollectionItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -914,7 +2337,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRequest); i {
+			switch v := v.(*LikeRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -926,7 +2349,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Interactive); i {
+			switch v := v.(*ListLikedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -938,7 +2361,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetResponse); i {
+			switch v := v.(*ListLikedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -950,7 +2373,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectRequest); i {
+			switch v := v.(*ListCollectedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -962,7 +2385,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectResponse); i {
+			switch v := v.(*ListCollectedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -974,7 +2397,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelLikeRequest); i {
+			switch v := v.(*UncollectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -986,7 +2409,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelLikeResponse); i {
+			switch v := v.(*UncollectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -998,7 +2421,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*LikeRequest); i {
+			switch v := v.(*MoveCollectionItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1010,7 +2433,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*LikeResponse); i {
+			switch v := v.(*MoveCollectionItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1022,7 +2445,7 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*IncrReadCntRequest); i {
+			switch v := v.(*CreateCollectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1034,6 +2457,258 @@ func file_api_proto_intr_intr_proto_init() {
 			}
 		}
 		file_api_proto_intr_intr_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCollectionItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCollectionItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*Interactive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelLikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelLikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*LikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*LikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrReadCntRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_intr_intr_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*IncrReadCntResponse); i {
 			case 0:
 				return &v.state
@@ -1052,7 +2727,7 @@ func file_api_proto_intr_intr_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_intr_intr_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	InteractiveService_IncrReadCnt_FullMethodName = "/intr.v1.InteractiveService/IncrReadCnt"
-	InteractiveService_Like_FullMethodName        = "/intr.v1.InteractiveService/Like"
-	InteractiveService_CancelLike_FullMethodName  = "/intr.v1.InteractiveService/CancelLike"
-	InteractiveService_Collect_FullMethodName     = "/intr.v1.InteractiveService/Collect"
-	InteractiveService_Get_FullMethodName         = "/intr.v1.InteractiveService/Get"
-	InteractiveService_GetByIds_FullMethodName    = "/intr.v1.InteractiveService/GetByIds"
+	InteractiveService_IncrReadCnt_FullMethodName         = "/intr.v1.InteractiveService/IncrReadCnt"
+	InteractiveService_Like_FullMethodName                = "/intr.v1.InteractiveService/Like"
+	InteractiveService_CancelLike_FullMethodName          = "/intr.v1.InteractiveService/CancelLike"
+	InteractiveService_Collect_FullMethodName             = "/intr.v1.InteractiveService/Collect"
+	InteractiveService_Get_FullMethodName                 = "/intr.v1.InteractiveService/Get"
+	InteractiveService_GetByIds_FullMethodName            = "/intr.v1.InteractiveService/GetByIds"
+	InteractiveService_Uncollect_FullMethodName           = "/intr.v1.InteractiveService/Uncollect"
+	InteractiveService_MoveCollectionItem_FullMethodName  = "/intr.v1.InteractiveService/MoveCollectionItem"
+	InteractiveService_ListLiked_FullMethodName           = "/intr.v1.InteractiveService/ListLiked"
+	InteractiveService_ListCollected_FullMethodName       = "/intr.v1.InteractiveService/ListCollected"
+	InteractiveService_CreateCollection_FullMethodName    = "/intr.v1.InteractiveService/CreateCollection"
+	InteractiveService_UpdateCollection_FullMethodName    = "/intr.v1.InteractiveService/UpdateCollection"
+	InteractiveService_DeleteCollection_FullMethodName    = "/intr.v1.InteractiveService/DeleteCollection"
+	InteractiveService_ListCollections_FullMethodName     = "/intr.v1.InteractiveService/ListCollections"
+	InteractiveService_ListCollectionItems_FullMethodName = "/intr.v1.InteractiveService/ListCollectionItems"
 )
 
 // InteractiveServiceClient is the client API for InteractiveService service.
@@ -37,6 +46,17 @@ type InteractiveServiceClient interface {
 	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	GetByIds(ctx context.Context, in *GetByIdsRequest, opts ...grpc.CallOption) (*GetByIdsResponse, error)
+	Uncollect(ctx context.Context, in *UncollectRequest, opts ...grpc.CallOption) (*UncollectResponse, error)
+	MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error)
+	// 我的点赞、我的收藏
+	ListLiked(ctx context.Context, in *ListLikedRequest, opts ...grpc.CallOption) (*ListLikedResponse, error)
+	ListCollected(ctx context.Context, in *ListCollectedRequest, opts ...grpc.CallOption) (*ListCollectedResponse, error)
+	// 收藏夹
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	ListCollectionItems(ctx context.Context, in *ListCollectionItemsRequest, opts ...grpc.CallOption) (*ListCollectionItemsResponse, error)
 }
 
 type interactiveServiceClient struct {
@@ -107,6 +127,96 @@ func (c *interactiveServiceClient) GetByIds(ctx context.Context, in *GetByIdsReq
 	return out, nil
 }
 
+func (c *interactiveServiceClient) Uncollect(ctx context.Context, in *UncollectRequest, opts ...grpc.CallOption) (*UncollectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UncollectResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_Uncollect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCollectionItemResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_MoveCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListLiked(ctx context.Context, in *ListLikedRequest, opts ...grpc.CallOption) (*ListLikedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLikedResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_ListLiked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListCollected(ctx context.Context, in *ListCollectedRequest, opts ...grpc.CallOption) (*ListCollectedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectedResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_ListCollected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListCollectionItems(ctx context.Context, in *ListCollectionItemsRequest, opts ...grpc.CallOption) (*ListCollectionItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_ListCollectionItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InteractiveServiceServer is the server API for InteractiveService service.
 // All implementations must embed UnimplementedInteractiveServiceServer
 // for forward compatibility.
@@ -117,6 +227,17 @@ type InteractiveServiceServer interface {
 	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error)
+	Uncollect(context.Context, *UncollectRequest) (*UncollectResponse, error)
+	MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error)
+	// 我的点赞、我的收藏
+	ListLiked(context.Context, *ListLikedRequest) (*ListLikedResponse, error)
+	ListCollected(context.Context, *ListCollectedRequest) (*ListCollectedResponse, error)
+	// 收藏夹
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	ListCollectionItems(context.Context, *ListCollectionItemsRequest) (*ListCollectionItemsResponse, error)
 	mustEmbedUnimplementedInteractiveServiceServer()
 }
 
@@ -145,6 +266,33 @@ func (UnimplementedInteractiveServiceServer) Get(context.Context, *GetRequest) (
 func (UnimplementedInteractiveServiceServer) GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByIds not implemented")
 }
+func (UnimplementedInteractiveServiceServer) Uncollect(context.Context, *UncollectRequest) (*UncollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uncollect not implemented")
+}
+func (UnimplementedInteractiveServiceServer) MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollectionItem not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListLiked(context.Context, *ListLikedRequest) (*ListLikedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiked not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListCollected(context.Context, *ListCollectedRequest) (*ListCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollected not implemented")
+}
+func (UnimplementedInteractiveServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListCollectionItems(context.Context, *ListCollectionItemsRequest) (*ListCollectionItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionItems not implemented")
+}
 func (UnimplementedInteractiveServiceServer) mustEmbedUnimplementedInteractiveServiceServer() {}
 func (UnimplementedInteractiveServiceServer) testEmbeddedByValue()                            {}
 
@@ -274,6 +422,168 @@ func _InteractiveService_GetByIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractiveService_Uncollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).Uncollect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_Uncollect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).Uncollect(ctx, req.(*UncollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_MoveCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).MoveCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_MoveCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).MoveCollectionItem(ctx, req.(*MoveCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListLiked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLikedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListLiked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_ListLiked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListLiked(ctx, req.(*ListLikedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_ListCollected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListCollected(ctx, req.(*ListCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_ListCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListCollectionItems(ctx, req.(*ListCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InteractiveService_ServiceDesc is the grpc.ServiceDesc for InteractiveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +615,42 @@ var InteractiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByIds",
 			Handler:    _InteractiveService_GetByIds_Handler,
 		},
+		{
+			MethodName: "Uncollect",
+			Handler:    _InteractiveService_Uncollect_Handler,
+		},
+		{
+			MethodName: "MoveCollectionItem",
+			Handler:    _InteractiveService_MoveCollectionItem_Handler,
+		},
+		{
+			MethodName: "ListLiked",
+			Handler:    _InteractiveService_ListLiked_Handler,
+		},
+		{
+			MethodName: "ListCollected",
+			Handler:    _InteractiveService_ListCollected_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _InteractiveService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _InteractiveService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _InteractiveService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _InteractiveService_ListCollections_Handler,
+		},
+		{
+			MethodName: "ListCollectionItems",
+			Handler:    _InteractiveService_ListCollectionItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/intr/intr.proto",
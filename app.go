@@ -4,10 +4,16 @@ import (
 	"github.com/gin-gonic/gin"
 	corn "github.com/robfig/cron/v3"
 	"we_book/events"
+	"we_book/internal/job"
+	"we_book/ioc"
 )
 
 type App struct {
 	web      *gin.Engine
 	consumer []events.Consumer
 	corn     *corn.Cron
+	// scheduler 执行 MySQL 中的任务，比如定时发布
+	scheduler *job.Scheduler
+	// admin 管理后台，比如切换文章迁移的双写阶段
+	admin *ioc.AdminServer
 }
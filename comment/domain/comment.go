@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+type Comment struct {
+	Id int64
+	// Biz 和 BizId 表示评论的是哪个资源，比如 article
+	Biz   string
+	BizId int64
+	// Commentator 发表评论的人
+	Commentator User
+	Content     string
+	// RootId 为 0 的是根评论，否则是这个根评论下面的回复
+	RootId int64
+	// ParentId 直接回复的评论
+	ParentId int64
+	Status   CommentStatus
+	// ReplyCnt 根评论下面所有回复的数量，包括已经删除的
+	ReplyCnt int64
+	// Replies 列表的时候预加载的前几条回复
+	Replies []Comment
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+func (c Comment) IsRoot() bool {
+	return c.RootId == 0
+}
+
+type User struct {
+	Id int64
+}
+
+type CommentStatus uint8
+
+const (
+	CommentStatusUnknown CommentStatus = iota
+	CommentStatusNormal
+	// CommentStatusDeleted 软删除，保留下面的回复，只是不再展示内容
+	CommentStatusDeleted
+)
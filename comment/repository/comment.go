@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"golang.org/x/sync/errgroup"
+	"time"
+	"we_book/comment/domain"
+	"we_book/comment/repository/dao"
+	"we_book/pkg/logger"
+)
+
+var ErrCommentNotFound = dao.ErrRecordNotFound
+
+//go:generate mockgen -source=./comment.go -destination=mocks/comment.mock.go -package=repomocks CommentRepository
+type CommentRepository interface {
+	Create(ctx context.Context, c domain.Comment) (int64, error)
+	FindById(ctx context.Context, id int64) (domain.Comment, error)
+	// ListRoots 按照时间倒序列出 ID 小于 maxId 的根评论，每个根评论预加载最早的 replyLimit 条回复
+	ListRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int, replyLimit int) ([]domain.Comment, error)
+	// ListReplies 按照时间正序列出 ID 大于 minId 的回复
+	ListReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]domain.Comment, error)
+	// UpdateStatus 状态真的发生变化的时候返回 true
+	UpdateStatus(ctx context.Context, id int64, status domain.CommentStatus) (bool, error)
+}
+
+type commentRepository struct {
+	dao dao.CommentDAO
+	l   logger.V1
+}
+
+func NewCommentRepository(dao dao.CommentDAO, l logger.V1) CommentRepository {
+	return &commentRepository{
+		dao: dao,
+		l:   l,
+	}
+}
+
+func (c *commentRepository) Create(ctx context.Context, cmt domain.Comment) (int64, error) {
+	return c.dao.Insert(ctx, c.toEntity(cmt))
+}
+
+func (c *commentRepository) FindById(ctx context.Context, id int64) (domain.Comment, error) {
+	res, err := c.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	return c.toDomain(res), nil
+}
+
+func (c *commentRepository) ListRoots(ctx context.Context, biz string, bizId int64,
+	maxId int64, limit int, replyLimit int) ([]domain.Comment, error) {
+	roots, err := c.dao.FindRoots(ctx, biz, bizId, maxId, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := slice.Map(roots, func(idx int, src dao.Comment) domain.Comment {
+		return c.toDomain(src)
+	})
+	if replyLimit <= 0 {
+		return res, nil
+	}
+	var eg errgroup.Group
+	for i := range res {
+		if res[i].ReplyCnt == 0 {
+			continue
+		}
+		root := &res[i]
+		eg.Go(func() error {
+			replies, er := c.ListReplies(ctx, root.Id, 0, replyLimit)
+			root.Replies = replies
+			return er
+		})
+	}
+	return res, eg.Wait()
+}
+
+func (c *commentRepository) ListReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]domain.Comment, error) {
+	res, err := c.dao.FindReplies(ctx, rootId, minId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Comment) domain.Comment {
+		return c.toDomain(src)
+	}), nil
+}
+
+func (c *commentRepository) UpdateStatus(ctx context.Context, id int64, status domain.CommentStatus) (bool, error) {
+	return c.dao.UpdateStatus(ctx, id, uint8(status))
+}
+
+func (c *commentRepository) toEntity(cmt domain.Comment) dao.Comment {
+	return dao.Comment{
+		Id:       cmt.Id,
+		Uid:      cmt.Commentator.Id,
+		Biz:      cmt.Biz,
+		BizId:    cmt.BizId,
+		RootId:   cmt.RootId,
+		ParentId: cmt.ParentId,
+		Content:  cmt.Content,
+		Status:   uint8(cmt.Status),
+	}
+}
+
+func (c *commentRepository) toDomain(cmt dao.Comment) domain.Comment {
+	return domain.Comment{
+		Id:    cmt.Id,
+		Biz:   cmt.Biz,
+		BizId: cmt.BizId,
+		Commentator: domain.User{
+			Id: cmt.Uid,
+		},
+		Content:  cmt.Content,
+		RootId:   cmt.RootId,
+		ParentId: cmt.ParentId,
+		Status:   domain.CommentStatus(cmt.Status),
+		ReplyCnt: cmt.ReplyCnt,
+		Ctime:    time.UnixMilli(cmt.Ctime),
+		Utime:    time.UnixMilli(cmt.Utime),
+	}
+}
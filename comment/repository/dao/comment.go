@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+var ErrRecordNotFound = gorm.ErrRecordNotFound
+
+type CommentDAO interface {
+	// Insert 插入评论，如果是回复，同时增加根评论的回复数
+	Insert(ctx context.Context, c Comment) (int64, error)
+	FindById(ctx context.Context, id int64) (Comment, error)
+	// FindRoots 按照 ID 倒序返回 ID 小于 maxId 的根评论
+	FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]Comment, error)
+	// FindReplies 按照 ID 正序返回 ID 大于 minId 的回复
+	FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]Comment, error)
+	// UpdateStatus 只在状态发生变化的时候返回 true
+	UpdateStatus(ctx context.Context, id int64, status uint8) (bool, error)
+}
+
+type GORMCommentDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCommentDAO(db *gorm.DB) CommentDAO {
+	return &GORMCommentDAO{db: db}
+}
+
+func (g *GORMCommentDAO) Insert(ctx context.Context, c Comment) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&c).Error
+		if err != nil || c.RootId == 0 {
+			return err
+		}
+		return tx.Model(&Comment{}).
+			Where("id = ?", c.RootId).
+			Updates(map[string]any{
+				"reply_cnt": gorm.Expr("reply_cnt + 1"),
+				"utime":     now,
+			}).Error
+	})
+	return c.Id, err
+}
+
+func (g *GORMCommentDAO) FindById(ctx context.Context, id int64) (Comment, error) {
+	var res Comment
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (g *GORMCommentDAO) FindRoots(ctx context.Context, biz string, bizId int64, maxId int64, limit int) ([]Comment, error) {
+	var res []Comment
+	err := g.db.WithContext(ctx).
+		Where("biz = ? AND biz_id = ? AND root_id = 0 AND id < ?", biz, bizId, maxId).
+		Order("id desc").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMCommentDAO) FindReplies(ctx context.Context, rootId int64, minId int64, limit int) ([]Comment, error) {
+	var res []Comment
+	err := g.db.WithContext(ctx).
+		Where("root_id = ? AND id > ?", rootId, minId).
+		Order("id asc").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMCommentDAO) UpdateStatus(ctx context.Context, id int64, status uint8) (bool, error) {
+	res := g.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ? AND status <> ?", id, status).
+		Updates(map[string]any{
+			"status": status,
+			"utime":  time.Now().UnixMilli(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+type Comment struct {
+	Id  int64 `gorm:"primaryKey,autoIncrement"`
+	Uid int64 `gorm:"index"`
+	// 列出某个资源的根评论走 (biz, biz_id, root_id, id)
+	Biz      string `gorm:"type:varchar(128);index:biz_root,priority:1"`
+	BizId    int64  `gorm:"index:biz_root,priority:2"`
+	RootId   int64  `gorm:"index:biz_root,priority:3;index"`
+	ParentId int64
+	Content  string `gorm:"type:varchar(4096)"`
+	Status   uint8
+	ReplyCnt int64
+	Ctime    int64
+	Utime    int64
+}
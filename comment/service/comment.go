@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf8"
+	"we_book/comment/domain"
+	"we_book/comment/repository"
+	intrSvc "we_book/interactive/service"
+	"we_book/pkg/logger"
+)
+
+const (
+	// previewReplyCnt 列出根评论的时候，每个根评论预加载的回复数
+	previewReplyCnt = 3
+	maxContentLen   = 1024
+)
+
+var (
+	ErrCommentNotFound = repository.ErrCommentNotFound
+	ErrInvalidComment  = errors.New("comment is empty or too long")
+	ErrUnknownBiz      = errors.New("biz does not support comments")
+	ErrInvalidParent   = errors.New("parent comment does not belong to the resource")
+	ErrNoPermission    = errors.New("only the commentator or the owner of the resource can delete")
+)
+
+// OwnerFunc 返回资源的作者，资源的作者可以删除下面所有的评论
+// 资源不存在的时候返回 error
+type OwnerFunc func(ctx context.Context, bizId int64) (int64, error)
+
+// BizOwners 允许评论的业务，key 是 biz
+type BizOwners map[string]OwnerFunc
+
+//go:generate mockgen -source=./comment.go -destination=mocks/comment.mock.go -package=svcmocks CommentService
+type CommentService interface {
+	// Create 发表评论，ParentId 不为 0 的时候是回复
+	Create(ctx context.Context, c domain.Comment) (int64, error)
+	// Delete 软删除，评论者本人和资源的作者都可以删
+	Delete(ctx context.Context, id int64, uid int64) error
+	// ListRoots cursor 为上一页最后一条评论的 ID，第一页传 0
+	ListRoots(ctx context.Context, biz string, bizId int64, cursor int64, limit int) ([]domain.Comment, error)
+	// ListReplies cursor 为上一页最后一条回复的 ID，第一页传 0
+	ListReplies(ctx context.Context, rootId int64, cursor int64, limit int) ([]domain.Comment, error)
+}
+
+type commentService struct {
+	repo    repository.CommentRepository
+	intrSvc intrSvc.InteractiveService
+	owners  BizOwners
+	l       logger.V1
+}
+
+func NewCommentService(repo repository.CommentRepository, intrSvc intrSvc.InteractiveService,
+	owners BizOwners, l logger.V1) CommentService {
+	return &commentService{
+		repo:    repo,
+		intrSvc: intrSvc,
+		owners:  owners,
+		l:       l,
+	}
+}
+
+func (s *commentService) Create(ctx context.Context, c domain.Comment) (int64, error) {
+	c.Content = strings.TrimSpace(c.Content)
+	if c.Content == "" || utf8.RuneCountInString(c.Content) > maxContentLen {
+		return 0, ErrInvalidComment
+	}
+	owner, ok := s.owners[c.Biz]
+	if !ok {
+		return 0, ErrUnknownBiz
+	}
+	// 顺便确认资源存在
+	if _, err := owner(ctx, c.BizId); err != nil {
+		return 0, err
+	}
+	c.RootId = 0
+	if c.ParentId > 0 {
+		parent, err := s.repo.FindById(ctx, c.ParentId)
+		if err != nil {
+			return 0, err
+		}
+		if parent.Biz != c.Biz || parent.BizId != c.BizId ||
+			parent.Status != domain.CommentStatusNormal {
+			return 0, ErrInvalidParent
+		}
+		// 只有两层，回复的回复也挂在根评论下面
+		c.RootId = parent.RootId
+		if parent.IsRoot() {
+			c.RootId = parent.Id
+		}
+	}
+	c.Status = domain.CommentStatusNormal
+	id, err := s.repo.Create(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	s.incrCommentCnt(ctx, c.Biz, c.BizId, 1)
+	return id, nil
+}
+
+func (s *commentService) Delete(ctx context.Context, id int64, uid int64) error {
+	c, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if c.Commentator.Id != uid {
+		owner, ok := s.owners[c.Biz]
+		if !ok {
+			return ErrNoPermission
+		}
+		ownerId, err := owner(ctx, c.BizId)
+		if err != nil {
+			return err
+		}
+		if ownerId != uid {
+			return ErrNoPermission
+		}
+	}
+	changed, err := s.repo.UpdateStatus(ctx, id, domain.CommentStatusDeleted)
+	if err != nil {
+		return err
+	}
+	// 重复删除不能重复扣减
+	if changed {
+		s.incrCommentCnt(ctx, c.Biz, c.BizId, -1)
+	}
+	return nil
+}
+
+func (s *commentService) ListRoots(ctx context.Context, biz string, bizId int64, cursor int64, limit int) ([]domain.Comment, error) {
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	res, err := s.repo.ListRoots(ctx, biz, bizId, cursor, limit, previewReplyCnt)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		hideDeleted(res[i].Replies)
+	}
+	hideDeleted(res)
+	return res, nil
+}
+
+func (s *commentService) ListReplies(ctx context.Context, rootId int64, cursor int64, limit int) ([]domain.Comment, error) {
+	res, err := s.repo.ListReplies(ctx, rootId, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	hideDeleted(res)
+	return res, nil
+}
+
+// incrCommentCnt 评论数不需要那么精确，失败了只记录日志
+func (s *commentService) incrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) {
+	err := s.intrSvc.IncrCommentCnt(ctx, biz, bizId, delta)
+	if err != nil {
+		s.l.Error("incr comment cnt error",
+			logger.String("biz", biz),
+			logger.Int64("biz_id", bizId),
+			logger.Error(err))
+	}
+}
+
+// hideDeleted 已经删除的评论保留位置，但是不返回内容
+func hideDeleted(cs []domain.Comment) {
+	for i := range cs {
+		if cs[i].Status == domain.CommentStatusDeleted {
+			cs[i].Content = ""
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"we_book/comment/domain"
+	"we_book/comment/repository"
+	repomocks "we_book/comment/repository/mocks"
+	intrSvc "we_book/interactive/service"
+	intrmocks "we_book/interactive/service/mocks"
+	"we_book/pkg/logger"
+)
+
+func testOwners() BizOwners {
+	return BizOwners{
+		"article": func(ctx context.Context, bizId int64) (int64, error) {
+			return 100, nil
+		},
+	}
+}
+
+func Test_commentService_Create(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService)
+
+		cmt domain.Comment
+
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name: "发表根评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain.Comment{
+					Biz:         "article",
+					BizId:       1,
+					Commentator: domain.User{Id: 123},
+					Content:     "写得好",
+					Status:      domain.CommentStatusNormal,
+				}).Return(int64(10), nil)
+				intr := intrmocks.NewMockInteractiveService(ctrl)
+				intr.EXPECT().IncrCommentCnt(gomock.Any(), "article", int64(1), int64(1)).
+					Return(nil)
+				return repo, intr
+			},
+			cmt: domain.Comment{
+				Biz:         "article",
+				BizId:       1,
+				Commentator: domain.User{Id: 123},
+				Content:     " 写得好 ",
+			},
+			wantId: 10,
+		},
+		{
+			name: "回复的回复挂在根评论下面",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(11)).Return(domain.Comment{
+					Id:     11,
+					Biz:    "article",
+					BizId:  1,
+					RootId: 10,
+					Status: domain.CommentStatusNormal,
+				}, nil)
+				repo.EXPECT().Create(gomock.Any(), domain.Comment{
+					Biz:         "article",
+					BizId:       1,
+					Commentator: domain.User{Id: 123},
+					Content:     "同意",
+					RootId:      10,
+					ParentId:    11,
+					Status:      domain.CommentStatusNormal,
+				}).Return(int64(12), nil)
+				intr := intrmocks.NewMockInteractiveService(ctrl)
+				intr.EXPECT().IncrCommentCnt(gomock.Any(), "article", int64(1), int64(1)).
+					Return(errors.New("mock db error"))
+				return repo, intr
+			},
+			cmt: domain.Comment{
+				Biz:         "article",
+				BizId:       1,
+				Commentator: domain.User{Id: 123},
+				Content:     "同意",
+				ParentId:    11,
+			},
+			wantId: 12,
+		},
+		{
+			name: "回复别的资源下的评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(11)).Return(domain.Comment{
+					Id:     11,
+					Biz:    "article",
+					BizId:  2,
+					Status: domain.CommentStatusNormal,
+				}, nil)
+				return repo, intrmocks.NewMockInteractiveService(ctrl)
+			},
+			cmt: domain.Comment{
+				Biz:      "article",
+				BizId:    1,
+				Content:  "同意",
+				ParentId: 11,
+			},
+			wantErr: ErrInvalidParent,
+		},
+		{
+			name: "空评论",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				return repomocks.NewMockCommentRepository(ctrl), intrmocks.NewMockInteractiveService(ctrl)
+			},
+			cmt: domain.Comment{
+				Biz:     "article",
+				BizId:   1,
+				Content: "  ",
+			},
+			wantErr: ErrInvalidComment,
+		},
+		{
+			name: "不支持评论的业务",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				return repomocks.NewMockCommentRepository(ctrl), intrmocks.NewMockInteractiveService(ctrl)
+			},
+			cmt: domain.Comment{
+				Biz:     "user",
+				BizId:   1,
+				Content: "你好",
+			},
+			wantErr: ErrUnknownBiz,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intr := tc.mock(ctrl)
+			svc := NewCommentService(repo, intr, testOwners(), logger.NewNoLogger())
+			id, err := svc.Create(context.Background(), tc.cmt)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func Test_commentService_Delete(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService)
+
+		uid int64
+
+		wantErr error
+	}{
+		{
+			name: "评论者删除",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(10)).Return(domain.Comment{
+					Id: 10, Biz: "article", BizId: 1, Commentator: domain.User{Id: 123},
+				}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(10), domain.CommentStatusDeleted).
+					Return(true, nil)
+				intr := intrmocks.NewMockInteractiveService(ctrl)
+				intr.EXPECT().IncrCommentCnt(gomock.Any(), "article", int64(1), int64(-1)).
+					Return(nil)
+				return repo, intr
+			},
+			uid: 123,
+		},
+		{
+			name: "作者删除，已经删过了",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(10)).Return(domain.Comment{
+					Id: 10, Biz: "article", BizId: 1, Commentator: domain.User{Id: 123},
+				}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(10), domain.CommentStatusDeleted).
+					Return(false, nil)
+				return repo, intrmocks.NewMockInteractiveService(ctrl)
+			},
+			uid: 100,
+		},
+		{
+			name: "没有权限",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository, intrSvc.InteractiveService) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(10)).Return(domain.Comment{
+					Id: 10, Biz: "article", BizId: 1, Commentator: domain.User{Id: 123},
+				}, nil)
+				return repo, intrmocks.NewMockInteractiveService(ctrl)
+			},
+			uid:     456,
+			wantErr: ErrNoPermission,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intr := tc.mock(ctrl)
+			svc := NewCommentService(repo, intr, testOwners(), logger.NewNoLogger())
+			err := svc.Delete(context.Background(), 10, tc.uid)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
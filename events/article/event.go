@@ -0,0 +1,35 @@
+package article
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/IBM/sarama"
+	"we_book/internal/domain"
+)
+
+// HeaderEventType 事件类型放在这个消息头里面
+const HeaderEventType = "type"
+
+// DecodeArticleEvent 按照消息头里面的类型解析成
+// domain.ArticlePublished 或者 domain.ArticleWithdrawn
+func DecodeArticleEvent(msg *sarama.ConsumerMessage) (any, error) {
+	var typ string
+	for _, h := range msg.Headers {
+		if h != nil && string(h.Key) == HeaderEventType {
+			typ = string(h.Value)
+			break
+		}
+	}
+	switch typ {
+	case domain.ArticleEventPublished:
+		var evt domain.ArticlePublished
+		err := json.Unmarshal(msg.Value, &evt)
+		return evt, err
+	case domain.ArticleEventWithdrawn:
+		var evt domain.ArticleWithdrawn
+		err := json.Unmarshal(msg.Value, &evt)
+		return evt, err
+	default:
+		return nil, fmt.Errorf("unknown article event type %q", typ)
+	}
+}
@@ -3,18 +3,24 @@ package article
 import (
 	"context"
 	"github.com/IBM/sarama"
+	"time"
+	"we_book/interactive/domain"
+	intrSvc "we_book/interactive/service"
 	"we_book/pkg/logger"
 	"we_book/pkg/saramax"
 )
 
+// HistoryReadEventConsumer 根据阅读事件记录用户的阅读历史
 type HistoryReadEventConsumer struct {
 	client sarama.Client
+	svc    intrSvc.ReadHistoryService
 	l      logger.V1
 }
 
-func NewHistoryReadEventConsumer(client sarama.Client, l logger.V1) *HistoryReadEventConsumer {
+func NewHistoryReadEventConsumer(client sarama.Client, svc intrSvc.ReadHistoryService, l logger.V1) *HistoryReadEventConsumer {
 	return &HistoryReadEventConsumer{
 		client: client,
+		svc:    svc,
 		l:      l,
 	}
 }
@@ -25,17 +31,26 @@ func (r *HistoryReadEventConsumer) Start() error {
 		return err
 	}
 	go func() {
-		err = cg.Consume(context.Background(), []string{"history_read"}, saramax.NewHandler[ReadEvent](r.l, r.Consumer))
-		if err != nil {
-			r.l.Error("consumer error")
+		er := cg.Consume(context.Background(), []string{"read_article"}, saramax.NewHandler[ReadEvent](r.l, r.Consumer))
+		if er != nil {
+			r.l.Error("consumer error", logger.Error(er))
 		}
 	}()
 	return err
 }
 
 func (r *HistoryReadEventConsumer) Consumer(msg *sarama.ConsumerMessage, t ReadEvent) error {
-	//ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	//defer cancel()
-	//return r.repo.AddRecord(ctx, t.Aid, t.Uid)
-	panic("implement me")
+	// 没有登录的阅读不记录
+	if t.Uid <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// 用消息的时间，积压的时候也是真实的阅读时间
+	return r.svc.Record(ctx, domain.ReadHistory{
+		Uid:   t.Uid,
+		Biz:   "article",
+		BizId: t.Aid,
+		Rtime: msg.Timestamp,
+	})
 }
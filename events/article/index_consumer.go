@@ -0,0 +1,122 @@
+package article
+
+import (
+	"context"
+	"github.com/IBM/sarama"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/article"
+	"we_book/internal/search"
+	"we_book/pkg/logger"
+)
+
+// ArticleIndexConsumer 根据文章的发表、撤回事件维护搜索索引
+// 索引在进程内，每个实例都要消费所有分区，所以没有使用消费者组
+type ArticleIndexConsumer struct {
+	client  sarama.Client
+	indexer search.Indexer
+	repo    article.ArticleRepository
+	l       logger.V1
+}
+
+func NewArticleIndexConsumer(client sarama.Client, indexer search.Indexer,
+	repo article.ArticleRepository, l logger.V1) *ArticleIndexConsumer {
+	return &ArticleIndexConsumer{
+		client:  client,
+		indexer: indexer,
+		repo:    repo,
+		l:       l,
+	}
+}
+
+func (c *ArticleIndexConsumer) Start() error {
+	consumer, err := sarama.NewConsumerFromClient(c.client)
+	if err != nil {
+		return err
+	}
+	partitions, err := consumer.Partitions(domain.TopicArticleEvent)
+	if err != nil {
+		return err
+	}
+	// 先从最新的位置开始消费，再全量构建一次
+	// 两边交叉的部分由索引按照 Utime 去重
+	for _, p := range partitions {
+		pc, err := consumer.ConsumePartition(domain.TopicArticleEvent, p, sarama.OffsetNewest)
+		if err != nil {
+			return err
+		}
+		go func() {
+			for msg := range pc.Messages() {
+				c.consume(msg)
+			}
+		}()
+	}
+	go func() {
+		err := c.rebuild()
+		if err != nil {
+			c.l.Error("rebuild search index error", logger.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (c *ArticleIndexConsumer) consume(msg *sarama.ConsumerMessage) {
+	evt, err := DecodeArticleEvent(msg)
+	if err != nil {
+		c.l.Error("反序列化消息失败",
+			logger.Error(err),
+			logger.String("topic", msg.Topic),
+			logger.Int64("partition", int64(msg.Partition)),
+			logger.Int64("offset", msg.Offset))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var aid int64
+	switch evt := evt.(type) {
+	case domain.ArticlePublished:
+		aid = evt.Aid
+		err = c.indexer.Index(ctx, domain.Article{
+			Id:       evt.Aid,
+			Title:    evt.Title,
+			Content:  evt.Content,
+			Author:   domain.Author{Id: evt.Uid},
+			Status:   domain.ArticleStatusPublished,
+			Category: evt.Category,
+			Tags:     evt.Tags,
+			Utime:    time.UnixMilli(evt.Utime),
+		})
+	case domain.ArticleWithdrawn:
+		aid = evt.Aid
+		err = c.indexer.Delete(ctx, evt.Aid, time.UnixMilli(evt.Utime))
+	}
+	if err != nil {
+		c.l.Error("更新搜索索引失败",
+			logger.Error(err),
+			logger.Int64("aid", aid))
+	}
+}
+
+// rebuild 分批读出所有已发表的文章放进索引
+func (c *ArticleIndexConsumer) rebuild() error {
+	const batchSize = 100
+	var cursor domain.ArticleCursor
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		arts, err := c.repo.ListPubByCursor(ctx, cursor, batchSize)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			err = c.indexer.Index(context.Background(), art)
+			if err != nil {
+				return err
+			}
+		}
+		if len(arts) < batchSize {
+			return nil
+		}
+		cursor = domain.NextCursor(arts)
+	}
+}
@@ -0,0 +1,190 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/IBM/sarama"
+	rlock "github.com/gotomicro/redis-lock"
+	"time"
+	"we_book/events/article"
+	"we_book/internal/repository/dao/outbox"
+	"we_book/pkg/logger"
+)
+
+// OutboxRelay 把发件箱里面的消息投递到 Kafka，投递成功之后再删掉，所以是至少一次
+// 多个实例只有抢到分布式锁的那个在投递，同一个 Key 的消息按照写入顺序发出去
+type OutboxRelay struct {
+	dao      outbox.OutboxDAO
+	producer sarama.SyncProducer
+	client   *rlock.Client
+	l        logger.V1
+
+	key       string
+	batchSize int
+	// interval 发件箱空了之后隔多久再查
+	interval time.Duration
+	lockTTL  time.Duration
+	// 失败的消息从 minBackoff 开始翻倍退避，最多 maxBackoff，失败 maxAttempts 次之后不再投递
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
+}
+
+func NewOutboxRelay(dao outbox.OutboxDAO, producer sarama.SyncProducer,
+	client *rlock.Client, l logger.V1) *OutboxRelay {
+	return &OutboxRelay{
+		dao:         dao,
+		producer:    producer,
+		client:      client,
+		l:           l,
+		key:         "outbox_relay",
+		batchSize:   100,
+		interval:    time.Second,
+		lockTTL:     time.Second * 10,
+		minBackoff:  time.Second,
+		maxBackoff:  time.Minute * 10,
+		maxAttempts: 16,
+	}
+}
+
+// Start 和消费者一起启动，在后台一直运行
+func (r *OutboxRelay) Start() error {
+	go r.run()
+	return nil
+}
+
+func (r *OutboxRelay) run() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		lock, err := r.client.Lock(ctx, r.key, r.lockTTL, &rlock.FixIntervalRetry{
+			Interval: time.Millisecond * 100,
+			Max:      0,
+		}, time.Second)
+		cancel()
+		if err != nil {
+			// 别的实例在投递，过一会再来抢
+			time.Sleep(r.lockTTL)
+			continue
+		}
+		r.runWithLock(lock)
+	}
+}
+
+// runWithLock 一直投递到锁丢了为止
+func (r *OutboxRelay) runWithLock(lock *rlock.Lock) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		err := lock.AutoRefresh(r.lockTTL/2, time.Second)
+		if err != nil {
+			r.l.Error("outbox relay refresh lock error", logger.Error(err))
+		}
+		cancel()
+	}()
+	for ctx.Err() == nil {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			r.l.Error("relay outbox messages error", logger.Error(err))
+		}
+		if err == nil && n == r.batchSize {
+			// 可能还有，接着投递
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// RelayOnce 按照写入顺序投递一批消息，返回投递成功的条数
+// 一条消息失败了只退避这一条，同一个 Key 后面的消息这一轮先不投递，其它 Key 的照常投递。
+// 之后的批次由 ListPending 挡住同一个 Key 后面的消息，直到这一条投递成功或者不再投递。
+// Kafka 整个不可用的时候就停下来，剩下的下一次再投递，也不算消息的失败次数
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	dctx, cancel := context.WithTimeout(ctx, time.Second)
+	msgs, err := r.dao.ListPending(dctx, r.batchSize)
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+	sent := make([]int64, 0, len(msgs))
+	failedKeys := make(map[string]struct{})
+	var sendErr error
+	for _, msg := range msgs {
+		if _, ok := failedKeys[msg.Key]; ok {
+			continue
+		}
+		_, _, err = r.producer.SendMessage(&sarama.ProducerMessage{
+			Topic: msg.Topic,
+			Key:   sarama.StringEncoder(msg.Key),
+			Value: sarama.ByteEncoder(msg.Payload),
+			Headers: []sarama.RecordHeader{
+				{Key: []byte(article.HeaderEventType), Value: []byte(msg.Type)},
+			},
+		})
+		if err == nil {
+			sent = append(sent, msg.Id)
+			continue
+		}
+		sendErr = err
+		r.l.Error("send outbox message error",
+			logger.Int64("id", msg.Id),
+			logger.String("topic", msg.Topic),
+			logger.Error(err))
+		if kafkaUnavailable(err) {
+			break
+		}
+		failedKeys[msg.Key] = struct{}{}
+		r.fail(ctx, msg, err)
+	}
+	dctx, cancel = context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	// 删除失败下一次会重复投递，消费者要能处理重复的消息
+	err = r.dao.Delete(dctx, sent)
+	if err != nil {
+		return 0, err
+	}
+	return len(sent), sendErr
+}
+
+// fail 记下这条消息的失败，超过次数或者消息本身就发不出去的不再投递
+func (r *OutboxRelay) fail(ctx context.Context, msg outbox.Message, sendErr error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	attempts := msg.Attempts + 1
+	var err error
+	if attempts >= r.maxAttempts || errors.Is(sendErr, sarama.ErrMessageSizeTooLarge) {
+		r.l.Error("outbox message dead",
+			logger.Int64("id", msg.Id),
+			logger.String("topic", msg.Topic),
+			logger.Int64("attempts", int64(attempts)))
+		err = r.dao.Dead(ctx, msg.Id, attempts)
+	} else {
+		err = r.dao.Retry(ctx, msg.Id, attempts, time.Now().Add(r.backoff(attempts)).UnixMilli())
+	}
+	if err != nil {
+		// 没记下来的话下一轮马上又会投递，只是少退避了一次
+		r.l.Error("mark outbox message failed error",
+			logger.Int64("id", msg.Id),
+			logger.Error(err))
+	}
+}
+
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	d := r.minBackoff
+	for i := 1; i < attempts && d < r.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.maxBackoff {
+		d = r.maxBackoff
+	}
+	return d
+}
+
+// kafkaUnavailable 这些错误和具体的消息无关，后面的消息也发不出去
+func kafkaUnavailable(err error) bool {
+	return errors.Is(err, sarama.ErrOutOfBrokers) ||
+		errors.Is(err, sarama.ErrNotConnected) ||
+		errors.Is(err, sarama.ErrClosedClient) ||
+		errors.Is(err, sarama.ErrShuttingDown)
+}
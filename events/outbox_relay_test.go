@@ -0,0 +1,137 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"we_book/internal/repository/dao/outbox"
+	outboxmocks "we_book/internal/repository/dao/outbox/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestOutboxRelay_RelayOnce(t *testing.T) {
+	msgs := []outbox.Message{
+		{Id: 1, Topic: "article_events", Key: "1", Type: "article_published", Payload: []byte(`{"aid":1}`)},
+		{Id: 2, Topic: "article_events", Key: "1", Type: "article_withdrawn", Payload: []byte(`{"aid":1}`)},
+		{Id: 3, Topic: "article_events", Key: "2", Type: "article_published", Payload: []byte(`{"aid":2}`)},
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO
+
+		wantN   int
+		wantErr error
+	}{
+		{
+			name: "全部投递成功",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(msgs, nil)
+				for range msgs {
+					p.ExpectSendMessageAndSucceed()
+				}
+				dao.EXPECT().Delete(gomock.Any(), []int64{1, 2, 3}).Return(nil)
+				return dao
+			},
+			wantN: 3,
+		},
+		{
+			name: "Kafka 不可用，停下来，只删除前面投递成功的",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(msgs, nil)
+				p.ExpectSendMessageAndSucceed()
+				p.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+				dao.EXPECT().Delete(gomock.Any(), []int64{1}).Return(nil)
+				return dao
+			},
+			wantN:   1,
+			wantErr: sarama.ErrOutOfBrokers,
+		},
+		{
+			name: "一条失败，退避重试，同一个 Key 后面的先不投，其它 Key 照常投递",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(msgs, nil)
+				p.ExpectSendMessageAndFail(sarama.ErrInvalidPartition)
+				dao.EXPECT().Retry(gomock.Any(), int64(1), 1, gomock.Any()).Return(nil)
+				p.ExpectSendMessageAndSucceed()
+				dao.EXPECT().Delete(gomock.Any(), []int64{3}).Return(nil)
+				return dao
+			},
+			wantN:   1,
+			wantErr: sarama.ErrInvalidPartition,
+		},
+		{
+			name: "消息太大，不再投递",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(msgs[2:], nil)
+				p.ExpectSendMessageAndFail(sarama.ErrMessageSizeTooLarge)
+				dao.EXPECT().Dead(gomock.Any(), int64(3), 1).Return(nil)
+				dao.EXPECT().Delete(gomock.Any(), []int64{}).Return(nil)
+				return dao
+			},
+			wantErr: sarama.ErrMessageSizeTooLarge,
+		},
+		{
+			name: "重试次数用完了，不再投递",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				msg := msgs[0]
+				msg.Attempts = 15
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return([]outbox.Message{msg}, nil)
+				p.ExpectSendMessageAndFail(sarama.ErrInvalidPartition)
+				dao.EXPECT().Dead(gomock.Any(), int64(1), 16).Return(nil)
+				dao.EXPECT().Delete(gomock.Any(), []int64{}).Return(nil)
+				return dao
+			},
+			wantErr: sarama.ErrInvalidPartition,
+		},
+		{
+			name: "删除失败",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(msgs[:1], nil)
+				p.ExpectSendMessageAndSucceed()
+				dao.EXPECT().Delete(gomock.Any(), []int64{1}).Return(errors.New("mock db error"))
+				return dao
+			},
+			wantErr: errors.New("mock db error"),
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller, p *mocks.SyncProducer) outbox.OutboxDAO {
+				dao := outboxmocks.NewMockOutboxDAO(ctrl)
+				dao.EXPECT().ListPending(gomock.Any(), 100).Return(nil, errors.New("mock db error"))
+				return dao
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			p := mocks.NewSyncProducer(t, nil)
+			defer p.Close()
+			r := NewOutboxRelay(tc.mock(ctrl, p), p, nil, logger.NewNoLogger())
+			n, err := r.RelayOnce(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantN, n)
+		})
+	}
+}
+
+func TestOutboxRelay_backoff(t *testing.T) {
+	r := NewOutboxRelay(nil, nil, nil, logger.NewNoLogger())
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, time.Second*4, r.backoff(3))
+	assert.Equal(t, time.Minute*10, r.backoff(15))
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Collection 收藏夹
+// Id 为 0 的是每个用户都有的默认收藏夹，不落库，也不公开
+type Collection struct {
+	Id     int64
+	Uid    int64
+	Name   string
+	Public bool
+	// ItemCnt 收藏夹里面收藏的数量
+	ItemCnt int64
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+// CollectionItem 收藏夹里面的一条收藏
+type CollectionItem struct {
+	Cid   int64
+	Uid   int64
+	Biz   string
+	BizId int64
+	Ctime time.Time
+}
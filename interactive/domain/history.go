@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// ReadHistory 用户的阅读记录，同一个资源只保留最近一次
+type ReadHistory struct {
+	Uid   int64
+	Biz   string
+	BizId int64
+	// Progress 阅读进度，0-100，0 表示没有上报过进度
+	Progress int
+	// Rtime 最近一次阅读的时间
+	Rtime time.Time
+}
@@ -1,13 +1,27 @@
 package domain
 
+import "time"
+
 type Interactive struct {
 	Biz   string `json:"biz"`
 	BizId int64  `json:"biz_id"`
 
+	// ReadCnt 同一个用户在去重窗口内重复阅读只算一次
 	ReadCnt    int64 `json:"read_cnt"`
 	LikedCnt   int64 `json:"liked_cnt"`
 	CollectCnt int64 `json:"collect_cnt"`
+	CommentCnt int64 `json:"comment_cnt"`
+	// UvCnt 独立访客数，HyperLogLog 估算之后定时落库，会有一点延迟
+	UvCnt int64 `json:"uv_cnt"`
 
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
 }
+
+// LikeRecord 用户点赞过的资源，Utime 是点赞的时间
+type LikeRecord struct {
+	Uid   int64
+	Biz   string
+	BizId int64
+	Utime time.Time
+}
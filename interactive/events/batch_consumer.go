@@ -42,13 +42,15 @@ func (r *InteractiveReadEventBatchConsumer) Start() error {
 func (r *InteractiveReadEventBatchConsumer) Consume(msgs []*sarama.ConsumerMessage, ts []ReadEvent) error {
 	ids := make([]int64, 0, len(ts))
 	bizs := make([]string, 0, len(ts))
+	uids := make([]int64, 0, len(ts))
 	for _, evt := range ts {
 		ids = append(ids, evt.Aid)
 		bizs = append(bizs, "article")
+		uids = append(uids, evt.Uid)
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
-	err := r.repo.BatchIncrReadCnt(ctx, ids, bizs)
+	err := r.repo.BatchIncrReadCnt(ctx, ids, bizs, uids)
 	if err != nil {
 		r.l.Error("批量更新阅读数失败",
 			logger.Field{Key: "ids", Value: ids},
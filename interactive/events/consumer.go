@@ -46,5 +46,5 @@ func (r *InteractiveReadEventConsumer) Start() error {
 func (r *InteractiveReadEventConsumer) Consume(msg *sarama.ConsumerMessage, t ReadEvent) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	return r.repo.IncrReadCnt(ctx, "article", t.Aid)
+	return r.repo.IncrReadCnt(ctx, "article", t.Aid, t.Uid)
 }
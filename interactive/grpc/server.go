@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"we_book/api/proto/gen/intr"
@@ -12,10 +14,18 @@ import (
 type InteractiveServiceServer struct {
 	intrv1.UnsafeInteractiveServiceServer
 	asv service.InteractiveService
+	csv service.CollectionService
+}
+
+func NewInteractiveServiceServer(asv service.InteractiveService, csv service.CollectionService) *InteractiveServiceServer {
+	return &InteractiveServiceServer{
+		asv: asv,
+		csv: csv,
+	}
 }
 
 func (i *InteractiveServiceServer) IncrReadCnt(ctx context.Context, request *intrv1.IncrReadCntRequest) (*intrv1.IncrReadCntResponse, error) {
-	err := i.asv.IncrReadCnt(ctx, request.GetBiz(), request.GetBizId())
+	err := i.asv.IncrReadCnt(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
 	return &intrv1.IncrReadCntResponse{}, err
 }
 
@@ -34,7 +44,153 @@ func (i *InteractiveServiceServer) CancelLike(ctx context.Context, request *intr
 
 func (i *InteractiveServiceServer) Collect(ctx context.Context, request *intrv1.CollectRequest) (*intrv1.CollectResponse, error) {
 	err := i.asv.Collect(ctx, request.GetBiz(), request.GetBizId(), request.GetCid(), request.GetUid())
-	return &intrv1.CollectResponse{}, err
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.CollectResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) Uncollect(ctx context.Context, request *intrv1.UncollectRequest) (*intrv1.UncollectResponse, error) {
+	err := i.csv.Uncollect(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.UncollectResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) MoveCollectionItem(ctx context.Context, request *intrv1.MoveCollectionItemRequest) (*intrv1.MoveCollectionItemResponse, error) {
+	err := i.csv.Move(ctx, request.GetBiz(), request.GetBizId(), request.GetCid(), request.GetUid())
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.MoveCollectionItemResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) ListLiked(ctx context.Context, request *intrv1.ListLikedRequest) (*intrv1.ListLikedResponse, error) {
+	if request.GetLimit() <= 0 || request.GetLimit() > 100 || request.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset or limit error")
+	}
+	likes, err := i.asv.ListLiked(ctx, request.GetUid(), request.GetBiz(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListLikedResponse{
+		Likes: slice.Map(likes, func(idx int, src domain.LikeRecord) *intrv1.LikeRecord {
+			return &intrv1.LikeRecord{
+				Uid:   src.Uid,
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Utime: src.Utime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollected(ctx context.Context, request *intrv1.ListCollectedRequest) (*intrv1.ListCollectedResponse, error) {
+	if request.GetLimit() <= 0 || request.GetLimit() > 100 || request.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset or limit error")
+	}
+	items, err := i.asv.ListCollected(ctx, request.GetUid(), request.GetBiz(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListCollectedResponse{
+		Items: slice.Map(items, i.toCollectionItemDTO),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) CreateCollection(ctx context.Context, request *intrv1.CreateCollectionRequest) (*intrv1.CreateCollectionResponse, error) {
+	id, err := i.csv.Create(ctx, i.toCollectionDomain(request.GetCollection()))
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.CreateCollectionResponse{Id: id}, nil
+}
+
+func (i *InteractiveServiceServer) UpdateCollection(ctx context.Context, request *intrv1.UpdateCollectionRequest) (*intrv1.UpdateCollectionResponse, error) {
+	err := i.csv.Update(ctx, i.toCollectionDomain(request.GetCollection()))
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.UpdateCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) DeleteCollection(ctx context.Context, request *intrv1.DeleteCollectionRequest) (*intrv1.DeleteCollectionResponse, error) {
+	err := i.csv.Delete(ctx, request.GetId(), request.GetUid())
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.DeleteCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollections(ctx context.Context, request *intrv1.ListCollectionsRequest) (*intrv1.ListCollectionsResponse, error) {
+	cols, err := i.csv.List(ctx, request.GetUid(), request.GetViewer())
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.ListCollectionsResponse{
+		Collections: slice.Map(cols, func(idx int, src domain.Collection) *intrv1.Collection {
+			return &intrv1.Collection{
+				Id:      src.Id,
+				Uid:     src.Uid,
+				Name:    src.Name,
+				Public:  src.Public,
+				ItemCnt: src.ItemCnt,
+				Ctime:   src.Ctime.UnixMilli(),
+				Utime:   src.Utime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollectionItems(ctx context.Context, request *intrv1.ListCollectionItemsRequest) (*intrv1.ListCollectionItemsResponse, error) {
+	if request.GetLimit() <= 0 || request.GetLimit() > 100 || request.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset or limit error")
+	}
+	items, err := i.csv.ListItems(ctx, request.GetCid(), request.GetViewer(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, i.toStatus(err)
+	}
+	return &intrv1.ListCollectionItemsResponse{
+		Items: slice.Map(items, i.toCollectionItemDTO),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) toCollectionItemDTO(idx int, src domain.CollectionItem) *intrv1.CollectionItem {
+	return &intrv1.CollectionItem{
+		Cid:   src.Cid,
+		Uid:   src.Uid,
+		Biz:   src.Biz,
+		BizId: src.BizId,
+		Ctime: src.Ctime.UnixMilli(),
+	}
+}
+
+func (i *InteractiveServiceServer) toCollectionDomain(c *intrv1.Collection) domain.Collection {
+	return domain.Collection{
+		Id:     c.GetId(),
+		Uid:    c.GetUid(),
+		Name:   c.GetName(),
+		Public: c.GetPublic(),
+	}
+}
+
+// toStatus 把收藏相关的业务错误转成 gRPC 的错误码
+func (i *InteractiveServiceServer) toStatus(err error) error {
+	switch {
+	case errors.Is(err, service.ErrCollectionNotFound),
+		errors.Is(err, service.ErrNotCollected):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrAlreadyCollected):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrInvalidCollectionName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
 }
 func (i *InteractiveServiceServer) Get(ctx context.Context, request *intrv1.GetRequest) (*intrv1.GetResponse, error) {
 	res, err := i.asv.Get(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
@@ -53,11 +209,21 @@ func (i *InteractiveServiceServer) toDTO(intr domain.Interactive) *intrv1.Intera
 		LikeCnt:    intr.LikedCnt,
 		Liked:      intr.Liked,
 		ReadCnt:    intr.ReadCnt,
+		CommentCnt: intr.CommentCnt,
+		UvCnt:      intr.UvCnt,
 	}
 }
 
 func (i *InteractiveServiceServer) GetByIds(ctx context.Context, request *intrv1.GetByIdsRequest) (*intrv1.GetByIdsResponse, error) {
-	res, err := i.asv.GetByIds(ctx, request.GetBiz(), request.GetIds())
+	var (
+		res map[int64]domain.Interactive
+		err error
+	)
+	if request.GetUid() > 0 {
+		res, err = i.asv.GetByIdsWithUser(ctx, request.GetBiz(), request.GetIds(), request.GetUid())
+	} else {
+		res, err = i.asv.GetByIds(ctx, request.GetBiz(), request.GetIds())
+	}
 	if err != nil {
 		return nil, err
 	}
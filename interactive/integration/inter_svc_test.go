@@ -46,6 +46,7 @@ func (s *InteractiveTestSuite) TestIncrReadCnt() {
 
 		biz   string
 		bizId int64
+		uid   int64
 
 		wantErr error
 	}{
@@ -167,6 +168,64 @@ func (s *InteractiveTestSuite) TestIncrReadCnt() {
 			biz:   "test",
 			bizId: 4,
 		},
+		{
+			name:   "登录用户第一次阅读",
+			before: func(t *testing.T) {},
+			after: func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+				defer cancel()
+				var data dao.Interactive
+				err := s.db.Where("biz = ? AND biz_id = ?", "test", 5).First(&data).Error
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), data.ReadCnt)
+				ok, err := s.rdb.Exists(ctx, "interactive:read:test:5:123").Result()
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), ok)
+				uv, err := s.rdb.PFCount(ctx, "interactive:uv:test:5").Result()
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), uv)
+				dirty, err := s.rdb.SIsMember(ctx, "interactive:uv:dirty", "test:5").Result()
+				assert.NoError(t, err)
+				assert.True(t, dirty)
+			},
+			biz:   "test",
+			bizId: 5,
+			uid:   123,
+		},
+		{
+			// 去重窗口内重复阅读，阅读数不变
+			name: "登录用户重复阅读",
+			before: func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+				defer cancel()
+				err := s.db.Create(dao.Interactive{
+					Id:      6,
+					Biz:     "test",
+					BizId:   6,
+					ReadCnt: 3,
+					Ctime:   6,
+					Utime:   7,
+				}).Error
+				assert.NoError(t, err)
+				err = s.rdb.Set(ctx, "interactive:read:test:6:123", 1, time.Minute).Err()
+				assert.NoError(t, err)
+			},
+			after: func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+				defer cancel()
+				var data dao.Interactive
+				err := s.db.Where("id = ?", 6).First(&data).Error
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), data.ReadCnt)
+				assert.Equal(t, int64(7), data.Utime)
+				uv, err := s.rdb.PFCount(ctx, "interactive:uv:test:6").Result()
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), uv)
+			},
+			biz:   "test",
+			bizId: 6,
+			uid:   123,
+		},
 	}
 
 	// 不同于 AsyncSms 服务，我们不需要 mock，所以创建一个就可以
@@ -175,7 +234,7 @@ func (s *InteractiveTestSuite) TestIncrReadCnt() {
 	for _, tc := range testCases {
 		s.T().Run(tc.name, func(t *testing.T) {
 			tc.before(t)
-			err := svc.IncrReadCnt(context.Background(), tc.biz, tc.bizId)
+			err := svc.IncrReadCnt(context.Background(), tc.biz, tc.bizId, tc.uid)
 			assert.Equal(t, tc.wantErr, err)
 			tc.after(t)
 		})
@@ -752,10 +811,33 @@ func (s *InteractiveTestSuite) TestGetByIds() {
 			},
 		},
 		{
-			name:    "没有对应的数据",
-			biz:     "test",
-			ids:     []int64{100, 200},
-			wantRes: map[int64]domain.Interactive{},
+			// 缓存里面已经有 1 了，3 要查数据库
+			name: "部分命中缓存",
+			biz:  "test",
+			ids:  []int64{1, 3},
+			wantRes: map[int64]domain.Interactive{
+				1: {
+					BizId:      1,
+					ReadCnt:    1,
+					CollectCnt: 2,
+					LikedCnt:   3,
+				},
+				3: {
+					BizId:      3,
+					ReadCnt:    3,
+					CollectCnt: 4,
+					LikedCnt:   5,
+				},
+			},
+		},
+		{
+			name: "没有对应的数据",
+			biz:  "test",
+			ids:  []int64{100, 200},
+			wantRes: map[int64]domain.Interactive{
+				100: {BizId: 100},
+				200: {BizId: 200},
+			},
 		},
 	}
 
@@ -767,6 +849,94 @@ func (s *InteractiveTestSuite) TestGetByIds() {
 			assert.Equal(t, tc.wantRes, res)
 		})
 	}
+	// 没有数据的也回写了缓存
+	cnt, err := s.rdb.Exists(context.Background(), "interactive:test:100").Result()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), cnt)
+}
+
+func (s *InteractiveTestSuite) TestGetByIdsWithUser() {
+	preCtx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	err := s.db.WithContext(preCtx).Create(&dao.Interactive{
+		Biz:        "test",
+		BizId:      1,
+		ReadCnt:    1,
+		CollectCnt: 2,
+		LikeCnt:    3,
+	}).Error
+	assert.NoError(s.T(), err)
+	err = s.db.WithContext(preCtx).Create([]dao.UserLikeBiz{
+		{Biz: "test", BizId: 1, Uid: 123, Status: 1},
+		// 取消了点赞
+		{Biz: "test", BizId: 2, Uid: 123, Status: 0},
+	}).Error
+	assert.NoError(s.T(), err)
+	err = s.db.WithContext(preCtx).Create(&dao.UserCollectionBiz{
+		Biz: "test", BizId: 2, Uid: 123, Cid: 1,
+	}).Error
+	assert.NoError(s.T(), err)
+
+	svc := startup.NewInteractiveService()
+	res, err := svc.GetByIdsWithUser(context.Background(), "test", []int64{1, 2}, 123)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), map[int64]domain.Interactive{
+		1: {
+			BizId:      1,
+			ReadCnt:    1,
+			CollectCnt: 2,
+			LikedCnt:   3,
+			Liked:      true,
+		},
+		2: {
+			BizId:     2,
+			Collected: true,
+		},
+	}, res)
+}
+
+func (s *InteractiveTestSuite) TestSyncUvCnt() {
+	preCtx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	err := s.db.WithContext(preCtx).Create(&dao.Interactive{
+		Biz:     "test",
+		BizId:   1,
+		ReadCnt: 10,
+		UvCnt:   1,
+	}).Error
+	assert.NoError(s.T(), err)
+	// 缓存里面是旧的访客数
+	err = s.rdb.HSet(preCtx, "interactive:test:1", "read_cnt", 10, "uv_cnt", 1).Err()
+	assert.NoError(s.T(), err)
+
+	svc := startup.NewInteractiveService()
+	for _, uid := range []int64{1, 2, 3, 1} {
+		err = svc.IncrReadCnt(context.Background(), "test", 1, uid)
+		assert.NoError(s.T(), err)
+	}
+	// 数据库里面还没有的也要落库
+	err = svc.IncrReadCnt(context.Background(), "test", 2, 1)
+	assert.NoError(s.T(), err)
+
+	n, err := svc.SyncUvCnt(context.Background(), 10)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, n)
+	// 已经落库的不会再处理
+	n, err = svc.SyncUvCnt(context.Background(), 10)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, n)
+
+	intr, err := svc.Get(context.Background(), "test", 1, 0)
+	assert.NoError(s.T(), err)
+	// uid 1 重复阅读只算一次
+	assert.Equal(s.T(), int64(13), intr.ReadCnt)
+	assert.Equal(s.T(), int64(3), intr.UvCnt)
+	intr, err = svc.Get(context.Background(), "test", 2, 0)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), intr.ReadCnt)
+	assert.Equal(s.T(), int64(1), intr.UvCnt)
 }
 
 func TestInteractiveService(t *testing.T) {
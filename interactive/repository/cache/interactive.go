@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	_ "embed"
 	"fmt"
 	"github.com/redis/go-redis/v9"
 	"strconv"
@@ -10,13 +11,18 @@ import (
 )
 
 var (
+	//go:embed lua/interactive_incr_cnt.lua
 	luaIncrReadCnt string
+	//go:embed lua/interactive_set_cnt.lua
+	luaSetCnt string
 )
 
 const (
 	fileReadCnt    = "read_cnt"
 	fileCollectCnt = "collect_cnt"
 	fileLikeCnt    = "like_cnt"
+	fileCommentCnt = "comment_cnt"
+	fileUvCnt      = "uv_cnt"
 )
 
 type InteractiveCache interface {
@@ -28,15 +34,34 @@ type InteractiveCache interface {
 	DecrLikeCntIfPresent(ctx context.Context,
 		biz string, bizId int64) error
 	IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	IncrCommentCntIfPresent(ctx context.Context, biz string, bizId int64, delta int64) error
+	// SetUvCntIfPresent 如果在缓存中有对应的数据，就把访客数改成 cnt
+	SetUvCntIfPresent(ctx context.Context, biz string, bizId int64, cnt int64) error
 	// Get 查询缓存中数据
 	// 事实上，这里 liked 和 collected 是不需要缓存的
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error
+	// MGet 一次 pipeline 查询多个，返回的只有缓存里面有的
+	MGet(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// MSet 一次 pipeline 回写多个
+	MSet(ctx context.Context, biz string, intrs map[int64]domain.Interactive) error
+	Del(ctx context.Context, biz string, bizId int64) error
+
+	// AddReaders 记录一批阅读，三个切片一一对应，uid 小于等于 0 的是未登录用户，不去重也不算访客
+	// 返回每一次阅读是不是这个用户在去重窗口内第一次读，是的话阅读数才 +1
+	AddReaders(ctx context.Context, bizs []string, bizIds []int64, uids []int64) ([]bool, error)
+	// PopUv 取出最多 limit 个有新访客的资源，带上 HyperLogLog 估算的访客数
+	PopUv(ctx context.Context, limit int) ([]domain.Interactive, error)
+	// MarkUvDirty 落库失败的时候放回去，下一次再处理
+	MarkUvDirty(ctx context.Context, biz string, bizId int64) error
 }
 
 type RedisInteractiveCache struct {
 	client     redis.Cmdable
 	expiration time.Duration
+	// readWindow 同一个用户在这段时间内重复阅读只算一次
+	readWindow time.Duration
 }
 
 func (r *RedisInteractiveCache) key(biz string, bizId int64) string {
@@ -67,6 +92,24 @@ func (r *RedisInteractiveCache) IncrCollectCntIfPresent(ctx context.Context, biz
 		fileCollectCnt, 1).Err()
 }
 
+func (r *RedisInteractiveCache) DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error {
+	return r.client.Eval(ctx, luaIncrReadCnt,
+		[]string{r.key(biz, bizId)},
+		fileCollectCnt, -1).Err()
+}
+
+func (r *RedisInteractiveCache) IncrCommentCntIfPresent(ctx context.Context, biz string, bizId int64, delta int64) error {
+	return r.client.Eval(ctx, luaIncrReadCnt,
+		[]string{r.key(biz, bizId)},
+		fileCommentCnt, delta).Err()
+}
+
+func (r *RedisInteractiveCache) SetUvCntIfPresent(ctx context.Context, biz string, bizId int64, cnt int64) error {
+	return r.client.Eval(ctx, luaSetCnt,
+		[]string{r.key(biz, bizId)},
+		fileUvCnt, cnt).Err()
+}
+
 func (r *RedisInteractiveCache) Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error) {
 	data, err := r.client.HGetAll(ctx, r.key(biz, bizId)).Result()
 	if err != nil {
@@ -79,28 +122,94 @@ func (r *RedisInteractiveCache) Get(ctx context.Context, biz string, bizId int64
 	collectCnt, _ := strconv.ParseInt(data[fileCollectCnt], 10, 64)
 	likeCnt, _ := strconv.ParseInt(data[fileLikeCnt], 10, 64)
 	readCnt, _ := strconv.ParseInt(data[fileReadCnt], 10, 64)
+	commentCnt, _ := strconv.ParseInt(data[fileCommentCnt], 10, 64)
+	uvCnt, _ := strconv.ParseInt(data[fileUvCnt], 10, 64)
 
 	return domain.Interactive{
 		CollectCnt: collectCnt,
 		LikedCnt:   likeCnt,
 		ReadCnt:    readCnt,
+		CommentCnt: commentCnt,
+		UvCnt:      uvCnt,
 	}, err
 }
 
+func (r *RedisInteractiveCache) MGet(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(bizIds))
+	for _, id := range bizIds {
+		cmds = append(cmds, pipe.HGetAll(ctx, r.key(biz, id)))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]domain.Interactive, len(bizIds))
+	for i, cmd := range cmds {
+		data := cmd.Val()
+		if len(data) == 0 {
+			continue
+		}
+		intr := r.toDomain(data)
+		intr.BizId = bizIds[i]
+		res[bizIds[i]] = intr
+	}
+	return res, nil
+}
+
+func (r *RedisInteractiveCache) MSet(ctx context.Context, biz string, intrs map[int64]domain.Interactive) error {
+	pipe := r.client.Pipeline()
+	for id, intr := range intrs {
+		key := r.key(biz, id)
+		pipe.HSet(ctx, key,
+			fileReadCnt, intr.ReadCnt,
+			fileLikeCnt, intr.LikedCnt,
+			fileCollectCnt, intr.CollectCnt,
+			fileCommentCnt, intr.CommentCnt,
+			fileUvCnt, intr.UvCnt)
+		pipe.Expire(ctx, key, r.expiration)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisInteractiveCache) toDomain(data map[string]string) domain.Interactive {
+	collectCnt, _ := strconv.ParseInt(data[fileCollectCnt], 10, 64)
+	likeCnt, _ := strconv.ParseInt(data[fileLikeCnt], 10, 64)
+	readCnt, _ := strconv.ParseInt(data[fileReadCnt], 10, 64)
+	commentCnt, _ := strconv.ParseInt(data[fileCommentCnt], 10, 64)
+	uvCnt, _ := strconv.ParseInt(data[fileUvCnt], 10, 64)
+	return domain.Interactive{
+		CollectCnt: collectCnt,
+		LikedCnt:   likeCnt,
+		ReadCnt:    readCnt,
+		CommentCnt: commentCnt,
+		UvCnt:      uvCnt,
+	}
+}
+
 func (r *RedisInteractiveCache) Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error {
 	key := r.key(biz, bizId)
 	err := r.client.HMSet(ctx, key,
 		fileReadCnt, intr.ReadCnt,
 		fileLikeCnt, intr.LikedCnt,
-		fileCollectCnt, intr.CollectCnt).Err()
+		fileCollectCnt, intr.CollectCnt,
+		fileCommentCnt, intr.CommentCnt,
+		fileUvCnt, intr.UvCnt).Err()
 	if err != nil {
 		return err
 	}
 	return r.client.Expire(ctx, key, r.expiration).Err()
 }
 
+func (r *RedisInteractiveCache) Del(ctx context.Context, biz string, bizId int64) error {
+	return r.client.Del(ctx, r.key(biz, bizId)).Err()
+}
+
 func NewRedisInteractiveCache(client redis.Cmdable) InteractiveCache {
 	return &RedisInteractiveCache{
-		client: client,
+		client:     client,
+		expiration: time.Minute * 15,
+		readWindow: time.Minute * 30,
 	}
 }
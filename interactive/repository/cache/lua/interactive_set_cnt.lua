@@ -0,0 +1,12 @@
+local key = KEYS[1]
+-- 对应到的是 hset 中的 field
+local cntKey = ARGV[1]
+local val = tonumber(ARGV[2])
+local exists = redis.call("EXISTS", key)
+if exists == 1 then
+    redis.call("HSET", key, cntKey, val)
+    return 1
+else
+    -- 缓存里面没有，下一次从数据库加载
+    return 0
+end
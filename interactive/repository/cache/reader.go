@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"strings"
+	"we_book/interactive/domain"
+)
+
+// uvDirtyKey 有新访客、还没有落库的资源，成员是 biz:bizId
+const uvDirtyKey = "interactive:uv:dirty"
+
+func (r *RedisInteractiveCache) readKey(biz string, bizId, uid int64) string {
+	return fmt.Sprintf("interactive:read:%s:%d:%d", biz, bizId, uid)
+}
+
+func (r *RedisInteractiveCache) uvKey(biz string, bizId int64) string {
+	return fmt.Sprintf("interactive:uv:%s:%d", biz, bizId)
+}
+
+func (r *RedisInteractiveCache) uvMember(biz string, bizId int64) string {
+	return fmt.Sprintf("%s:%d", biz, bizId)
+}
+
+func (r *RedisInteractiveCache) AddReaders(ctx context.Context, bizs []string, bizIds []int64, uids []int64) ([]bool, error) {
+	res := make([]bool, len(bizIds))
+	cmds := make([]*redis.BoolCmd, len(bizIds))
+	pipe := r.client.Pipeline()
+	for i := range bizIds {
+		if uids[i] <= 0 {
+			// 未登录的没法去重
+			res[i] = true
+			continue
+		}
+		cmds[i] = pipe.SetNX(ctx, r.readKey(bizs[i], bizIds[i], uids[i]), 1, r.readWindow)
+		pipe.PFAdd(ctx, r.uvKey(bizs[i], bizIds[i]), uids[i])
+		pipe.SAdd(ctx, uvDirtyKey, r.uvMember(bizs[i], bizIds[i]))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, cmd := range cmds {
+		if cmd != nil {
+			res[i] = cmd.Val()
+		}
+	}
+	return res, nil
+}
+
+func (r *RedisInteractiveCache) PopUv(ctx context.Context, limit int) ([]domain.Interactive, error) {
+	members, err := r.client.SPopN(ctx, uvDirtyKey, int64(limit)).Result()
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+	res := make([]domain.Interactive, 0, len(members))
+	cmds := make([]*redis.IntCmd, 0, len(members))
+	pipe := r.client.Pipeline()
+	for _, m := range members {
+		idx := strings.LastIndex(m, ":")
+		bizId, er := strconv.ParseInt(m[idx+1:], 10, 64)
+		if idx <= 0 || er != nil {
+			continue
+		}
+		res = append(res, domain.Interactive{Biz: m[:idx], BizId: bizId})
+		cmds = append(cmds, pipe.PFCount(ctx, r.uvKey(m[:idx], bizId)))
+	}
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		// 放回去，下一次再处理
+		args := make([]any, 0, len(members))
+		for _, m := range members {
+			args = append(args, m)
+		}
+		_ = r.client.SAdd(ctx, uvDirtyKey, args...).Err()
+		return nil, err
+	}
+	for i, cmd := range cmds {
+		res[i].UvCnt = cmd.Val()
+	}
+	return res, nil
+}
+
+func (r *RedisInteractiveCache) MarkUvDirty(ctx context.Context, biz string, bizId int64) error {
+	return r.client.SAdd(ctx, uvDirtyKey, r.uvMember(biz, bizId)).Err()
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository/cache"
+	"we_book/interactive/repository/dao"
+	"we_book/pkg/logger"
+)
+
+var (
+	ErrCollectionNotFound    = dao.ErrCollectionNotFound
+	ErrDuplicateCollect      = dao.ErrDuplicateCollect
+	ErrCollectionBizNotFound = dao.ErrCollectionBizNotFound
+)
+
+//go:generate mockgen -source=./collection.go -destination=mocks/collection.mock.go -package=repomocks CollectionRepository
+type CollectionRepository interface {
+	Create(ctx context.Context, c domain.Collection) (int64, error)
+	Update(ctx context.Context, c domain.Collection) error
+	// Delete 连同里面的收藏一起删掉
+	Delete(ctx context.Context, id, uid int64) error
+	GetById(ctx context.Context, id int64) (domain.Collection, error)
+	// ListByUid onlyPublic 为 true 的时候只查公开的
+	ListByUid(ctx context.Context, uid int64, onlyPublic bool) ([]domain.Collection, error)
+	// CountItems 按照收藏夹统计 uid 的收藏数量
+	CountItems(ctx context.Context, uid int64) (map[int64]int64, error)
+	ListItems(ctx context.Context, cid, uid int64, offset int, limit int) ([]domain.CollectionItem, error)
+	MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error
+	// RemoveItem 取消收藏
+	RemoveItem(ctx context.Context, biz string, bizId, uid int64) error
+}
+
+type CacheCollectionRepository struct {
+	dao     dao.CollectionDAO
+	intrDAO dao.InteractiveDAO
+	cache   cache.InteractiveCache
+	l       logger.V1
+}
+
+func NewCollectionRepository(dao dao.CollectionDAO, intrDAO dao.InteractiveDAO,
+	cache cache.InteractiveCache, l logger.V1) CollectionRepository {
+	return &CacheCollectionRepository{
+		dao:     dao,
+		intrDAO: intrDAO,
+		cache:   cache,
+		l:       l,
+	}
+}
+
+func (c *CacheCollectionRepository) Create(ctx context.Context, col domain.Collection) (int64, error) {
+	return c.dao.Insert(ctx, c.toEntity(col))
+}
+
+func (c *CacheCollectionRepository) Update(ctx context.Context, col domain.Collection) error {
+	return c.dao.Update(ctx, c.toEntity(col))
+}
+
+func (c *CacheCollectionRepository) Delete(ctx context.Context, id, uid int64) error {
+	items, err := c.dao.Delete(ctx, id, uid)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		er := c.cache.DecrCollectCntIfPresent(ctx, item.Biz, item.BizId)
+		if er != nil {
+			c.l.Error("decr collect cnt cache failed",
+				logger.String("biz", item.Biz),
+				logger.Int64("biz_id", item.BizId),
+				logger.Error(er))
+		}
+	}
+	return nil
+}
+
+func (c *CacheCollectionRepository) GetById(ctx context.Context, id int64) (domain.Collection, error) {
+	col, err := c.dao.GetById(ctx, id)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	return c.toDomain(col), nil
+}
+
+func (c *CacheCollectionRepository) ListByUid(ctx context.Context, uid int64, onlyPublic bool) ([]domain.Collection, error) {
+	cols, err := c.dao.GetByUid(ctx, uid, onlyPublic)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(cols, func(idx int, src dao.Collection) domain.Collection {
+		return c.toDomain(src)
+	}), nil
+}
+
+func (c *CacheCollectionRepository) CountItems(ctx context.Context, uid int64) (map[int64]int64, error) {
+	return c.dao.CountItems(ctx, uid)
+}
+
+func (c *CacheCollectionRepository) ListItems(ctx context.Context, cid, uid int64, offset int, limit int) ([]domain.CollectionItem, error) {
+	items, err := c.dao.ListItems(ctx, cid, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, func(idx int, src dao.UserCollectionBiz) domain.CollectionItem {
+		return domain.CollectionItem{
+			Cid:   src.Cid,
+			Uid:   src.Uid,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Ctime: time.UnixMilli(src.Ctime),
+		}
+	}), nil
+}
+
+func (c *CacheCollectionRepository) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	return c.dao.MoveItem(ctx, biz, bizId, uid, cid)
+}
+
+func (c *CacheCollectionRepository) RemoveItem(ctx context.Context, biz string, bizId, uid int64) error {
+	err := c.intrDAO.DeleteCollectionBiz(ctx, biz, bizId, uid)
+	if err != nil {
+		return err
+	}
+	return c.cache.DecrCollectCntIfPresent(ctx, biz, bizId)
+}
+
+func (c *CacheCollectionRepository) toEntity(col domain.Collection) dao.Collection {
+	return dao.Collection{
+		Id:     col.Id,
+		Uid:    col.Uid,
+		Name:   col.Name,
+		Public: col.Public,
+	}
+}
+
+func (c *CacheCollectionRepository) toDomain(col dao.Collection) domain.Collection {
+	return domain.Collection{
+		Id:     col.Id,
+		Uid:    col.Uid,
+		Name:   col.Name,
+		Public: col.Public,
+		Ctime:  time.UnixMilli(col.Ctime),
+		Utime:  time.UnixMilli(col.Utime),
+	}
+}
@@ -0,0 +1,173 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CollectionDAO 收藏夹，收藏记录本身在 InteractiveDAO 里面
+type CollectionDAO interface {
+	Insert(ctx context.Context, c Collection) (int64, error)
+	// Update 修改名字和是否公开，只能改自己的
+	Update(ctx context.Context, c Collection) error
+	// Delete 删除收藏夹以及里面的收藏，返回被删除的收藏，用来更新缓存
+	Delete(ctx context.Context, id, uid int64) ([]UserCollectionBiz, error)
+	GetById(ctx context.Context, id int64) (Collection, error)
+	// GetByUid onlyPublic 为 true 的时候只查公开的
+	GetByUid(ctx context.Context, uid int64, onlyPublic bool) ([]Collection, error)
+	// CountItems 按照收藏夹统计收藏数量，没有收藏的不在结果里面
+	CountItems(ctx context.Context, uid int64) (map[int64]int64, error)
+	// ListItems 收藏夹里面的收藏，按照收藏时间倒序
+	ListItems(ctx context.Context, cid, uid int64, offset int, limit int) ([]UserCollectionBiz, error)
+	// MoveItem 把收藏移动到 cid，cid 大于 0 的时候收藏夹必须是 uid 的
+	MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error
+}
+
+type GORMCollectionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollectionDAO(db *gorm.DB) CollectionDAO {
+	return &GORMCollectionDAO{db: db}
+}
+
+func (g *GORMCollectionDAO) Insert(ctx context.Context, c Collection) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := g.db.WithContext(ctx).Create(&c).Error
+	return c.Id, err
+}
+
+func (g *GORMCollectionDAO) Update(ctx context.Context, c Collection) error {
+	res := g.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ? AND uid = ?", c.Id, c.Uid).
+		Updates(map[string]any{
+			"name":   c.Name,
+			"public": c.Public,
+			"utime":  time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+func (g *GORMCollectionDAO) Delete(ctx context.Context, id, uid int64) ([]UserCollectionBiz, error) {
+	var items []UserCollectionBiz
+	now := time.Now().UnixMilli()
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND uid = ?", id, uid).Delete(&Collection{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrCollectionNotFound
+		}
+		err := tx.Where("cid = ? AND uid = ?", id, uid).Find(&items).Error
+		if err != nil || len(items) == 0 {
+			return err
+		}
+		err = tx.Where("cid = ? AND uid = ?", id, uid).Delete(&UserCollectionBiz{}).Error
+		if err != nil {
+			return err
+		}
+		// 每个资源只会被这个用户收藏一次，所以都是减一
+		for _, item := range items {
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ? AND collect_cnt > 0", item.Biz, item.BizId).
+				Updates(map[string]any{
+					"collect_cnt": gorm.Expr("collect_cnt - 1"),
+					"utime":       now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return items, err
+}
+
+func (g *GORMCollectionDAO) GetById(ctx context.Context, id int64) (Collection, error) {
+	var res Collection
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return res, ErrCollectionNotFound
+	}
+	return res, err
+}
+
+func (g *GORMCollectionDAO) GetByUid(ctx context.Context, uid int64, onlyPublic bool) ([]Collection, error) {
+	var res []Collection
+	db := g.db.WithContext(ctx).Where("uid = ?", uid)
+	if onlyPublic {
+		db = db.Where("public = ?", true)
+	}
+	err := db.Order("id ASC").Find(&res).Error
+	return res, err
+}
+
+func (g *GORMCollectionDAO) CountItems(ctx context.Context, uid int64) (map[int64]int64, error) {
+	var rows []struct {
+		Cid int64
+		Cnt int64
+	}
+	err := g.db.WithContext(ctx).Model(&UserCollectionBiz{}).
+		Select("cid, COUNT(*) AS cnt").
+		Where("uid = ?", uid).
+		Group("cid").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		res[row.Cid] = row.Cnt
+	}
+	return res, nil
+}
+
+func (g *GORMCollectionDAO) ListItems(ctx context.Context, cid, uid int64, offset int, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := g.db.WithContext(ctx).
+		Where("cid = ? AND uid = ?", cid, uid).
+		Order("ctime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMCollectionDAO) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if cid > 0 {
+			var c Collection
+			err := tx.Where("id = ? AND uid = ?", cid, uid).First(&c).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCollectionNotFound
+			}
+			if err != nil {
+				return err
+			}
+		}
+		res := tx.Model(&UserCollectionBiz{}).
+			Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, bizId).
+			Updates(map[string]any{
+				"cid":   cid,
+				"utime": time.Now().UnixMilli(),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		// utime 一定会变，所以 0 就是没有收藏
+		if res.RowsAffected == 0 {
+			return ErrCollectionBizNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type ReadHistoryDAO interface {
+	// Upsert 已经有记录的时候更新阅读时间，Progress 为 0 的时候保留原来的进度
+	Upsert(ctx context.Context, h ReadHistory) error
+	// List 按照阅读时间倒序，biz 为空的时候查全部
+	List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]ReadHistory, error)
+	Delete(ctx context.Context, uid int64, biz string, bizId int64) error
+	// Clear 删除 uid 全部的阅读记录
+	Clear(ctx context.Context, uid int64) error
+	// ListOverflowUids 阅读记录超过 keep 条的用户
+	ListOverflowUids(ctx context.Context, keep int, limit int) ([]int64, error)
+	// Trim 只保留 uid 最近的 keep 条，返回删除了多少条
+	Trim(ctx context.Context, uid int64, keep int) (int64, error)
+}
+
+type GORMReadHistoryDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMReadHistoryDAO(db *gorm.DB) ReadHistoryDAO {
+	return &GORMReadHistoryDAO{db: db}
+}
+
+func (g *GORMReadHistoryDAO) Upsert(ctx context.Context, h ReadHistory) error {
+	now := time.Now().UnixMilli()
+	h.Ctime = now
+	h.Utime = now
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			// 消息可能乱序，阅读时间只往后走
+			"rtime":    gorm.Expr("GREATEST(rtime, ?)", h.Rtime),
+			"progress": gorm.Expr("IF(? > 0, ?, progress)", h.Progress, h.Progress),
+			"utime":    now,
+		}),
+	}).Create(&h).Error
+}
+
+func (g *GORMReadHistoryDAO) List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]ReadHistory, error) {
+	var res []ReadHistory
+	db := g.db.WithContext(ctx).Where("uid = ?", uid)
+	if biz != "" {
+		db = db.Where("biz = ?", biz)
+	}
+	err := db.Order("rtime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMReadHistoryDAO) Delete(ctx context.Context, uid int64, biz string, bizId int64) error {
+	return g.db.WithContext(ctx).
+		Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, bizId).
+		Delete(&ReadHistory{}).Error
+}
+
+func (g *GORMReadHistoryDAO) Clear(ctx context.Context, uid int64) error {
+	return g.db.WithContext(ctx).Where("uid = ?", uid).Delete(&ReadHistory{}).Error
+}
+
+func (g *GORMReadHistoryDAO) ListOverflowUids(ctx context.Context, keep int, limit int) ([]int64, error) {
+	var res []int64
+	err := g.db.WithContext(ctx).Model(&ReadHistory{}).
+		Select("uid").
+		Group("uid").
+		Having("COUNT(*) > ?", keep).
+		Limit(limit).
+		Pluck("uid", &res).Error
+	return res, err
+}
+
+func (g *GORMReadHistoryDAO) Trim(ctx context.Context, uid int64, keep int) (int64, error) {
+	// 第 keep 条之后的都删掉，阅读时间相同的按照 ID 排
+	var ids []int64
+	err := g.db.WithContext(ctx).Model(&ReadHistory{}).
+		Where("uid = ?", uid).
+		Order("rtime DESC, id DESC").
+		Offset(keep).Limit(1000).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	res := g.db.WithContext(ctx).Where("id IN ?", ids).Delete(&ReadHistory{})
+	return res.RowsAffected, res.Error
+}
+
+type ReadHistory struct {
+	Id       int64  `gorm:"primaryKey,autoIncrement"`
+	Uid      int64  `gorm:"uniqueIndex:uid_biz_type_id;index:uid_rtime"`
+	Biz      string `gorm:"uniqueIndex:uid_biz_type_id;type:varchar(128)"`
+	BizId    int64  `gorm:"uniqueIndex:uid_biz_type_id"`
+	Progress int
+	// Rtime 最近一次阅读的时间，毫秒
+	Rtime int64 `gorm:"index:uid_rtime"`
+	Ctime int64
+	Utime int64
+}
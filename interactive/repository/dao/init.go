@@ -3,10 +3,49 @@ package dao
 import "gorm.io/gorm"
 
 func InitTable(db *gorm.DB) error {
+	// AutoMigrate 只会加索引不会删索引，旧的索引要先手动删掉
+	err := dropLegacyIndexes(db)
+	if err != nil {
+		return err
+	}
 	return db.AutoMigrate(
 		&Interactive{},
 		&UserLikeBiz{},
 		&Collection{},
 		&UserCollectionBiz{},
+		&ReadHistory{},
 	)
 }
+
+// legacyIndex 已经被替换掉的索引
+type legacyIndex struct {
+	model any
+	names []string
+}
+
+// legacyIndexes 早期每一列都是单独的唯一索引，
+// 同一个用户没办法点赞、收藏两个资源，也没办法建两个收藏夹
+var legacyIndexes = []legacyIndex{
+	{model: &UserLikeBiz{}, names: []string{"idx_biz_id", "idx_biz_type", "idx_uid"}},
+	{model: &UserCollectionBiz{}, names: []string{"idx_cid", "idx_biz_id", "idx_biz_type", "idx_uid"}},
+	{model: &Collection{}, names: []string{"idx_uid"}},
+}
+
+func dropLegacyIndexes(db *gorm.DB) error {
+	m := db.Migrator()
+	for _, idx := range legacyIndexes {
+		if !m.HasTable(idx.model) {
+			continue
+		}
+		for _, name := range idx.names {
+			if !m.HasIndex(idx.model, name) {
+				continue
+			}
+			err := m.DropIndex(idx.model, name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
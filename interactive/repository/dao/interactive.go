@@ -2,12 +2,22 @@ package dao
 
 import (
 	"context"
+	"errors"
+	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
 )
 
-var ErrRecordNotFound = gorm.ErrRecordNotFound
+var (
+	ErrRecordNotFound = gorm.ErrRecordNotFound
+	// ErrCollectionNotFound 收藏夹不存在，或者不是这个用户的
+	ErrCollectionNotFound = errors.New("收藏夹不存在")
+	// ErrDuplicateCollect 一个用户只能收藏一次，要换收藏夹用移动
+	ErrDuplicateCollect = errors.New("重复收藏")
+	// ErrCollectionBizNotFound 用户没有收藏过
+	ErrCollectionBizNotFound = errors.New("没有收藏")
+)
 
 type InteractiveDAO interface {
 	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
@@ -15,9 +25,28 @@ type InteractiveDAO interface {
 	GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error)
 	DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error
 	Get(ctx context.Context, biz string, bizId int64) (Interactive, error)
+	// GetByIds 一条 IN 查询，没有记录的 ID 不在结果里面
+	GetByIds(ctx context.Context, biz string, bizIds []int64) ([]Interactive, error)
+	// LikedBizIds bizIds 里面 uid 点赞了的
+	LikedBizIds(ctx context.Context, biz string, bizIds []int64, uid int64) ([]int64, error)
+	// CollectedBizIds bizIds 里面 uid 收藏了的
+	CollectedBizIds(ctx context.Context, biz string, bizIds []int64, uid int64) ([]int64, error)
+	// InsertCollectionBiz cid 大于 0 的时候，收藏夹必须是 uid 的
 	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error
+	// DeleteCollectionBiz 取消收藏，同时减少收藏数
+	DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error
+	// ListLikedByUid 用户点赞过的，按照点赞时间倒序，biz 为空的时候查全部
+	ListLikedByUid(ctx context.Context, uid int64, biz string, offset int, limit int) ([]UserLikeBiz, error)
+	// ListCollectedByUid 用户收藏过的，不分收藏夹，按照收藏时间倒序，biz 为空的时候查全部
+	ListCollectedByUid(ctx context.Context, uid int64, biz string, offset int, limit int) ([]UserCollectionBiz, error)
 	GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (UserCollectionBiz, error)
 	BatchIncrReadCnt(ctx context.Context, ids []int64, biz []string) error
+	// SetUvCnt 覆盖独立访客数，HyperLogLog 的估算值是累计的，不需要加
+	SetUvCnt(ctx context.Context, biz string, bizId int64, cnt int64) error
+	// IncrCommentCnt delta 为 1 或者 -1
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	// Delete 删除计数以及点赞、收藏记录
+	Delete(ctx context.Context, biz string, bizId int64) error
 }
 
 type GORMInteractiveDAO struct {
@@ -53,6 +82,47 @@ func (G *GORMInteractiveDAO) IncrReadCnt(ctx context.Context, biz string, bizId
 	}).Error
 }
 
+func (G *GORMInteractiveDAO) SetUvCnt(ctx context.Context, biz string, bizId int64, cnt int64) error {
+	now := time.Now().UnixMilli()
+	return G.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"uv_cnt": cnt,
+			"utime":  now,
+		}),
+	}).Create(&Interactive{
+		BizId: bizId,
+		Biz:   biz,
+		UvCnt: cnt,
+		Ctime: now,
+		Utime: now,
+	}).Error
+}
+
+func (G *GORMInteractiveDAO) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	now := time.Now().UnixMilli()
+	if delta < 0 {
+		// 删评论只更新已有的计数，没有记录的时候什么也不做，不会插入负数
+		return G.db.WithContext(ctx).Model(&Interactive{}).
+			Where("biz = ? AND biz_id = ? AND comment_cnt >= ?", biz, bizId, -delta).
+			Updates(map[string]any{
+				"comment_cnt": gorm.Expr("comment_cnt + ?", delta),
+				"utime":       now,
+			}).Error
+	}
+	return G.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"comment_cnt": gorm.Expr("comment_cnt + ?", delta),
+			"utime":       now,
+		}),
+	}).Create(&Interactive{
+		BizId:      bizId,
+		Biz:        biz,
+		CommentCnt: delta,
+		Ctime:      now,
+		Utime:      now,
+	}).Error
+}
+
 func (G *GORMInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
 	now := time.Now().UnixMilli()
 	// 同时记录点赞和点赞数
@@ -112,13 +182,54 @@ func (G *GORMInteractiveDAO) Get(ctx context.Context, biz string, bizId int64) (
 	return res, err
 }
 
+func (G *GORMInteractiveDAO) GetByIds(ctx context.Context, biz string, bizIds []int64) ([]Interactive, error) {
+	var res []Interactive
+	err := G.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ?", biz, bizIds).
+		Find(&res).Error
+	return res, err
+}
+
+func (G *GORMInteractiveDAO) LikedBizIds(ctx context.Context, biz string, bizIds []int64, uid int64) ([]int64, error) {
+	var res []int64
+	err := G.db.WithContext(ctx).Model(&UserLikeBiz{}).
+		Where("biz = ? AND biz_id IN ? AND uid = ? AND status = ?", biz, bizIds, uid, 1).
+		Pluck("biz_id", &res).Error
+	return res, err
+}
+
+func (G *GORMInteractiveDAO) CollectedBizIds(ctx context.Context, biz string, bizIds []int64, uid int64) ([]int64, error) {
+	var res []int64
+	err := G.db.WithContext(ctx).Model(&UserCollectionBiz{}).
+		Where("biz = ? AND biz_id IN ? AND uid = ?", biz, bizIds, uid).
+		Pluck("biz_id", &res).Error
+	return res, err
+}
+
 func (G *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error {
 	now := time.Now().UnixMilli()
 	cb.Ctime = now
 	cb.Utime = now
 	return G.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 首先新建一个收藏夹
-		err := G.db.WithContext(ctx).Create(&cb).Error
+		// 0 是默认收藏夹，不落库
+		if cb.Cid > 0 {
+			var c Collection
+			err := tx.Where("id = ? AND uid = ?", cb.Cid, cb.Uid).First(&c).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCollectionNotFound
+			}
+			if err != nil {
+				return err
+			}
+		}
+		// 首先插入收藏记录
+		err := tx.Create(&cb).Error
+		if sqlError, ok := err.(*mysql.MySQLError); ok {
+			const uniqueIndexErrNo = 1062
+			if sqlError.Number == uniqueIndexErrNo {
+				return ErrDuplicateCollect
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -138,6 +249,50 @@ func (G *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCol
 	})
 }
 
+func (G *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error {
+	now := time.Now().UnixMilli()
+	return G.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, bizId).
+			Delete(&UserCollectionBiz{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrCollectionBizNotFound
+		}
+		return tx.Model(&Interactive{}).
+			Where("biz = ? AND biz_id = ? AND collect_cnt > 0", biz, bizId).
+			Updates(map[string]any{
+				"collect_cnt": gorm.Expr("collect_cnt - 1"),
+				"utime":       now,
+			}).Error
+	})
+}
+
+func (G *GORMInteractiveDAO) ListLikedByUid(ctx context.Context, uid int64, biz string, offset int, limit int) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	db := G.db.WithContext(ctx).Where("uid = ? AND status = ?", uid, 1)
+	if biz != "" {
+		db = db.Where("biz = ?", biz)
+	}
+	err := db.Order("utime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (G *GORMInteractiveDAO) ListCollectedByUid(ctx context.Context, uid int64, biz string, offset int, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	db := G.db.WithContext(ctx).Where("uid = ?", uid)
+	if biz != "" {
+		db = db.Where("biz = ?", biz)
+	}
+	err := db.Order("ctime DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
 func (G *GORMInteractiveDAO) GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error) {
 	var res UserLikeBiz
 	err := G.db.WithContext(ctx).Where("biz = ? and biz_id = ? and uid = ? and status = ?", biz, bizId, uid, 1).First(&res).Error
@@ -150,36 +305,54 @@ func (G *GORMInteractiveDAO) GetCollectionInfo(ctx context.Context, biz string,
 	return res, err
 }
 
+func (G *GORMInteractiveDAO) Delete(ctx context.Context, biz string, bizId int64) error {
+	return G.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("biz = ? and biz_id = ?", biz, bizId).Delete(&UserLikeBiz{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("biz = ? and biz_id = ?", biz, bizId).Delete(&UserCollectionBiz{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("biz = ? and biz_id = ?", biz, bizId).Delete(&Interactive{}).Error
+	})
+}
+
 func NewGORMInteractiveDAO(db *gorm.DB) InteractiveDAO {
 	return &GORMInteractiveDAO{db: db}
 }
 
+// UserLikeBiz 取消点赞只是把 Status 改成 0，Utime 是最近一次点赞或者取消的时间
 type UserLikeBiz struct {
 	Id     int64  `gorm:"primaryKey,autoIncrement"`
-	BizId  int64  `gorm:"uniqueIndex:idx_biz_id"`
-	Biz    string `gorm:"uniqueIndex:idx_biz_type;type:varchar(128)"`
-	Uid    int64  `gorm:"uniqueIndex:idx_uid"`
+	Uid    int64  `gorm:"uniqueIndex:uid_biz_type_id;index:uid_utime"`
+	Biz    string `gorm:"uniqueIndex:uid_biz_type_id;type:varchar(128)"`
+	BizId  int64  `gorm:"uniqueIndex:uid_biz_type_id"`
 	Ctime  int64
-	Utime  int64
+	Utime  int64 `gorm:"index:uid_utime"`
 	Status int64
 }
 
+// UserCollectionBiz 一个用户对同一个资源只有一条收藏记录，Cid 表示在哪个收藏夹
 type UserCollectionBiz struct {
 	Id    int64  `gorm:"primaryKey,autoIncrement"`
-	Cid   int64  `gorm:"uniqueIndex:idx_cid"`
-	BizId int64  `gorm:"uniqueIndex:idx_biz_id"`
-	Biz   string `gorm:"uniqueIndex:idx_biz_type;type:varchar(128)"`
-	Uid   int64  `gorm:"uniqueIndex:idx_uid"`
-	Ctime int64
+	Uid   int64  `gorm:"uniqueIndex:uid_biz_type_id;index:uid_ctime"`
+	Biz   string `gorm:"uniqueIndex:uid_biz_type_id;type:varchar(128)"`
+	BizId int64  `gorm:"uniqueIndex:uid_biz_type_id"`
+	Cid   int64  `gorm:"index"`
+	Ctime int64  `gorm:"index:uid_ctime"`
 	Utime int64
 }
 
 type Collection struct {
-	Id    int64  `gorm:"primaryKey,autoIncrement"`
-	Name  string `gorm:"type:varchar(1024)"`
-	Uid   int64  `gorm:"uniqueIndex:idx_uid"`
-	Ctime int64
-	Utime int64
+	Id   int64  `gorm:"primaryKey,autoIncrement"`
+	Name string `gorm:"type:varchar(1024)"`
+	Uid  int64  `gorm:"index"`
+	// Public 公开的收藏夹别人也能看
+	Public bool
+	Ctime  int64
+	Utime  int64
 }
 
 type CollectionItem struct {
@@ -196,6 +369,8 @@ type Interactive struct {
 	ReadCnt    int64
 	LikeCnt    int64
 	CollectCnt int64
+	CommentCnt int64
+	UvCnt      int64
 	Ctime      int64
 	Utime      int64
 }
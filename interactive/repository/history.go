@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository/dao"
+)
+
+//go:generate mockgen -source=./history.go -destination=mocks/history.mock.go -package=repomocks ReadHistoryRepository
+type ReadHistoryRepository interface {
+	// AddRecord 记录一次阅读，同一个资源只保留最近一次
+	AddRecord(ctx context.Context, h domain.ReadHistory) error
+	List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.ReadHistory, error)
+	Delete(ctx context.Context, uid int64, biz string, bizId int64) error
+	Clear(ctx context.Context, uid int64) error
+	// ListOverflowUids 阅读记录超过 keep 条的用户，最多 limit 个
+	ListOverflowUids(ctx context.Context, keep int, limit int) ([]int64, error)
+	// Trim 删掉 uid 最近 keep 条之外的一批，返回删除的数量
+	Trim(ctx context.Context, uid int64, keep int) (int64, error)
+}
+
+type GORMReadHistoryRepository struct {
+	dao dao.ReadHistoryDAO
+}
+
+func NewReadHistoryRepository(dao dao.ReadHistoryDAO) ReadHistoryRepository {
+	return &GORMReadHistoryRepository{dao: dao}
+}
+
+func (g *GORMReadHistoryRepository) AddRecord(ctx context.Context, h domain.ReadHistory) error {
+	return g.dao.Upsert(ctx, dao.ReadHistory{
+		Uid:      h.Uid,
+		Biz:      h.Biz,
+		BizId:    h.BizId,
+		Progress: h.Progress,
+		Rtime:    h.Rtime.UnixMilli(),
+	})
+}
+
+func (g *GORMReadHistoryRepository) List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.ReadHistory, error) {
+	hs, err := g.dao.List(ctx, uid, biz, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(hs, func(idx int, src dao.ReadHistory) domain.ReadHistory {
+		return domain.ReadHistory{
+			Uid:      src.Uid,
+			Biz:      src.Biz,
+			BizId:    src.BizId,
+			Progress: src.Progress,
+			Rtime:    time.UnixMilli(src.Rtime),
+		}
+	}), nil
+}
+
+func (g *GORMReadHistoryRepository) Delete(ctx context.Context, uid int64, biz string, bizId int64) error {
+	return g.dao.Delete(ctx, uid, biz, bizId)
+}
+
+func (g *GORMReadHistoryRepository) Clear(ctx context.Context, uid int64) error {
+	return g.dao.Clear(ctx, uid)
+}
+
+func (g *GORMReadHistoryRepository) ListOverflowUids(ctx context.Context, keep int, limit int) ([]int64, error) {
+	return g.dao.ListOverflowUids(ctx, keep, limit)
+}
+
+func (g *GORMReadHistoryRepository) Trim(ctx context.Context, uid int64, keep int) (int64, error) {
+	return g.dao.Trim(ctx, uid, keep)
+}
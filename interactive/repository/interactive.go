@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
 	"we_book/interactive/domain"
 	"we_book/interactive/repository/cache"
 	"we_book/interactive/repository/dao"
@@ -9,16 +11,31 @@ import (
 )
 
 type InteractiveRepository interface {
+	// IncrReadCnt uid 在去重窗口内重复阅读不加阅读数，uid 为 0 的时候每次都加
 	IncrReadCnt(ctx context.Context,
-		biz string, bizId int64) error
-	BatchIncrReadCnt(ctx context.Context, ids []int64, bizId []string) error
+		biz string, bizId int64, uid int64) error
+	BatchIncrReadCnt(ctx context.Context, ids []int64, bizId []string, uids []int64) error
+	// SyncUvCnt 把最多 limit 个资源的独立访客数落库，返回处理了多少个
+	SyncUvCnt(ctx context.Context, limit int) (int, error)
 	IncrLike(ctx context.Context, biz string, bizId, uid int64) error
 	DecrLike(ctx context.Context, biz string, bizId, uid int64) error
 	AddCollectionItem(ctx context.Context, biz string, bizId, cid int64, uid int64) error
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
+	// GetByIds 每个 ID 都有返回，没有记录的计数都是 0
+	GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// LikedByIds 返回 bizIds 里面 uid 点赞了的
+	LikedByIds(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]bool, error)
+	// CollectedByIds 返回 bizIds 里面 uid 收藏了的
+	CollectedByIds(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]bool, error)
 	Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error)
+	// ListLiked uid 点赞过的，biz 为空的时候不区分业务
+	ListLiked(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.LikeRecord, error)
+	// ListCollected uid 收藏过的，不区分收藏夹
+	ListCollected(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.CollectionItem, error)
 	Collected(ctx context.Context, biz string, id int64, uid int64) (bool, error)
-	AddRecord(ctx context.Context, aid int64, uid int64) error
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	// Delete 业务对象被彻底删除的时候，清理掉它的计数和点赞、收藏记录
+	Delete(ctx context.Context, biz string, bizId int64) error
 }
 
 type CacheReadCntRepository struct {
@@ -27,13 +44,26 @@ type CacheReadCntRepository struct {
 	l     logger.V1
 }
 
-func (c *CacheReadCntRepository) AddRecord(ctx context.Context, aid int64, uid int64) error {
-	// TODO:implement me
-	panic("implement me")
-}
-
-func (c *CacheReadCntRepository) BatchIncrReadCnt(ctx context.Context, ids []int64, bizId []string) error {
-	err := c.dao.BatchIncrReadCnt(ctx, ids, bizId)
+func (c *CacheReadCntRepository) BatchIncrReadCnt(ctx context.Context, ids []int64, bizId []string, uids []int64) error {
+	firsts, err := c.cache.AddReaders(ctx, bizId, ids, uids)
+	if err != nil {
+		// 去重失败的时候宁可多算，也不能丢
+		c.l.Error("add readers failed", logger.Error(err))
+	} else {
+		cntIds := make([]int64, 0, len(ids))
+		cntBizs := make([]string, 0, len(bizId))
+		for i, first := range firsts {
+			if first {
+				cntIds = append(cntIds, ids[i])
+				cntBizs = append(cntBizs, bizId[i])
+			}
+		}
+		ids, bizId = cntIds, cntBizs
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	err = c.dao.BatchIncrReadCnt(ctx, ids, bizId)
 	if err != nil {
 		return err
 	}
@@ -42,14 +72,64 @@ func (c *CacheReadCntRepository) BatchIncrReadCnt(ctx context.Context, ids []int
 	return nil
 }
 
-func (c *CacheReadCntRepository) IncrReadCnt(ctx context.Context, biz string, bizId int64) error {
-	err := c.dao.IncrReadCnt(ctx, biz, bizId)
+func (c *CacheReadCntRepository) IncrReadCnt(ctx context.Context, biz string, bizId int64, uid int64) error {
+	firsts, err := c.cache.AddReaders(ctx, []string{biz}, []int64{bizId}, []int64{uid})
+	if err != nil {
+		// 去重失败的时候宁可多算，也不能丢
+		c.l.Error("add readers failed",
+			logger.Int64("biz_id", bizId),
+			logger.String("biz", biz),
+			logger.Error(err))
+	} else if !firsts[0] {
+		return nil
+	}
+	err = c.dao.IncrReadCnt(ctx, biz, bizId)
 	if err != nil {
 		return err
 	}
 	return c.cache.IncrReadCntIfPresent(ctx, biz, bizId)
 }
 
+func (c *CacheReadCntRepository) SyncUvCnt(ctx context.Context, limit int) (int, error) {
+	intrs, err := c.cache.PopUv(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, intr := range intrs {
+		err = c.dao.SetUvCnt(ctx, intr.Biz, intr.BizId, intr.UvCnt)
+		if err != nil {
+			// 还没有落库的都放回去
+			for _, left := range intrs[i:] {
+				er := c.cache.MarkUvDirty(ctx, left.Biz, left.BizId)
+				if er != nil {
+					c.l.Error("mark uv dirty failed",
+						logger.Int64("biz_id", left.BizId),
+						logger.String("biz", left.Biz),
+						logger.Error(er))
+				}
+			}
+			return i, err
+		}
+		// 只改缓存里面的 uv_cnt，其他计数还是缓存里面的
+		er := c.cache.SetUvCntIfPresent(ctx, intr.Biz, intr.BizId, intr.UvCnt)
+		if er != nil {
+			c.l.Error("set uv cnt cache failed",
+				logger.Int64("biz_id", intr.BizId),
+				logger.String("biz", intr.Biz),
+				logger.Error(er))
+		}
+	}
+	return len(intrs), nil
+}
+
+func (c *CacheReadCntRepository) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	err := c.dao.IncrCommentCnt(ctx, biz, bizId, delta)
+	if err != nil {
+		return err
+	}
+	return c.cache.IncrCommentCntIfPresent(ctx, biz, bizId, delta)
+}
+
 func (c *CacheReadCntRepository) IncrLike(ctx context.Context, biz string, bizId, uid int64) error {
 	err := c.dao.InsertLikeInfo(ctx, biz, bizId, uid)
 	if err != nil {
@@ -101,6 +181,104 @@ func (c *CacheReadCntRepository) Get(ctx context.Context, biz string, bizId int6
 	return res, nil
 }
 
+func (c *CacheReadCntRepository) GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
+	res, err := c.cache.MGet(ctx, biz, bizIds)
+	if err != nil {
+		// 缓存出错就全部查数据库
+		c.l.Error("mget interactive cache failed",
+			logger.String("biz", biz),
+			logger.Error(err))
+		res = make(map[int64]domain.Interactive, len(bizIds))
+	}
+	misses := make([]int64, 0, len(bizIds))
+	for _, id := range bizIds {
+		if _, ok := res[id]; !ok {
+			misses = append(misses, id)
+		}
+	}
+	if len(misses) == 0 {
+		return res, nil
+	}
+	intrs, err := c.dao.GetByIds(ctx, biz, misses)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[int64]domain.Interactive, len(misses))
+	for _, intr := range intrs {
+		found[intr.BizId] = c.toDomain(intr)
+	}
+	// 数据库里面没有的也回写 0，下一次不用再查数据库
+	// 之后点赞、阅读会先写数据库再更新缓存，不会不一致
+	for _, id := range misses {
+		intr := found[id]
+		intr.BizId = id
+		found[id] = intr
+		res[id] = intr
+	}
+	err = c.cache.MSet(ctx, biz, found)
+	if err != nil {
+		c.l.Error("mset interactive cache failed",
+			logger.String("biz", biz),
+			logger.Error(err))
+	}
+	return res, nil
+}
+
+func (c *CacheReadCntRepository) LikedByIds(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]bool, error) {
+	ids, err := c.dao.LikedBizIds(ctx, biz, bizIds, uid)
+	if err != nil {
+		return nil, err
+	}
+	return c.toSet(ids), nil
+}
+
+func (c *CacheReadCntRepository) CollectedByIds(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]bool, error) {
+	ids, err := c.dao.CollectedBizIds(ctx, biz, bizIds, uid)
+	if err != nil {
+		return nil, err
+	}
+	return c.toSet(ids), nil
+}
+
+func (c *CacheReadCntRepository) toSet(ids []int64) map[int64]bool {
+	res := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		res[id] = true
+	}
+	return res
+}
+
+func (c *CacheReadCntRepository) ListLiked(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.LikeRecord, error) {
+	likes, err := c.dao.ListLikedByUid(ctx, uid, biz, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(likes, func(idx int, src dao.UserLikeBiz) domain.LikeRecord {
+		return domain.LikeRecord{
+			Uid:   src.Uid,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Utime: time.UnixMilli(src.Utime),
+		}
+	}), nil
+}
+
+func (c *CacheReadCntRepository) ListCollected(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.CollectionItem, error) {
+	items, err := c.dao.ListCollectedByUid(ctx, uid, biz, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, func(idx int, src dao.UserCollectionBiz) domain.CollectionItem {
+		return domain.CollectionItem{
+			Cid:   src.Cid,
+			Uid:   src.Uid,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Ctime: time.UnixMilli(src.Ctime),
+		}
+	}), nil
+}
+
 func (c *CacheReadCntRepository) Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error) {
 	_, err := c.dao.GetLikeInfo(ctx, biz, id, uid)
 	switch err {
@@ -125,11 +303,22 @@ func (c *CacheReadCntRepository) Collected(ctx context.Context, biz string, id i
 	}
 }
 
+func (c *CacheReadCntRepository) Delete(ctx context.Context, biz string, bizId int64) error {
+	err := c.dao.Delete(ctx, biz, bizId)
+	if err != nil {
+		return err
+	}
+	return c.cache.Del(ctx, biz, bizId)
+}
+
 func (c *CacheReadCntRepository) toDomain(intro dao.Interactive) domain.Interactive {
 	return domain.Interactive{
+		BizId:      intro.BizId,
 		LikedCnt:   intro.LikeCnt,
 		ReadCnt:    intro.ReadCnt,
 		CollectCnt: intro.CollectCnt,
+		CommentCnt: intro.CommentCnt,
+		UvCnt:      intro.UvCnt,
 	}
 }
 
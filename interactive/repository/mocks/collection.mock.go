@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./collection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./collection.go -destination=mocks/collection.mock.go -package=repomocks CollectionRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/interactive/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCollectionRepository is a mock of CollectionRepository interface.
+type MockCollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionRepositoryMockRecorder
+}
+
+// MockCollectionRepositoryMockRecorder is the mock recorder for MockCollectionRepository.
+type MockCollectionRepositoryMockRecorder struct {
+	mock *MockCollectionRepository
+}
+
+// NewMockCollectionRepository creates a new mock instance.
+func NewMockCollectionRepository(ctrl *gomock.Controller) *MockCollectionRepository {
+	mock := &MockCollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockCollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionRepository) EXPECT() *MockCollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountItems mocks base method.
+func (m *MockCollectionRepository) CountItems(ctx context.Context, uid int64) (map[int64]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountItems", ctx, uid)
+	ret0, _ := ret[0].(map[int64]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountItems indicates an expected call of CountItems.
+func (mr *MockCollectionRepositoryMockRecorder) CountItems(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountItems", reflect.TypeOf((*MockCollectionRepository)(nil).CountItems), ctx, uid)
+}
+
+// Create mocks base method.
+func (m *MockCollectionRepository) Create(ctx context.Context, c domain.Collection) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollectionRepositoryMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollectionRepository)(nil).Create), ctx, c)
+}
+
+// Delete mocks base method.
+func (m *MockCollectionRepository) Delete(ctx context.Context, id, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCollectionRepositoryMockRecorder) Delete(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollectionRepository)(nil).Delete), ctx, id, uid)
+}
+
+// GetById mocks base method.
+func (m *MockCollectionRepository) GetById(ctx context.Context, id int64) (domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockCollectionRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockCollectionRepository)(nil).GetById), ctx, id)
+}
+
+// ListByUid mocks base method.
+func (m *MockCollectionRepository) ListByUid(ctx context.Context, uid int64, onlyPublic bool) ([]domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUid", ctx, uid, onlyPublic)
+	ret0, _ := ret[0].([]domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUid indicates an expected call of ListByUid.
+func (mr *MockCollectionRepositoryMockRecorder) ListByUid(ctx, uid, onlyPublic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUid", reflect.TypeOf((*MockCollectionRepository)(nil).ListByUid), ctx, uid, onlyPublic)
+}
+
+// ListItems mocks base method.
+func (m *MockCollectionRepository) ListItems(ctx context.Context, cid, uid int64, offset, limit int) ([]domain.CollectionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", ctx, cid, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.CollectionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockCollectionRepositoryMockRecorder) ListItems(ctx, cid, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockCollectionRepository)(nil).ListItems), ctx, cid, uid, offset, limit)
+}
+
+// MoveItem mocks base method.
+func (m *MockCollectionRepository) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, biz, bizId, uid, cid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockCollectionRepositoryMockRecorder) MoveItem(ctx, biz, bizId, uid, cid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockCollectionRepository)(nil).MoveItem), ctx, biz, bizId, uid, cid)
+}
+
+// RemoveItem mocks base method.
+func (m *MockCollectionRepository) RemoveItem(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockCollectionRepositoryMockRecorder) RemoveItem(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockCollectionRepository)(nil).RemoveItem), ctx, biz, bizId, uid)
+}
+
+// Update mocks base method.
+func (m *MockCollectionRepository) Update(ctx context.Context, c domain.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCollectionRepositoryMockRecorder) Update(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCollectionRepository)(nil).Update), ctx, c)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./history.go -destination=mocks/history.mock.go -package=repomocks ReadHistoryRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/interactive/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReadHistoryRepository is a mock of ReadHistoryRepository interface.
+type MockReadHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadHistoryRepositoryMockRecorder
+}
+
+// MockReadHistoryRepositoryMockRecorder is the mock recorder for MockReadHistoryRepository.
+type MockReadHistoryRepositoryMockRecorder struct {
+	mock *MockReadHistoryRepository
+}
+
+// NewMockReadHistoryRepository creates a new mock instance.
+func NewMockReadHistoryRepository(ctrl *gomock.Controller) *MockReadHistoryRepository {
+	mock := &MockReadHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockReadHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadHistoryRepository) EXPECT() *MockReadHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddRecord mocks base method.
+func (m *MockReadHistoryRepository) AddRecord(ctx context.Context, h domain.ReadHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecord", ctx, h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecord indicates an expected call of AddRecord.
+func (mr *MockReadHistoryRepositoryMockRecorder) AddRecord(ctx, h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecord", reflect.TypeOf((*MockReadHistoryRepository)(nil).AddRecord), ctx, h)
+}
+
+// Clear mocks base method.
+func (m *MockReadHistoryRepository) Clear(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockReadHistoryRepositoryMockRecorder) Clear(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockReadHistoryRepository)(nil).Clear), ctx, uid)
+}
+
+// Delete mocks base method.
+func (m *MockReadHistoryRepository) Delete(ctx context.Context, uid int64, biz string, bizId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, biz, bizId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReadHistoryRepositoryMockRecorder) Delete(ctx, uid, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReadHistoryRepository)(nil).Delete), ctx, uid, biz, bizId)
+}
+
+// List mocks base method.
+func (m *MockReadHistoryRepository) List(ctx context.Context, uid int64, biz string, offset, limit int) ([]domain.ReadHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, uid, biz, offset, limit)
+	ret0, _ := ret[0].([]domain.ReadHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadHistoryRepositoryMockRecorder) List(ctx, uid, biz, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReadHistoryRepository)(nil).List), ctx, uid, biz, offset, limit)
+}
+
+// ListOverflowUids mocks base method.
+func (m *MockReadHistoryRepository) ListOverflowUids(ctx context.Context, keep, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverflowUids", ctx, keep, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverflowUids indicates an expected call of ListOverflowUids.
+func (mr *MockReadHistoryRepositoryMockRecorder) ListOverflowUids(ctx, keep, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverflowUids", reflect.TypeOf((*MockReadHistoryRepository)(nil).ListOverflowUids), ctx, keep, limit)
+}
+
+// Trim mocks base method.
+func (m *MockReadHistoryRepository) Trim(ctx context.Context, uid int64, keep int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trim", ctx, uid, keep)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trim indicates an expected call of Trim.
+func (mr *MockReadHistoryRepositoryMockRecorder) Trim(ctx, uid, keep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockReadHistoryRepository)(nil).Trim), ctx, uid, keep)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository"
+	"we_book/pkg/logger"
+)
+
+const (
+	// DefaultCollectionName 默认收藏夹的名字，默认收藏夹的 ID 是 0
+	DefaultCollectionName = "默认收藏夹"
+	// collectionNameMaxLen 收藏夹名字最多多少个字
+	collectionNameMaxLen = 64
+)
+
+var (
+	ErrCollectionNotFound    = repository.ErrCollectionNotFound
+	ErrAlreadyCollected      = repository.ErrDuplicateCollect
+	ErrNotCollected          = repository.ErrCollectionBizNotFound
+	ErrInvalidCollectionName = errors.New("收藏夹名字不合法")
+)
+
+//go:generate mockgen -source=./collection.go -destination=mocks/collection.mock.go -package=svcmocks CollectionService
+type CollectionService interface {
+	// Create 新建收藏夹，返回 ID
+	Create(ctx context.Context, c domain.Collection) (int64, error)
+	// Update 重命名或者修改是否公开，默认收藏夹不能改
+	Update(ctx context.Context, c domain.Collection) error
+	// Delete 删除收藏夹，里面的收藏也会被取消
+	Delete(ctx context.Context, id, uid int64) error
+	// List uid 的收藏夹，viewer 不是 uid 的时候只能看到公开的
+	List(ctx context.Context, uid, viewer int64) ([]domain.Collection, error)
+	// ListItems 收藏夹里面的收藏，cid 为 0 的时候是 viewer 自己的默认收藏夹
+	// 别人的私密收藏夹当作不存在
+	ListItems(ctx context.Context, cid, viewer int64, offset int, limit int) ([]domain.CollectionItem, error)
+	// Move 把收藏移动到 cid 收藏夹
+	Move(ctx context.Context, biz string, bizId, cid, uid int64) error
+	// Uncollect 取消收藏
+	Uncollect(ctx context.Context, biz string, bizId, uid int64) error
+}
+
+type collectionService struct {
+	repo repository.CollectionRepository
+	l    logger.V1
+}
+
+func NewCollectionService(repo repository.CollectionRepository, l logger.V1) CollectionService {
+	return &collectionService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (s *collectionService) Create(ctx context.Context, c domain.Collection) (int64, error) {
+	name, err := s.checkName(c.Name)
+	if err != nil {
+		return 0, err
+	}
+	c.Name = name
+	return s.repo.Create(ctx, c)
+}
+
+func (s *collectionService) Update(ctx context.Context, c domain.Collection) error {
+	if c.Id <= 0 {
+		return ErrCollectionNotFound
+	}
+	name, err := s.checkName(c.Name)
+	if err != nil {
+		return err
+	}
+	c.Name = name
+	return s.repo.Update(ctx, c)
+}
+
+func (s *collectionService) Delete(ctx context.Context, id, uid int64) error {
+	if id <= 0 {
+		return ErrCollectionNotFound
+	}
+	return s.repo.Delete(ctx, id, uid)
+}
+
+func (s *collectionService) List(ctx context.Context, uid, viewer int64) ([]domain.Collection, error) {
+	owner := uid == viewer
+	cols, err := s.repo.ListByUid(ctx, uid, !owner)
+	if err != nil {
+		return nil, err
+	}
+	cnts, err := s.repo.CountItems(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Collection, 0, len(cols)+1)
+	if owner {
+		res = append(res, domain.Collection{
+			Uid:     uid,
+			Name:    DefaultCollectionName,
+			ItemCnt: cnts[0],
+		})
+	}
+	for _, c := range cols {
+		c.ItemCnt = cnts[c.Id]
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+func (s *collectionService) ListItems(ctx context.Context, cid, viewer int64, offset int, limit int) ([]domain.CollectionItem, error) {
+	if cid == 0 {
+		return s.repo.ListItems(ctx, 0, viewer, offset, limit)
+	}
+	c, err := s.repo.GetById(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	if c.Uid != viewer && !c.Public {
+		return nil, ErrCollectionNotFound
+	}
+	return s.repo.ListItems(ctx, cid, c.Uid, offset, limit)
+}
+
+func (s *collectionService) Move(ctx context.Context, biz string, bizId, cid, uid int64) error {
+	if cid < 0 {
+		return ErrCollectionNotFound
+	}
+	return s.repo.MoveItem(ctx, biz, bizId, uid, cid)
+}
+
+func (s *collectionService) Uncollect(ctx context.Context, biz string, bizId, uid int64) error {
+	return s.repo.RemoveItem(ctx, biz, bizId, uid)
+}
+
+func (s *collectionService) checkName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == DefaultCollectionName ||
+		utf8.RuneCountInString(name) > collectionNameMaxLen {
+		return "", ErrInvalidCollectionName
+	}
+	return name, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository"
+	repomocks "we_book/interactive/repository/mocks"
+)
+
+func Test_collectionService_List(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.CollectionRepository
+
+		uid    int64
+		viewer int64
+
+		wantedRes []domain.Collection
+		wantedErr error
+	}{
+		{
+			name: "自己的，带上默认收藏夹",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().ListByUid(gomock.Any(), int64(123), false).
+					Return([]domain.Collection{
+						{Id: 1, Uid: 123, Name: "Go"},
+						{Id: 2, Uid: 123, Name: "MySQL", Public: true},
+					}, nil)
+				repo.EXPECT().CountItems(gomock.Any(), int64(123)).
+					Return(map[int64]int64{0: 3, 2: 5}, nil)
+				return repo
+			},
+			uid:    123,
+			viewer: 123,
+			wantedRes: []domain.Collection{
+				{Uid: 123, Name: DefaultCollectionName, ItemCnt: 3},
+				{Id: 1, Uid: 123, Name: "Go"},
+				{Id: 2, Uid: 123, Name: "MySQL", Public: true, ItemCnt: 5},
+			},
+		},
+		{
+			name: "别人的，只有公开的",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().ListByUid(gomock.Any(), int64(123), true).
+					Return([]domain.Collection{
+						{Id: 2, Uid: 123, Name: "MySQL", Public: true},
+					}, nil)
+				repo.EXPECT().CountItems(gomock.Any(), int64(123)).
+					Return(map[int64]int64{0: 3, 2: 5}, nil)
+				return repo
+			},
+			uid:    123,
+			viewer: 456,
+			wantedRes: []domain.Collection{
+				{Id: 2, Uid: 123, Name: "MySQL", Public: true, ItemCnt: 5},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCollectionService(tc.mock(ctrl), nil)
+			res, err := svc.List(context.Background(), tc.uid, tc.viewer)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedRes, res)
+		})
+	}
+}
+
+func Test_collectionService_ListItems(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.CollectionRepository
+
+		cid    int64
+		viewer int64
+
+		wantedRes []domain.CollectionItem
+		wantedErr error
+	}{
+		{
+			name: "自己的默认收藏夹",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().ListItems(gomock.Any(), int64(0), int64(123), 0, 10).
+					Return([]domain.CollectionItem{{Uid: 123, Biz: "article", BizId: 1}}, nil)
+				return repo
+			},
+			cid:       0,
+			viewer:    123,
+			wantedRes: []domain.CollectionItem{{Uid: 123, Biz: "article", BizId: 1}},
+		},
+		{
+			name: "别人的公开收藏夹",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(2)).
+					Return(domain.Collection{Id: 2, Uid: 456, Public: true}, nil)
+				repo.EXPECT().ListItems(gomock.Any(), int64(2), int64(456), 0, 10).
+					Return([]domain.CollectionItem{{Cid: 2, Uid: 456, Biz: "article", BizId: 1}}, nil)
+				return repo
+			},
+			cid:       2,
+			viewer:    123,
+			wantedRes: []domain.CollectionItem{{Cid: 2, Uid: 456, Biz: "article", BizId: 1}},
+		},
+		{
+			name: "别人的私密收藏夹",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(2)).
+					Return(domain.Collection{Id: 2, Uid: 456}, nil)
+				return repo
+			},
+			cid:       2,
+			viewer:    123,
+			wantedErr: ErrCollectionNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCollectionService(tc.mock(ctrl), nil)
+			res, err := svc.ListItems(context.Background(), tc.cid, tc.viewer, 0, 10)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedRes, res)
+		})
+	}
+}
+
+func Test_collectionService_Create(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.CollectionRepository
+
+		c domain.Collection
+
+		wantedId  int64
+		wantedErr error
+	}{
+		{
+			name: "去掉首尾空格",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				repo := repomocks.NewMockCollectionRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain.Collection{Uid: 123, Name: "Go"}).
+					Return(int64(1), nil)
+				return repo
+			},
+			c:        domain.Collection{Uid: 123, Name: "  Go "},
+			wantedId: 1,
+		},
+		{
+			name: "空名字",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				return repomocks.NewMockCollectionRepository(ctrl)
+			},
+			c:         domain.Collection{Uid: 123, Name: "  "},
+			wantedErr: ErrInvalidCollectionName,
+		},
+		{
+			name: "和默认收藏夹重名",
+			mock: func(ctrl *gomock.Controller) repository.CollectionRepository {
+				return repomocks.NewMockCollectionRepository(ctrl)
+			},
+			c:         domain.Collection{Uid: 123, Name: DefaultCollectionName},
+			wantedErr: ErrInvalidCollectionName,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCollectionService(tc.mock(ctrl), nil)
+			id, err := svc.Create(context.Background(), tc.c)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedId, id)
+		})
+	}
+}
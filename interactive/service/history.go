@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository"
+	"we_book/pkg/logger"
+)
+
+var ErrInvalidProgress = errors.New("阅读进度不合法")
+
+//go:generate mockgen -source=./history.go -destination=mocks/history.mock.go -package=svcmocks ReadHistoryService
+type ReadHistoryService interface {
+	// Record 记录一次阅读，Progress 为 0 的时候不修改原来的进度，Rtime 不传就是现在
+	Record(ctx context.Context, h domain.ReadHistory) error
+	// List 按照阅读时间倒序，biz 为空的时候不区分业务
+	List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.ReadHistory, error)
+	// Delete 删除一条阅读记录
+	Delete(ctx context.Context, uid int64, biz string, bizId int64) error
+	// Clear 清空阅读记录
+	Clear(ctx context.Context, uid int64) error
+	// Trim 每个用户只保留最近 keep 条，一次最多处理 batch 个用户，返回处理了多少个用户
+	Trim(ctx context.Context, keep int, batch int) (int, error)
+}
+
+type readHistoryService struct {
+	repo repository.ReadHistoryRepository
+	l    logger.V1
+}
+
+func NewReadHistoryService(repo repository.ReadHistoryRepository, l logger.V1) ReadHistoryService {
+	return &readHistoryService{
+		repo: repo,
+		l:    l,
+	}
+}
+
+func (s *readHistoryService) Record(ctx context.Context, h domain.ReadHistory) error {
+	if h.Progress < 0 || h.Progress > 100 {
+		return ErrInvalidProgress
+	}
+	if h.Rtime.IsZero() {
+		h.Rtime = time.Now()
+	}
+	return s.repo.AddRecord(ctx, h)
+}
+
+func (s *readHistoryService) List(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.ReadHistory, error) {
+	return s.repo.List(ctx, uid, biz, offset, limit)
+}
+
+func (s *readHistoryService) Delete(ctx context.Context, uid int64, biz string, bizId int64) error {
+	return s.repo.Delete(ctx, uid, biz, bizId)
+}
+
+func (s *readHistoryService) Clear(ctx context.Context, uid int64) error {
+	return s.repo.Clear(ctx, uid)
+}
+
+func (s *readHistoryService) Trim(ctx context.Context, keep int, batch int) (int, error) {
+	uids, err := s.repo.ListOverflowUids(ctx, keep, batch)
+	if err != nil {
+		return 0, err
+	}
+	for _, uid := range uids {
+		// 一次只删一批，删到没有为止
+		for {
+			n, er := s.repo.Trim(ctx, uid, keep)
+			if er != nil {
+				return 0, er
+			}
+			if n == 0 {
+				break
+			}
+		}
+	}
+	return len(uids), nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"we_book/interactive/domain"
+	"we_book/interactive/repository"
+	repomocks "we_book/interactive/repository/mocks"
+)
+
+func Test_readHistoryService_Record(t *testing.T) {
+	rtime := time.UnixMilli(1700000000000)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.ReadHistoryRepository
+
+		h domain.ReadHistory
+
+		wantedErr error
+	}{
+		{
+			name: "上报进度",
+			mock: func(ctrl *gomock.Controller) repository.ReadHistoryRepository {
+				repo := repomocks.NewMockReadHistoryRepository(ctrl)
+				repo.EXPECT().AddRecord(gomock.Any(), domain.ReadHistory{
+					Uid: 123, Biz: "article", BizId: 1, Progress: 50, Rtime: rtime,
+				}).Return(nil)
+				return repo
+			},
+			h: domain.ReadHistory{Uid: 123, Biz: "article", BizId: 1, Progress: 50, Rtime: rtime},
+		},
+		{
+			name: "没有阅读时间就用现在",
+			mock: func(ctrl *gomock.Controller) repository.ReadHistoryRepository {
+				repo := repomocks.NewMockReadHistoryRepository(ctrl)
+				repo.EXPECT().AddRecord(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, h domain.ReadHistory) error {
+						if h.Rtime.IsZero() {
+							return errors.New("rtime 没有设置")
+						}
+						return nil
+					})
+				return repo
+			},
+			h: domain.ReadHistory{Uid: 123, Biz: "article", BizId: 1},
+		},
+		{
+			name: "进度超过 100",
+			mock: func(ctrl *gomock.Controller) repository.ReadHistoryRepository {
+				return repomocks.NewMockReadHistoryRepository(ctrl)
+			},
+			h:         domain.ReadHistory{Uid: 123, Biz: "article", BizId: 1, Progress: 101},
+			wantedErr: ErrInvalidProgress,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewReadHistoryService(tc.mock(ctrl), nil)
+			err := svc.Record(context.Background(), tc.h)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_readHistoryService_Trim(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.ReadHistoryRepository
+
+		wantedN   int
+		wantedErr error
+	}{
+		{
+			name: "每个用户删到没有为止",
+			mock: func(ctrl *gomock.Controller) repository.ReadHistoryRepository {
+				repo := repomocks.NewMockReadHistoryRepository(ctrl)
+				repo.EXPECT().ListOverflowUids(gomock.Any(), 10, 100).
+					Return([]int64{1, 2}, nil)
+				gomock.InOrder(
+					repo.EXPECT().Trim(gomock.Any(), int64(1), 10).Return(int64(1000), nil),
+					repo.EXPECT().Trim(gomock.Any(), int64(1), 10).Return(int64(3), nil),
+					repo.EXPECT().Trim(gomock.Any(), int64(1), 10).Return(int64(0), nil),
+				)
+				repo.EXPECT().Trim(gomock.Any(), int64(2), 10).Return(int64(0), nil)
+				return repo
+			},
+			wantedN: 2,
+		},
+		{
+			name: "删除失败",
+			mock: func(ctrl *gomock.Controller) repository.ReadHistoryRepository {
+				repo := repomocks.NewMockReadHistoryRepository(ctrl)
+				repo.EXPECT().ListOverflowUids(gomock.Any(), 10, 100).
+					Return([]int64{1}, nil)
+				repo.EXPECT().Trim(gomock.Any(), int64(1), 10).
+					Return(int64(0), errors.New("mock db error"))
+				return repo
+			},
+			wantedErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewReadHistoryService(tc.mock(ctrl), nil)
+			n, err := svc.Trim(context.Background(), 10, 100)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedN, n)
+		})
+	}
+}
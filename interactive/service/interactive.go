@@ -10,7 +10,8 @@ import (
 
 //go:generate mockgen -source=./interactive.go -destination=mocks/interactive.mock.go -package=svcmocks  InteractiveService
 type InteractiveService interface {
-	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
+	// IncrReadCnt uid 是读者，同一个读者短时间内重复阅读只算一次，未登录的时候传 0
+	IncrReadCnt(ctx context.Context, biz string, bizId int64, uid int64) error
 	// Like 点赞
 	Like(ctx context.Context, biz string, bizId int64, uid int64) error
 	// CancelLike 取消点赞
@@ -19,7 +20,20 @@ type InteractiveService interface {
 	// cid 不一定有，或者说 0 对应的是该用户的默认收藏夹
 	Collect(ctx context.Context, biz string, bizId, cid, uid int64) error
 	Get(ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error)
+	// GetByIds 批量查询计数，每个 ID 都有返回，没有记录的计数都是 0
 	GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// GetByIdsWithUser 在 GetByIds 的基础上带上 uid 有没有点赞、收藏，给列表页用
+	GetByIdsWithUser(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]domain.Interactive, error)
+	// ListLiked 我的点赞，按照点赞时间倒序，biz 为空的时候不区分业务
+	ListLiked(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.LikeRecord, error)
+	// ListCollected 我的收藏，不区分收藏夹，按照收藏时间倒序，biz 为空的时候不区分业务
+	ListCollected(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.CollectionItem, error)
+	// IncrCommentCnt 新增评论 delta 为 1，删除评论为 -1
+	IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error
+	// Delete 业务对象被彻底删除之后调用
+	Delete(ctx context.Context, biz string, bizId int64) error
+	// SyncUvCnt 把最多 limit 个资源的独立访客数落库，返回处理了多少个，给定时任务用
+	SyncUvCnt(ctx context.Context, limit int) (int, error)
 }
 
 type interactiveService struct {
@@ -28,12 +42,71 @@ type interactiveService struct {
 }
 
 func (i *interactiveService) GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error) {
-	//TODO implement me
-	panic("implement me")
+	if len(bizIds) == 0 {
+		return map[int64]domain.Interactive{}, nil
+	}
+	return i.repo.GetByIds(ctx, biz, bizIds)
+}
+
+func (i *interactiveService) GetByIdsWithUser(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]domain.Interactive, error) {
+	if len(bizIds) == 0 {
+		return map[int64]domain.Interactive{}, nil
+	}
+	var (
+		eg        errgroup.Group
+		intrs     map[int64]domain.Interactive
+		liked     map[int64]bool
+		collected map[int64]bool
+	)
+	eg.Go(func() error {
+		var err error
+		intrs, err = i.repo.GetByIds(ctx, biz, bizIds)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		liked, err = i.repo.LikedByIds(ctx, biz, bizIds, uid)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		collected, err = i.repo.CollectedByIds(ctx, biz, bizIds, uid)
+		return err
+	})
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	for id, intr := range intrs {
+		intr.Liked = liked[id]
+		intr.Collected = collected[id]
+		intrs[id] = intr
+	}
+	return intrs, nil
+}
+
+func (i *interactiveService) ListLiked(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.LikeRecord, error) {
+	return i.repo.ListLiked(ctx, uid, biz, offset, limit)
+}
+
+func (i *interactiveService) ListCollected(ctx context.Context, uid int64, biz string, offset int, limit int) ([]domain.CollectionItem, error) {
+	return i.repo.ListCollected(ctx, uid, biz, offset, limit)
+}
+
+func (i *interactiveService) IncrReadCnt(ctx context.Context, biz string, bizId int64, uid int64) error {
+	return i.repo.IncrReadCnt(ctx, biz, bizId, uid)
+}
+
+func (i *interactiveService) SyncUvCnt(ctx context.Context, limit int) (int, error) {
+	return i.repo.SyncUvCnt(ctx, limit)
+}
+
+func (i *interactiveService) IncrCommentCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	return i.repo.IncrCommentCnt(ctx, biz, bizId, delta)
 }
 
-func (i *interactiveService) IncrReadCnt(ctx context.Context, biz string, bizId int64) error {
-	return i.repo.IncrReadCnt(ctx, biz, bizId)
+func (i *interactiveService) Delete(ctx context.Context, biz string, bizId int64) error {
+	return i.repo.Delete(ctx, biz, bizId)
 }
 
 func (i *interactiveService) Like(ctx context.Context, biz string, bizId int64, uid int64) error {
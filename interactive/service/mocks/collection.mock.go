@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./collection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./collection.go -destination=mocks/collection.mock.go -package=svcmocks CollectionService
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/interactive/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCollectionService is a mock of CollectionService interface.
+type MockCollectionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionServiceMockRecorder
+}
+
+// MockCollectionServiceMockRecorder is the mock recorder for MockCollectionService.
+type MockCollectionServiceMockRecorder struct {
+	mock *MockCollectionService
+}
+
+// NewMockCollectionService creates a new mock instance.
+func NewMockCollectionService(ctrl *gomock.Controller) *MockCollectionService {
+	mock := &MockCollectionService{ctrl: ctrl}
+	mock.recorder = &MockCollectionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollectionService) EXPECT() *MockCollectionServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCollectionService) Create(ctx context.Context, c domain.Collection) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollectionServiceMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollectionService)(nil).Create), ctx, c)
+}
+
+// Delete mocks base method.
+func (m *MockCollectionService) Delete(ctx context.Context, id, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCollectionServiceMockRecorder) Delete(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollectionService)(nil).Delete), ctx, id, uid)
+}
+
+// List mocks base method.
+func (m *MockCollectionService) List(ctx context.Context, uid, viewer int64) ([]domain.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, uid, viewer)
+	ret0, _ := ret[0].([]domain.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCollectionServiceMockRecorder) List(ctx, uid, viewer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCollectionService)(nil).List), ctx, uid, viewer)
+}
+
+// ListItems mocks base method.
+func (m *MockCollectionService) ListItems(ctx context.Context, cid, viewer int64, offset, limit int) ([]domain.CollectionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", ctx, cid, viewer, offset, limit)
+	ret0, _ := ret[0].([]domain.CollectionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockCollectionServiceMockRecorder) ListItems(ctx, cid, viewer, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockCollectionService)(nil).ListItems), ctx, cid, viewer, offset, limit)
+}
+
+// Move mocks base method.
+func (m *MockCollectionService) Move(ctx context.Context, biz string, bizId, cid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, biz, bizId, cid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockCollectionServiceMockRecorder) Move(ctx, biz, bizId, cid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockCollectionService)(nil).Move), ctx, biz, bizId, cid, uid)
+}
+
+// Uncollect mocks base method.
+func (m *MockCollectionService) Uncollect(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uncollect", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uncollect indicates an expected call of Uncollect.
+func (mr *MockCollectionServiceMockRecorder) Uncollect(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uncollect", reflect.TypeOf((*MockCollectionService)(nil).Uncollect), ctx, biz, bizId, uid)
+}
+
+// Update mocks base method.
+func (m *MockCollectionService) Update(ctx context.Context, c domain.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCollectionServiceMockRecorder) Update(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCollectionService)(nil).Update), ctx, c)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./history.go -destination=mocks/history.mock.go -package=svcmocks ReadHistoryService
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/interactive/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReadHistoryService is a mock of ReadHistoryService interface.
+type MockReadHistoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadHistoryServiceMockRecorder
+}
+
+// MockReadHistoryServiceMockRecorder is the mock recorder for MockReadHistoryService.
+type MockReadHistoryServiceMockRecorder struct {
+	mock *MockReadHistoryService
+}
+
+// NewMockReadHistoryService creates a new mock instance.
+func NewMockReadHistoryService(ctrl *gomock.Controller) *MockReadHistoryService {
+	mock := &MockReadHistoryService{ctrl: ctrl}
+	mock.recorder = &MockReadHistoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadHistoryService) EXPECT() *MockReadHistoryServiceMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockReadHistoryService) Clear(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockReadHistoryServiceMockRecorder) Clear(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockReadHistoryService)(nil).Clear), ctx, uid)
+}
+
+// Delete mocks base method.
+func (m *MockReadHistoryService) Delete(ctx context.Context, uid int64, biz string, bizId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, biz, bizId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReadHistoryServiceMockRecorder) Delete(ctx, uid, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReadHistoryService)(nil).Delete), ctx, uid, biz, bizId)
+}
+
+// List mocks base method.
+func (m *MockReadHistoryService) List(ctx context.Context, uid int64, biz string, offset, limit int) ([]domain.ReadHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, uid, biz, offset, limit)
+	ret0, _ := ret[0].([]domain.ReadHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadHistoryServiceMockRecorder) List(ctx, uid, biz, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReadHistoryService)(nil).List), ctx, uid, biz, offset, limit)
+}
+
+// Record mocks base method.
+func (m *MockReadHistoryService) Record(ctx context.Context, h domain.ReadHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockReadHistoryServiceMockRecorder) Record(ctx, h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockReadHistoryService)(nil).Record), ctx, h)
+}
+
+// Trim mocks base method.
+func (m *MockReadHistoryService) Trim(ctx context.Context, keep, batch int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trim", ctx, keep, batch)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trim indicates an expected call of Trim.
+func (mr *MockReadHistoryServiceMockRecorder) Trim(ctx, keep, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockReadHistoryService)(nil).Trim), ctx, keep, batch)
+}
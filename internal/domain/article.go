@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"time"
+	"we_book/pkg/markdown"
+)
 
 type Article struct {
 	Id      int64
@@ -8,18 +11,184 @@ type Article struct {
 	Content string
 	Author  Author
 	Status  ArticleStatus
-	Ctime   time.Time
-	Utime   time.Time
+	// Category 一篇文章只属于一个分类
+	Category string
+	Tags     []string
+	// RevisionId 制作库中是当前草稿对应的版本
+	// 线上库中是读者看到的版本
+	RevisionId int64
+	Ctime      time.Time
+	Utime      time.Time
+	// Dtime 移到回收站的时间，不在回收站里面就是零值
+	Dtime time.Time
+	// Stats 保存的时候根据内容统计
+	Stats ArticleStats
+	// Summary 保存的时候算好的摘要，Abstract 优先用它
+	Summary string
+	// Bylines 读者端的署名，线上库的文章回源的时候查出来，和文章一起缓存
+	Bylines []Author
+	// Editor 保存这次修改的人，协作者编辑的时候 Author 是所有者
+	// 只用来记在生成的版本上，为零值的时候就是 Author
+	Editor Author
+}
+
+const (
+	// cjkPerMinute 中日韩文字每分钟读多少字
+	cjkPerMinute = 300
+	// latinPerMinute 其他文字每分钟读多少词
+	latinPerMinute = 200
+	// firstImageTime 第一张图片看 12 秒，后面每张少一秒，最少 3 秒
+	firstImageTime = 12 * time.Second
+	minImageTime   = 3 * time.Second
+)
+
+// ArticleStats 文章的字数统计和预计阅读时间
+type ArticleStats struct {
+	// Words 中日韩文字一个字算一个词
+	Words int
+	// Chars 去掉空白之后的字符数
+	Chars  int
+	Images int
+	// ReadingTime 预计阅读时间，精确到秒
+	ReadingTime time.Duration
+}
+
+func NewArticleStats(content string) ArticleStats {
+	cnt := markdown.Count(content)
+	reading := time.Duration(cnt.CJK)*time.Minute/cjkPerMinute +
+		time.Duration(cnt.Latin)*time.Minute/latinPerMinute
+	for i := 0; i < cnt.Images; i++ {
+		img := firstImageTime - time.Duration(i)*time.Second
+		if img < minImageTime {
+			img = minImageTime
+		}
+		reading += img
+	}
+	return ArticleStats{
+		Words:       cnt.Words,
+		Chars:       cnt.Chars,
+		Images:      cnt.Images,
+		ReadingTime: reading.Round(time.Second),
+	}
+}
+
+// ReadingMinutes 向上取整到分钟，有内容的文章至少一分钟
+func (s ArticleStats) ReadingMinutes() int {
+	if s.ReadingTime <= 0 {
+		return 0
+	}
+	return int((s.ReadingTime + time.Minute - 1) / time.Minute)
 }
 
+// Abstract 取 Markdown 去掉格式之后的前 100 个字
+// 要渲染整篇文章，保存的时候算一次放在 Summary 里面，这里只给没有算过的兜底
 func (a Article) Abstract() string {
-	sc := []rune(a.Content)
+	if a.Summary != "" {
+		return a.Summary
+	}
+	return NewAbstract(a.Content)
+}
+
+func NewAbstract(content string) string {
+	return abstractOf(markdown.PlainText(content))
+}
+
+func abstractOf(text string) string {
+	sc := []rune(text)
 	if len(sc) < 100 {
-		return a.Content
+		return text
 	}
 	return string(sc[:100])
 }
 
+// RenderedContent 文章内容渲染之后的结果，同一个版本渲染的结果不会变
+type RenderedContent struct {
+	// HTML 已经过滤过，可以直接输出给读者
+	HTML string
+	TOC  []TOCItem
+	// Abstract 从渲染结果里面取的纯文本摘要
+	Abstract string
+}
+
+func NewRenderedContent(doc markdown.Document) RenderedContent {
+	toc := make([]TOCItem, 0, len(doc.TOC))
+	for _, h := range doc.TOC {
+		toc = append(toc, TOCItem{
+			Level: h.Level,
+			Id:    h.Id,
+			Title: h.Title,
+		})
+	}
+	return RenderedContent{
+		HTML:     doc.HTML,
+		TOC:      toc,
+		Abstract: abstractOf(doc.Text),
+	}
+}
+
+// FeedDoc 渲染好的订阅源，ETag 是内容的摘要
+type FeedDoc struct {
+	Content  []byte
+	ETag     string
+	Modified time.Time
+}
+
+// TOCItem 目录里面的一项，Id 就是 HTML 里面标题的锚点
+type TOCItem struct {
+	Level int
+	Id    string
+	Title string
+}
+
+// ArticleCursor 按照 utime、id 倒序翻页的位置，零值就是第一页
+// 下一页从 Utime、Id 都比它小的文章开始
+type ArticleCursor struct {
+	Utime time.Time
+	Id    int64
+}
+
+func (c ArticleCursor) IsZero() bool {
+	return c.Id == 0 && c.Utime.IsZero()
+}
+
+// NextCursor 最后一篇文章就是下一页的位置
+func NextCursor(arts []Article) ArticleCursor {
+	if len(arts) == 0 {
+		return ArticleCursor{}
+	}
+	last := arts[len(arts)-1]
+	return ArticleCursor{
+		Utime: last.Utime,
+		Id:    last.Id,
+	}
+}
+
+// ArticleRevision 文章的一个历史版本，每次保存都会产生一个
+type ArticleRevision struct {
+	Id        int64
+	ArticleId int64
+	// Version 同一篇文章内从 1 开始递增
+	Version int64
+	Title   string
+	Content string
+	Author  Author
+	Ctime   time.Time
+	// Editor 保存出这个版本的人
+	Editor Author
+}
+
+// ScheduledPublication 一次还没有执行的定时发布
+type ScheduledPublication struct {
+	Article   Article
+	PublishAt time.Time
+}
+
+// Tag 标签以及带有这个标签的已发表文章数
+type Tag struct {
+	Name string
+	Cnt  int64
+}
+
 type Author struct {
 	Id   int64
 	Name string
@@ -32,6 +201,14 @@ const (
 	ArticleStatusUnpublished
 	ArticleStatusPublished
 	ArticleStatusPrivate
+	// ArticleStatusScheduled 等待定时发布
+	ArticleStatusScheduled
+	// ArticleStatusTrashed 在回收站里面，过了保留期会被彻底删除
+	ArticleStatusTrashed
+	// ArticleStatusPendingReview 发表的时候没有通过自动审核，等待人工复审
+	ArticleStatusPendingReview
+	// ArticleStatusRejected 人工复审没有通过，作者修改之后可以重新发表
+	ArticleStatusRejected
 )
 
 func (s ArticleStatus) ToUint8() uint {
@@ -50,6 +227,14 @@ func (s ArticleStatus) toString() string {
 		return "unpublished"
 	case ArticleStatusPublished:
 		return "published"
+	case ArticleStatusScheduled:
+		return "scheduled"
+	case ArticleStatusTrashed:
+		return "trashed"
+	case ArticleStatusPendingReview:
+		return "pending_review"
+	case ArticleStatusRejected:
+		return "rejected"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,31 @@
+package domain
+
+// TopicArticleEvent 文章发表、撤回的事件，按照文章 ID 分区保证同一篇文章有序
+const TopicArticleEvent = "article_events"
+
+// 事件类型放在 Kafka 消息的 type 头里面
+const (
+	ArticleEventPublished = "article_published"
+	ArticleEventWithdrawn = "article_withdrawn"
+)
+
+// ArticlePublished 文章发表，或者撤回之后重新发表
+// 内容就是这一次读者能看到的内容，消费者不需要再回查
+type ArticlePublished struct {
+	Aid        int64    `json:"aid"`
+	Uid        int64    `json:"uid"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content"`
+	Category   string   `json:"category"`
+	Tags       []string `json:"tags"`
+	RevisionId int64    `json:"revision_id"`
+	// Utime 毫秒数，同一篇文章的事件可以用它去重
+	Utime int64 `json:"utime"`
+}
+
+// ArticleWithdrawn 文章撤回或者移到回收站，读者已经看不到了
+type ArticleWithdrawn struct {
+	Aid   int64 `json:"aid"`
+	Uid   int64 `json:"uid"`
+	Utime int64 `json:"utime"`
+}
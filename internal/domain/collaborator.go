@@ -0,0 +1,110 @@
+package domain
+
+import "time"
+
+// Collaborator 文章的协作者，所有者邀请之后，对方接受了才生效
+type Collaborator struct {
+	ArticleId int64
+	User      Author
+	Role      CollaboratorRole
+	Status    InvitationStatus
+	// Inviter 发出邀请的人，也就是文章的所有者
+	Inviter int64
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+// Active 接受了邀请才有权限
+func (c Collaborator) Active() bool {
+	return c.Status == InvitationStatusAccepted
+}
+
+// NewBylines 读者端的署名，所有者在前面，然后是编辑，只读的协作者不署名
+func NewBylines(owner Author, collabs []Collaborator) []Author {
+	res := make([]Author, 0, len(collabs)+1)
+	res = append(res, owner)
+	for _, c := range collabs {
+		if c.Active() && c.Role == CollaboratorRoleEditor {
+			res = append(res, c.User)
+		}
+	}
+	return res
+}
+
+type CollaboratorRole uint8
+
+const (
+	// CollaboratorRoleNone 和文章没有关系
+	CollaboratorRoleNone CollaboratorRole = iota
+	// CollaboratorRoleOwner 创建文章的人，不能邀请别人当所有者
+	CollaboratorRoleOwner
+	// CollaboratorRoleEditor 可以编辑、发表、撤回，发表之后会署名
+	CollaboratorRoleEditor
+	// CollaboratorRoleViewer 只能看草稿
+	CollaboratorRoleViewer
+)
+
+func (r CollaboratorRole) ToUint8() uint8 {
+	return uint8(r)
+}
+
+func (r CollaboratorRole) String() string {
+	switch r {
+	case CollaboratorRoleOwner:
+		return "owner"
+	case CollaboratorRoleEditor:
+		return "editor"
+	case CollaboratorRoleViewer:
+		return "viewer"
+	default:
+		return "none"
+	}
+}
+
+// CanEdit 能不能编辑、发表、撤回
+func (r CollaboratorRole) CanEdit() bool {
+	return r == CollaboratorRoleOwner || r == CollaboratorRoleEditor
+}
+
+// CanView 能不能看草稿
+func (r CollaboratorRole) CanView() bool {
+	return r != CollaboratorRoleNone
+}
+
+// CollaboratorRoleOf 只能邀请编辑和只读两种角色
+func CollaboratorRoleOf(s string) CollaboratorRole {
+	switch s {
+	case "editor":
+		return CollaboratorRoleEditor
+	case "viewer":
+		return CollaboratorRoleViewer
+	default:
+		return CollaboratorRoleNone
+	}
+}
+
+type InvitationStatus uint8
+
+const (
+	InvitationStatusUnknown InvitationStatus = iota
+	InvitationStatusPending
+	InvitationStatusAccepted
+	InvitationStatusDeclined
+)
+
+func (s InvitationStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s InvitationStatus) String() string {
+	switch s {
+	case InvitationStatusPending:
+		return "pending"
+	case InvitationStatusAccepted:
+		return "accepted"
+	case InvitationStatusDeclined:
+		return "declined"
+	default:
+		return "unknown"
+	}
+}
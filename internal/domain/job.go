@@ -6,18 +6,29 @@ import (
 )
 
 type Job struct {
-	Id         int64
-	Name       string
-	Cron       string
-	Executor   string
-	Cfg        string
-	CancelFunc func() error
+	Id       int64
+	Name     string
+	Cron     string
+	Executor string
+	Cfg      string
+	// Attempts 连续执行失败的次数
+	Attempts int
+	// NextExecTime 下一次执行的时间
+	NextExecTime time.Time
+	CancelFunc   func() error
 }
 
 var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom |
 	cron.Month | cron.Dow | cron.Descriptor)
 
+// NextTime 一次性任务没有 Cron，执行完之后不会再有下一次
 func (j Job) NextTime() time.Time {
-	s, _ := parser.Parse(j.Cron)
+	if j.Cron == "" {
+		return time.Time{}
+	}
+	s, err := parser.Parse(j.Cron)
+	if err != nil {
+		return time.Time{}
+	}
 	return s.Next(time.Now())
 }
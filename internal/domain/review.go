@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// ModerationResult 自动审核的结果，Pass 为 false 的时候要人工复审
+type ModerationResult struct {
+	Pass bool
+	// Hits 命中的敏感词
+	Hits []string
+}
+
+// ArticleReview 文章的人工复审，一篇文章只保留最近的一次
+type ArticleReview struct {
+	ArticleId int64
+	Author    Author
+	Status    ReviewStatus
+	// Hits 自动审核命中的敏感词，给审核的人看
+	Hits []string
+	// Reason 驳回的原因，作者可以看到
+	Reason   string
+	Reviewer int64
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+type ReviewStatus uint8
+
+const (
+	ReviewStatusUnknown ReviewStatus = iota
+	ReviewStatusPending
+	ReviewStatusApproved
+	ReviewStatusRejected
+	// ReviewStatusCancelled 复审之前作者又修改了文章
+	ReviewStatusCancelled
+)
+
+func (s ReviewStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s ReviewStatus) String() string {
+	switch s {
+	case ReviewStatusPending:
+		return "pending"
+	case ReviewStatusApproved:
+		return "approved"
+	case ReviewStatusRejected:
+		return "rejected"
+	case ReviewStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+func ReviewStatusOf(s string) ReviewStatus {
+	switch s {
+	case "pending":
+		return ReviewStatusPending
+	case "approved":
+		return ReviewStatusApproved
+	case "rejected":
+		return ReviewStatusRejected
+	case "cancelled":
+		return ReviewStatusCancelled
+	default:
+		return ReviewStatusUnknown
+	}
+}
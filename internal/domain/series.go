@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// Series 系列，作者把一个长的教程拆成多篇文章，按照顺序组织起来
+type Series struct {
+	Id          int64
+	Title       string
+	Description string
+	Author      Author
+	// ArticleIds 按照章节的顺序，一篇文章只能属于一个系列
+	ArticleIds []int64
+	Ctime      time.Time
+	Utime      time.Time
+}
+
+// IndexOf 文章在系列里面的位置，不在系列里面返回 -1
+func (s Series) IndexOf(aid int64) int {
+	for i, id := range s.ArticleIds {
+		if id == aid {
+			return i
+		}
+	}
+	return -1
+}
+
+// SeriesNav 读者看某一章的时候，所在的系列和前后章节
+// 没有发表的章节会被跳过，Prev 和 Next 为 nil 说明已经是第一章或者最后一章
+type SeriesNav struct {
+	Series Series
+	// Index 当前章节的位置，从 0 开始
+	Index int
+	Prev  *Article
+	Next  *Article
+}
@@ -0,0 +1,116 @@
+package domain
+
+import "time"
+
+// TransferTask 一次批量导入或者导出文章，在后台任务里面执行
+type TransferTask struct {
+	Id   int64
+	Uid  int64
+	Type TransferType
+	// Format 导出的格式，导入的时候按照压缩包里面的文件决定
+	Format TransferFormat
+	Status TransferStatus
+	// Total 需要处理的文章数，开始执行之后才知道
+	Total  int
+	Done   int
+	Failed int
+	// Errors 失败的文章以及原因，只保留前面一部分
+	Errors []string
+	// Msg 整个任务失败的原因
+	Msg   string
+	Ctime time.Time
+	Utime time.Time
+}
+
+// Finished 已经执行完，不管成功还是失败
+func (t TransferTask) Finished() bool {
+	return t.Status == TransferStatusDone || t.Status == TransferStatusFailed
+}
+
+type TransferType uint8
+
+const (
+	TransferTypeUnknown TransferType = iota
+	TransferTypeImport
+	TransferTypeExport
+)
+
+func (t TransferType) ToUint8() uint8 {
+	return uint8(t)
+}
+
+func (t TransferType) String() string {
+	switch t {
+	case TransferTypeImport:
+		return "import"
+	case TransferTypeExport:
+		return "export"
+	default:
+		return "unknown"
+	}
+}
+
+type TransferFormat uint8
+
+const (
+	TransferFormatUnknown TransferFormat = iota
+	// TransferFormatMarkdown 每篇文章一个带 front matter 的 Markdown 文件
+	TransferFormatMarkdown
+	// TransferFormatJSON 所有文章放在一个 JSON 文件里面
+	TransferFormatJSON
+)
+
+func (f TransferFormat) ToUint8() uint8 {
+	return uint8(f)
+}
+
+func (f TransferFormat) String() string {
+	switch f {
+	case TransferFormatMarkdown:
+		return "markdown"
+	case TransferFormatJSON:
+		return "json"
+	default:
+		return "unknown"
+	}
+}
+
+func TransferFormatOf(s string) TransferFormat {
+	switch s {
+	case "markdown", "md":
+		return TransferFormatMarkdown
+	case "json":
+		return TransferFormatJSON
+	default:
+		return TransferFormatUnknown
+	}
+}
+
+type TransferStatus uint8
+
+const (
+	TransferStatusUnknown TransferStatus = iota
+	TransferStatusPending
+	TransferStatusRunning
+	TransferStatusDone
+	TransferStatusFailed
+)
+
+func (s TransferStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+func (s TransferStatus) String() string {
+	switch s {
+	case TransferStatusPending:
+		return "pending"
+	case TransferStatusRunning:
+		return "running"
+	case TransferStatusDone:
+		return "done"
+	case TransferStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// Upload 作者上传的图片，Name 是随机生成的，文章内容里面通过 URL 引用
+type Upload struct {
+	Id   int64
+	Name string
+	// Key 原图在对象存储里面的 key，ThumbKey 是缩略图的
+	Key         string
+	ThumbKey    string
+	Uploader    int64
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	// URL 和 ThumbURL 由 Key 拼出来，不存数据库
+	URL      string
+	ThumbURL string
+	Ctime    time.Time
+}
+
+// UploadRefType 区分制作库和线上库的引用
+// 作者在草稿里面删掉一张图片，线上库的文章还在用，这张图片不能被清理
+type UploadRefType uint8
+
+const (
+	UploadRefUnknown UploadRefType = iota
+	UploadRefDraft
+	UploadRefPublished
+)
+
+func (t UploadRefType) ToUint8() uint8 {
+	return uint8(t)
+}
+
+// uploadKeyPattern 和 service 里面生成 key 的格式保持一致，
+// 不管 URL 前面是本站的地址还是 CDN 的地址，都只看 key 的部分，缩略图也算引用了原图
+var uploadKeyPattern = regexp.MustCompile(`img/\d+/\d{8}/([0-9a-f]{32})(?:_thumb)?\.(?:jpg|png|gif)`)
+
+// IsUploadKey key 是不是上传的图片，读图片的时候只允许这种格式的 key，
+// 对象存储和别的数据共用的时候不会被读到别的东西
+func IsUploadKey(key string) bool {
+	loc := uploadKeyPattern.FindStringIndex(key)
+	return loc != nil && loc[0] == 0 && loc[1] == len(key)
+}
+
+// UploadNames 内容里面引用的上传图片，按照第一次出现的顺序，不重复
+func UploadNames(content string) []string {
+	var res []string
+	seen := map[string]struct{}{}
+	for _, m := range uploadKeyPattern.FindAllStringSubmatch(content, -1) {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		res = append(res, m[1])
+	}
+	return res
+}
@@ -59,6 +59,9 @@ func (s *ArticleMongoHandlerTestSuite) TearDownTest() {
 	_, err = s.mdb.Collection("live_articles").
 		DeleteMany(ctx, bson.D{})
 	assert.NoError(s.T(), err)
+	_, err = s.mdb.Collection("article_revisions").
+		DeleteMany(ctx, bson.D{})
+	assert.NoError(s.T(), err)
 }
 
 func (s *ArticleMongoHandlerTestSuite) TestCleanMongo() {
@@ -98,16 +101,19 @@ func (s *ArticleMongoHandlerTestSuite) TestArticleHandler_Edit() {
 				defer cancel()
 				// 验证一下数据
 				var art article.Article
-				err := s.col.FindOne(ctx, bson.D{bson.E{"author_id", 123}}).Decode(&art)
+				err := s.col.FindOne(ctx, bson.D{bson.E{Key: "author_id", Value: 123}}).Decode(&art)
 				assert.NoError(t, err)
 				assert.True(t, art.Ctime > 0)
 				assert.True(t, art.Utime > 0)
 				// 我们断定 ID 生成了
 				assert.True(t, art.Id > 0)
+				// 保存的时候会生成一个版本
+				assert.True(t, art.RevisionId > 0)
 				// 重置了这些值，因为无法比较
 				art.Utime = 0
 				art.Ctime = 0
 				art.Id = 0
+				art.RevisionId = 0
 				assert.Equal(t, article.Article{
 					Title:    "hello，你好",
 					Content:  "随便试试",
@@ -151,7 +157,9 @@ func (s *ArticleMongoHandlerTestSuite) TestArticleHandler_Edit() {
 				err := s.col.FindOne(ctx, bson.D{bson.E{Key: "id", Value: 2}}).Decode(&art)
 				assert.NoError(t, err)
 				assert.True(t, art.Utime > 234)
+				assert.True(t, art.RevisionId > 0)
 				art.Utime = 0
+				art.RevisionId = 0
 				assert.Equal(t, article.Article{
 					Id:       2,
 					Title:    "新的标题",
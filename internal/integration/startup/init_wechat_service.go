@@ -1,8 +1,8 @@
 package startup
 
 import (
-	"we_book/internal/pkg/logger"
 	"we_book/internal/service/oauth2/wechat"
+	"we_book/pkg/logger"
 )
 
 func InitPhoneToWechatService(v1 logger.V1) wechat.Service {
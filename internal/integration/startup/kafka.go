@@ -0,0 +1,20 @@
+package startup
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+)
+
+func InitKafka() sarama.Client {
+	addrs := viper.GetStringSlice("kafka.addrs")
+	if len(addrs) == 0 {
+		addrs = []string{"localhost:9094"}
+	}
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Producer.Return.Successes = true
+	client, err := sarama.NewClient(addrs, saramaCfg)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
@@ -1,6 +1,6 @@
 package startup
 
-import "we_book/internal/pkg/logger"
+import "we_book/pkg/logger"
 
 //func InitLogger() logger.V1 {
 //	l, err := zap.NewDevelopment()
@@ -1,9 +1,9 @@
 package startup
 
 import (
-	"we_book/internal/pkg/logger"
 	"we_book/internal/service/oauth2/wechat"
 	"we_book/internal/web"
+	"we_book/pkg/logger"
 )
 
 func InitWechatService(l logger.V1) wechat.Service {
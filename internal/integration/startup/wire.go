@@ -5,21 +5,45 @@ package startup
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
+	commentRepo "we_book/comment/repository"
+	commentDAO "we_book/comment/repository/dao"
+	commentSvc "we_book/comment/service"
+	events "we_book/events/article"
+	repository2 "we_book/interactive/repository"
+	cache2 "we_book/interactive/repository/cache"
+	dao2 "we_book/interactive/repository/dao"
+	service2 "we_book/interactive/service"
 	"we_book/internal/repository"
 	"we_book/internal/repository/article"
 	"we_book/internal/repository/cache"
 	"we_book/internal/repository/dao"
 	article2 "we_book/internal/repository/dao/article"
+	"we_book/internal/search"
 	"we_book/internal/service"
 	"we_book/internal/web"
 	ijwt "we_book/internal/web/jwt"
 	"we_book/ioc"
 )
 
-var thirdProviderSet = wire.NewSet(InitRedis, InitLogger, InitDB, InitMongoDB)
+var thirdProviderSet = wire.NewSet(InitRedis, InitLogger, InitDB, InitMongoDB, InitKafka, ioc.NewSyncProducer)
 var userSvcProviderSet = wire.NewSet(dao.NewUserDAO, cache.NewUserCache, repository.NewUserRepository, service.NewUserService, web.NewUserHandler)
 var codeSvcProviderSet = wire.NewSet(cache.NewRedisCodeCache, repository.NewCodeRepository, service.NewCodeService)
-var articleSvcProviderSet = wire.NewSet(article2.NewGORMArticleDAO, article.NewArticleRepository, service.NewArticleService, web.NewArticleHandler)
+var interactiveSvcProviderSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCacheInteractiveRepository, service2.NewInteractiveService)
+var articleSvcProviderSet = wire.NewSet(cache.NewRedisArticleCache, article.NewArticleRepository,
+	dao.NewGORMJobDAO, repository.NewPreemptCronJobRepository, events.NewKafkaProducer,
+	dao.NewGORMCollaboratorDAO, repository.NewCollaboratorRepository,
+	dao.NewGORMReviewDAO, repository.NewReviewRepository, ioc.InitModerator,
+	dao.NewGORMUploadDAO, repository.NewUploadRepository,
+	dao.NewGORMSeriesDAO, repository.NewSeriesRepository, service.NewSeriesService,
+	service.NewArticleService, web.NewArticleHandler)
+var searchProviderSet = wire.NewSet(search.NewMemoryIndex,
+	wire.Bind(new(search.Searcher), new(*search.MemoryIndex)),
+	service.NewSearchService, web.NewSearchHandler)
+var commentProviderSet = wire.NewSet(commentDAO.NewGORMCommentDAO, commentRepo.NewCommentRepository,
+	commentSvc.NewCommentService, ioc.InitCommentBizOwners, web.NewCommentHandler)
+var feedProviderSet = wire.NewSet(ioc.InitFeedService, web.NewFeedHandler)
+var transferProviderSet = wire.NewSet(dao.NewGORMTransferTaskDAO, repository.NewTransferTaskRepository,
+	ioc.InitArticleTransferService, web.NewArticleTransferHandler)
 
 func InitWebServer() *gin.Engine {
 	wire.Build(
@@ -30,7 +54,25 @@ func InitWebServer() *gin.Engine {
 		// 验证码服务
 		codeSvcProviderSet,
 		// 文章服务
+		article2.NewGORMArticleDAO,
+		interactiveSvcProviderSet,
 		articleSvcProviderSet,
+		searchProviderSet,
+		commentProviderSet,
+		feedProviderSet,
+		transferProviderSet,
+		web.NewSeriesHandler,
+		ioc.InitUploadService,
+		web.NewUploadHandler,
+		dao2.NewGORMCollectionDAO,
+		repository2.NewCollectionRepository,
+		service2.NewCollectionService,
+		web.NewCollectionHandler,
+		web.NewUserInteractiveHandler,
+		dao2.NewGORMReadHistoryDAO,
+		repository2.NewReadHistoryRepository,
+		service2.NewReadHistoryService,
+		web.NewHistoryHandler,
 
 		InitSMSService,
 		InitPhoneToWechatService,
@@ -44,13 +86,14 @@ func InitWebServer() *gin.Engine {
 	return new(gin.Engine)
 }
 
-func InitArticleHandler(dao article2.ArticleDAO) *web.ArticleHandler {
+func InitArticleHandler(artDAO article2.ArticleDAO) *web.ArticleHandler {
 	wire.Build(
-		article.NewArticleRepository,
-		service.NewArticleService,
-		web.NewArticleHandler,
 		thirdProviderSet,
-		//articleSvcProviderSet,
+		dao.NewUserDAO,
+		cache.NewUserCache,
+		repository.NewUserRepository,
+		interactiveSvcProviderSet,
+		articleSvcProviderSet,
 	)
 	return new(web.ArticleHandler)
 }
@@ -9,11 +9,20 @@ package startup
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
+	repository3 "we_book/comment/repository"
+	dao3 "we_book/comment/repository/dao"
+	service3 "we_book/comment/service"
+	article3 "we_book/events/article"
+	repository2 "we_book/interactive/repository"
+	cache2 "we_book/interactive/repository/cache"
+	dao2 "we_book/interactive/repository/dao"
+	service2 "we_book/interactive/service"
 	"we_book/internal/repository"
 	article2 "we_book/internal/repository/article"
 	"we_book/internal/repository/cache"
 	"we_book/internal/repository/dao"
 	"we_book/internal/repository/dao/article"
+	"we_book/internal/search"
 	"we_book/internal/service"
 	"we_book/internal/web"
 	"we_book/internal/web/jwt"
@@ -38,30 +47,111 @@ func InitWebServer() *gin.Engine {
 	codeService := service.NewCodeService(codeRepository, smsService)
 	userHandler := web.NewUserHandler(userService, codeService, handler)
 	articleDAO := article.NewGORMArticleDAO(db)
-	articleRepository := article2.NewArticleRepository(articleDAO)
-	articleService := service.NewArticleService(articleRepository)
-	articleHandler := web.NewArticleHandler(articleService, v1)
+	articleCache := cache.NewRedisArticleCache(cmdable)
+	uploadDAO := dao.NewGORMUploadDAO(db)
+	uploadRepository := repository.NewUploadRepository(uploadDAO)
+	collaboratorDAO := dao.NewGORMCollaboratorDAO(db)
+	collaboratorRepository := repository.NewCollaboratorRepository(collaboratorDAO, userRepository, v1)
+	articleRepository := article2.NewArticleRepository(articleDAO, articleCache, userRepository, uploadRepository, collaboratorRepository, v1)
+	jobDAO := dao.NewGORMJobDAO(db)
+	jobRepository := repository.NewPreemptCronJobRepository(jobDAO)
+	client := InitKafka()
+	syncProducer := ioc.NewSyncProducer(client)
+	producer := article3.NewKafkaProducer(syncProducer)
+	reviewDAO := dao.NewGORMReviewDAO(db)
+	reviewRepository := repository.NewReviewRepository(reviewDAO)
+	moderator := ioc.InitModerator()
+	articleService := service.NewArticleService(articleRepository, jobRepository, collaboratorRepository, reviewRepository, moderator, v1, producer)
+	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
+	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
+	interactiveRepository := repository2.NewCacheInteractiveRepository(interactiveCache, interactiveDAO, v1)
+	interactiveService := service2.NewInteractiveService(interactiveRepository, v1)
+	seriesDAO := dao.NewGORMSeriesDAO(db)
+	seriesRepository := repository.NewSeriesRepository(seriesDAO)
+	seriesService := service.NewSeriesService(seriesRepository, articleRepository, v1)
+	articleHandler := web.NewArticleHandler(articleService, seriesService, interactiveService, v1)
+	memoryIndex := search.NewMemoryIndex()
+	searchService := service.NewSearchService(memoryIndex)
+	searchHandler := web.NewSearchHandler(searchService, v1)
+	commentDAO := dao3.NewGORMCommentDAO(db)
+	commentRepository := repository3.NewCommentRepository(commentDAO, v1)
+	bizOwners := ioc.InitCommentBizOwners(articleRepository)
+	commentService := service3.NewCommentService(commentRepository, interactiveService, bizOwners, v1)
+	commentHandler := web.NewCommentHandler(commentService, v1)
+	feedService := ioc.InitFeedService(articleRepository, userRepository, v1)
+	feedHandler := web.NewFeedHandler(feedService, v1)
+	transferTaskDAO := dao.NewGORMTransferTaskDAO(db)
+	transferTaskRepository := repository.NewTransferTaskRepository(transferTaskDAO)
+	articleTransferService := ioc.InitArticleTransferService(articleService, transferTaskRepository, jobRepository, v1)
+	articleTransferHandler := web.NewArticleTransferHandler(articleTransferService, v1)
+	seriesHandler := web.NewSeriesHandler(seriesService, v1)
+	uploadService := ioc.InitUploadService(uploadRepository, v1)
+	uploadHandler := web.NewUploadHandler(uploadService, v1)
+	collectionDAO := dao2.NewGORMCollectionDAO(db)
+	collectionRepository := repository2.NewCollectionRepository(collectionDAO, interactiveDAO, interactiveCache, v1)
+	collectionService := service2.NewCollectionService(collectionRepository, v1)
+	collectionHandler := web.NewCollectionHandler(collectionService, interactiveService, articleService, v1)
+	userInteractiveHandler := web.NewUserInteractiveHandler(interactiveService, articleService, v1)
+	readHistoryDAO := dao2.NewGORMReadHistoryDAO(db)
+	readHistoryRepository := repository2.NewReadHistoryRepository(readHistoryDAO)
+	readHistoryService := service2.NewReadHistoryService(readHistoryRepository, v1)
+	historyHandler := web.NewHistoryHandler(readHistoryService, articleService, v1)
 	wechatService := InitPhoneToWechatService(v1)
 	wechatHandlerConfig := NewWechatHandlerConfig()
 	oAuth2WeChatHandler := web.NewOAuth2WeChatHandler(wechatService, userService, handler, wechatHandlerConfig)
-	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WeChatHandler)
+	engine := ioc.InitWebServer(v, userHandler, articleHandler, searchHandler, commentHandler, feedHandler, articleTransferHandler, seriesHandler, uploadHandler, collectionHandler, userInteractiveHandler, historyHandler, oAuth2WeChatHandler)
 	return engine
 }
 
-func InitArticleHandler(dao2 article.ArticleDAO) *web.ArticleHandler {
-	articleRepository := article2.NewArticleRepository(dao2)
-	articleService := service.NewArticleService(articleRepository)
+func InitArticleHandler(dao4 article.ArticleDAO) *web.ArticleHandler {
+	cmdable := InitRedis()
+	articleCache := cache.NewRedisArticleCache(cmdable)
+	db := InitDB()
+	userDAO := dao.NewUserDAO(db)
+	userCache := cache.NewUserCache(cmdable)
+	userRepository := repository.NewUserRepository(userDAO, userCache)
 	v1 := InitLogger()
-	articleHandler := web.NewArticleHandler(articleService, v1)
+	uploadDAO := dao.NewGORMUploadDAO(db)
+	uploadRepository := repository.NewUploadRepository(uploadDAO)
+	collaboratorDAO := dao.NewGORMCollaboratorDAO(db)
+	collaboratorRepository := repository.NewCollaboratorRepository(collaboratorDAO, userRepository, v1)
+	articleRepository := article2.NewArticleRepository(dao4, articleCache, userRepository, uploadRepository, collaboratorRepository, v1)
+	jobDAO := dao.NewGORMJobDAO(db)
+	jobRepository := repository.NewPreemptCronJobRepository(jobDAO)
+	client := InitKafka()
+	syncProducer := ioc.NewSyncProducer(client)
+	producer := article3.NewKafkaProducer(syncProducer)
+	reviewDAO := dao.NewGORMReviewDAO(db)
+	reviewRepository := repository.NewReviewRepository(reviewDAO)
+	moderator := ioc.InitModerator()
+	articleService := service.NewArticleService(articleRepository, jobRepository, collaboratorRepository, reviewRepository, moderator, v1, producer)
+	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
+	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
+	interactiveRepository := repository2.NewCacheInteractiveRepository(interactiveCache, interactiveDAO, v1)
+	interactiveService := service2.NewInteractiveService(interactiveRepository, v1)
+	seriesDAO := dao.NewGORMSeriesDAO(db)
+	seriesRepository := repository.NewSeriesRepository(seriesDAO)
+	seriesService := service.NewSeriesService(seriesRepository, articleRepository, v1)
+	articleHandler := web.NewArticleHandler(articleService, seriesService, interactiveService, v1)
 	return articleHandler
 }
 
 // wire.go:
 
-var thirdProviderSet = wire.NewSet(InitRedis, InitLogger, InitDB, InitMongoDB)
+var thirdProviderSet = wire.NewSet(InitRedis, InitLogger, InitDB, InitMongoDB, InitKafka, ioc.NewSyncProducer)
 
 var userSvcProviderSet = wire.NewSet(dao.NewUserDAO, cache.NewUserCache, repository.NewUserRepository, service.NewUserService, web.NewUserHandler)
 
 var codeSvcProviderSet = wire.NewSet(cache.NewRedisCodeCache, repository.NewCodeRepository, service.NewCodeService)
 
-var articleSvcProviderSet = wire.NewSet(article.NewGORMArticleDAO, article2.NewArticleRepository, service.NewArticleService, web.NewArticleHandler)
+var interactiveSvcProviderSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCacheInteractiveRepository, service2.NewInteractiveService)
+
+var articleSvcProviderSet = wire.NewSet(cache.NewRedisArticleCache, article2.NewArticleRepository, dao.NewGORMJobDAO, repository.NewPreemptCronJobRepository, article3.NewKafkaProducer, service.NewArticleService, web.NewArticleHandler)
+
+var searchProviderSet = wire.NewSet(search.NewMemoryIndex, wire.Bind(new(search.Searcher), new(*search.MemoryIndex)), service.NewSearchService, web.NewSearchHandler)
+
+var commentProviderSet = wire.NewSet(dao3.NewGORMCommentDAO, repository3.NewCommentRepository, service3.NewCommentService, ioc.InitCommentBizOwners, web.NewCommentHandler)
+
+var feedProviderSet = wire.NewSet(ioc.InitFeedService, web.NewFeedHandler)
+
+var transferProviderSet = wire.NewSet(dao.NewGORMTransferTaskDAO, repository.NewTransferTaskRepository, ioc.InitArticleTransferService, web.NewArticleTransferHandler)
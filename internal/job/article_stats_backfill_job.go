@@ -0,0 +1,53 @@
+package job
+
+import (
+	"context"
+	"time"
+	"we_book/internal/service"
+	"we_book/pkg/logger"
+)
+
+// ArticleStatsBackfillJob 字数统计和摘要上线之前保存的文章都是零值，一次性补上
+// 作为一次性任务放在 MySQL 里面调度，只会有一个实例执行，失败了从头再来，补过的文章会跳过
+type ArticleStatsBackfillJob struct {
+	svc       service.ArticleService
+	l         logger.V1
+	timeout   time.Duration
+	batchSize int
+}
+
+func NewArticleStatsBackfillJob(svc service.ArticleService, l logger.V1,
+	timeout time.Duration) *ArticleStatsBackfillJob {
+	return &ArticleStatsBackfillJob{
+		svc:       svc,
+		l:         l,
+		timeout:   timeout,
+		batchSize: 100,
+	}
+}
+
+func (a *ArticleStatsBackfillJob) Name() string {
+	return "article_stats_backfill_job"
+}
+
+func (a *ArticleStatsBackfillJob) Run() error {
+	var (
+		startId int64
+		total   int
+	)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+		next, n, err := a.svc.BackfillStats(ctx, startId, a.batchSize)
+		cancel()
+		if err != nil {
+			return err
+		}
+		total += n
+		if n < a.batchSize {
+			a.l.Info("article stats backfill finished",
+				logger.Int64("count", int64(total)))
+			return nil
+		}
+		startId = next
+	}
+}
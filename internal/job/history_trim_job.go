@@ -0,0 +1,48 @@
+package job
+
+import (
+	"context"
+	"time"
+	intrSvc "we_book/interactive/service"
+	"we_book/pkg/logger"
+)
+
+// HistoryTrimJob 每个用户只保留最近 keep 条阅读记录
+type HistoryTrimJob struct {
+	svc       intrSvc.ReadHistoryService
+	l         logger.V1
+	timeout   time.Duration
+	keep      int
+	batchSize int
+}
+
+func NewHistoryTrimJob(svc intrSvc.ReadHistoryService, l logger.V1,
+	timeout time.Duration, keep int) *HistoryTrimJob {
+	return &HistoryTrimJob{
+		svc:       svc,
+		l:         l,
+		timeout:   timeout,
+		keep:      keep,
+		batchSize: 100,
+	}
+}
+
+func (h *HistoryTrimJob) Name() string {
+	return "history_trim_job"
+}
+
+func (h *HistoryTrimJob) Run() error {
+	total := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+		n, err := h.svc.Trim(ctx, h.keep, h.batchSize)
+		cancel()
+		total += n
+		// 处理过的用户不会再查出来，不足一批就说明没有了
+		if err != nil || n < h.batchSize {
+			h.l.Info("history trim finished",
+				logger.Int64("users", int64(total)))
+			return err
+		}
+	}
+}
@@ -2,8 +2,10 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
 	"time"
 	"we_book/internal/domain"
 	"we_book/internal/service"
@@ -16,6 +18,12 @@ type Executor interface {
 	Exec(ctx context.Context, job domain.Job) error
 }
 
+// GiveUpHandler 一次性任务重试次数用完之后，Executor 可以在这里收尾，比如把业务状态改回去
+// 收尾成功了任务才会停掉，失败了任务还会接着重试
+type GiveUpHandler interface {
+	GiveUp(ctx context.Context, job domain.Job) error
+}
+
 type Scheduler struct {
 	execs   map[string]Executor
 	svc     service.JobService
@@ -50,12 +58,30 @@ func (s *Scheduler) Scheduler(ctx context.Context) error {
 		cancel()
 
 		if err != nil {
-			s.l.Error("Preempt job error", logger.Error(err))
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				s.l.Error("Preempt job error", logger.Error(err))
+			}
+			// 没有抢到任务，歇一会再来
+			s.limiter.Release(1)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+			continue
 		}
 
 		exec, ok := s.execs[job.Executor]
 		if !ok {
 			s.l.Error("Executor not found", logger.String("executor", job.Executor))
+			s.limiter.Release(1)
+			// 推迟到下一次，避免同一个任务被反复抢占
+			if err1 := s.svc.ResetNextTime(ctx, job); err1 != nil {
+				s.l.Error("reset job next time error", logger.Error(err1), logger.Int64("job_id", job.Id))
+			}
+			if err1 := job.CancelFunc(); err1 != nil {
+				s.l.Error("release job error", logger.Error(err1), logger.Int64("job_id", job.Id))
+			}
 			continue
 		}
 
@@ -68,20 +94,41 @@ func (s *Scheduler) Scheduler(ctx context.Context) error {
 				}
 			}()
 			err1 := exec.Exec(ctx, job)
-			if err1 != nil {
-				s.l.Error("exec job error", logger.Error(err1), logger.Int64("job_id", job.Id))
+			rctx, rcancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer rcancel()
+			if err1 == nil {
+				err1 = s.svc.ResetNextTime(rctx, job)
+				if err1 != nil {
+					s.l.Error("reset job next time error", logger.Error(err1), logger.Int64("job_id", job.Id))
+				}
+				return
+			}
+			s.l.Error("exec job error", logger.Error(err1), logger.Int64("job_id", job.Id))
+			err1 = s.svc.Retry(rctx, job)
+			if errors.Is(err1, service.ErrJobRetryExhausted) {
+				err1 = s.giveUp(rctx, exec, job)
 			}
-
-			ctx, cancel = context.WithTimeout(context.Background(), time.Second)
-			defer cancel()
-			err1 = s.svc.ResetNextTime(ctx, job)
 			if err1 != nil {
-				s.l.Error("reset job next time error", logger.Error(err1), logger.Int64("job_id", job.Id))
+				s.l.Error("retry job error", logger.Error(err1), logger.Int64("job_id", job.Id))
 			}
 		}()
 	}
 }
 
+// giveUp 重试次数用完了，先让 Executor 收尾，再停掉任务
+func (s *Scheduler) giveUp(ctx context.Context, exec Executor, job domain.Job) error {
+	s.l.Error("job retry exhausted",
+		logger.Int64("job_id", job.Id),
+		logger.String("name", job.Name))
+	if h, ok := exec.(GiveUpHandler); ok {
+		err := h.GiveUp(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+	return s.svc.ResetNextTime(ctx, job)
+}
+
 type LocalFuncExecutor struct {
 	funcs map[string]func(ctx context.Context, j domain.Job) error
 }
@@ -0,0 +1,76 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	"we_book/pkg/logger"
+)
+
+// PublishArticleExecutor 执行定时发布，到点之后走一遍正常的发表流程
+type PublishArticleExecutor struct {
+	svc service.ArticleService
+	l   logger.V1
+}
+
+func NewPublishArticleExecutor(svc service.ArticleService, l logger.V1) *PublishArticleExecutor {
+	return &PublishArticleExecutor{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (p *PublishArticleExecutor) Name() string {
+	return service.PublishArticleExecutor
+}
+
+func (p *PublishArticleExecutor) Exec(ctx context.Context, job domain.Job) error {
+	var cfg service.PublishArticleJobCfg
+	err := json.Unmarshal([]byte(job.Cfg), &cfg)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	art, err := p.svc.GetById(ctx, cfg.Aid)
+	if err != nil {
+		return err
+	}
+	if art.Status != domain.ArticleStatusScheduled || art.Author.Id != cfg.Uid {
+		// 作者在这期间直接发布了，或者又改回了草稿
+		p.l.Warn("skip scheduled publish",
+			logger.Int64("aid", cfg.Aid),
+			logger.Int64("job_id", job.Id))
+		return nil
+	}
+	_, err = p.svc.Publish(ctx, art)
+	if errors.Is(err, service.ErrArticlePendingReview) {
+		// 没有通过自动审核，已经进了复审队列，不需要重试
+		p.l.Warn("scheduled publish pending review",
+			logger.Int64("aid", cfg.Aid),
+			logger.Int64("job_id", job.Id))
+		return nil
+	}
+	return err
+}
+
+// GiveUp 重试了几次都发布不了，文章改回未发表，不然会一直停在定时发布的状态
+func (p *PublishArticleExecutor) GiveUp(ctx context.Context, job domain.Job) error {
+	var cfg service.PublishArticleJobCfg
+	err := json.Unmarshal([]byte(job.Cfg), &cfg)
+	if err != nil {
+		// 配置都解析不了，没有什么可以收尾的
+		return nil
+	}
+	p.l.Error("scheduled publish failed",
+		logger.Int64("aid", cfg.Aid),
+		logger.Int64("job_id", job.Id))
+	err = p.svc.AbortSchedule(ctx, cfg.Aid, cfg.Uid)
+	if errors.Is(err, service.ErrArticleNotFound) || errors.Is(err, service.ErrNotArticleAuthor) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+	intrSvc "we_book/interactive/service"
+	"we_book/internal/service"
+	"we_book/pkg/logger"
+)
+
+// PurgeTrashJob 彻底删除超过保留期的回收站文章，连同点赞、收藏这些数据
+// 多个实例同时跑也没关系，已经删掉的文章会被跳过
+type PurgeTrashJob struct {
+	artSvc    service.ArticleService
+	intrSvc   intrSvc.InteractiveService
+	l         logger.V1
+	timeout   time.Duration
+	batchSize int
+}
+
+func NewPurgeTrashJob(artSvc service.ArticleService,
+	intrSvc intrSvc.InteractiveService,
+	l logger.V1,
+	timeout time.Duration) *PurgeTrashJob {
+	return &PurgeTrashJob{
+		artSvc:    artSvc,
+		intrSvc:   intrSvc,
+		l:         l,
+		timeout:   timeout,
+		batchSize: 100,
+	}
+}
+
+func (p *PurgeTrashJob) Name() string {
+	return "purge_trash_job"
+}
+
+func (p *PurgeTrashJob) Run() error {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		n, err := p.purgeBatch(ctx)
+		cancel()
+		// 出错的文章下一批还会查出来，这一轮就到此为止
+		if err != nil || n < p.batchSize {
+			return err
+		}
+	}
+}
+
+func (p *PurgeTrashJob) purgeBatch(ctx context.Context) (int, error) {
+	arts, err := p.artSvc.ListExpiredTrash(ctx, p.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, art := range arts {
+		err = p.artSvc.Purge(ctx, art.Id)
+		if errors.Is(err, service.ErrArticleNotFound) {
+			// 别的实例删掉了，或者作者刚好恢复了
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		err = p.intrSvc.Delete(ctx, "article", art.Id)
+		if err != nil {
+			// 文章已经删掉了，这里失败只会留下一些没人看的计数
+			p.l.Error("delete article interactive error",
+				logger.Int64("aid", art.Id),
+				logger.Error(err))
+		}
+	}
+	return len(arts), nil
+}
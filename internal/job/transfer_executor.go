@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+)
+
+// ArticleTransferExecutor 执行文章的批量导入和导出
+// 任务本身的进度记录在 TransferTask 里面
+type ArticleTransferExecutor struct {
+	svc service.ArticleTransferService
+}
+
+func NewArticleTransferExecutor(svc service.ArticleTransferService) *ArticleTransferExecutor {
+	return &ArticleTransferExecutor{
+		svc: svc,
+	}
+}
+
+func (a *ArticleTransferExecutor) Name() string {
+	return service.ArticleTransferExecutor
+}
+
+func (a *ArticleTransferExecutor) Exec(ctx context.Context, job domain.Job) error {
+	var cfg service.ArticleTransferJobCfg
+	err := json.Unmarshal([]byte(job.Cfg), &cfg)
+	if err != nil {
+		return err
+	}
+	// 导入导出的文章可能比较多，给得宽松一点
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*10)
+	defer cancel()
+	return a.svc.Run(ctx, cfg.TaskId)
+}
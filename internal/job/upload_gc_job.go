@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"time"
+	"we_book/internal/service"
+	"we_book/pkg/logger"
+)
+
+// UploadGCJob 清理没有被任何文章引用的图片
+// 刚上传的图片作者可能还没来得及保存文章，所以只清理上传超过 grace 的
+type UploadGCJob struct {
+	svc       service.UploadService
+	l         logger.V1
+	timeout   time.Duration
+	grace     time.Duration
+	batchSize int
+}
+
+func NewUploadGCJob(svc service.UploadService, l logger.V1,
+	timeout time.Duration, grace time.Duration) *UploadGCJob {
+	return &UploadGCJob{
+		svc:       svc,
+		l:         l,
+		timeout:   timeout,
+		grace:     grace,
+		batchSize: 100,
+	}
+}
+
+func (u *UploadGCJob) Name() string {
+	return "upload_gc_job"
+}
+
+func (u *UploadGCJob) Run() error {
+	before := time.Now().Add(-u.grace)
+	total := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), u.timeout)
+		n, err := u.svc.GC(ctx, before, u.batchSize)
+		cancel()
+		total += n
+		// 被引用的图片不会删掉，下一批还会查出来，不足一批就说明没有了
+		if err != nil || n < u.batchSize {
+			u.l.Info("upload gc finished",
+				logger.Int64("deleted", int64(total)))
+			return err
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package job
+
+import (
+	"context"
+	"time"
+	intrSvc "we_book/interactive/service"
+	"we_book/pkg/logger"
+)
+
+// UvSyncJob 把 Redis 里面 HyperLogLog 估算的独立访客数落库
+// 待落库的资源是 SPOP 取出来的，多个实例同时跑也不会重复处理
+type UvSyncJob struct {
+	svc       intrSvc.InteractiveService
+	l         logger.V1
+	timeout   time.Duration
+	batchSize int
+}
+
+func NewUvSyncJob(svc intrSvc.InteractiveService, l logger.V1, timeout time.Duration) *UvSyncJob {
+	return &UvSyncJob{
+		svc:       svc,
+		l:         l,
+		timeout:   timeout,
+		batchSize: 100,
+	}
+}
+
+func (u *UvSyncJob) Name() string {
+	return "uv_sync_job"
+}
+
+func (u *UvSyncJob) Run() error {
+	total := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), u.timeout)
+		n, err := u.svc.SyncUvCnt(ctx, u.batchSize)
+		cancel()
+		total += n
+		// 失败的会放回去，下一轮再处理
+		if err != nil || n < u.batchSize {
+			u.l.Debug("uv sync finished",
+				logger.Int64("count", int64(total)))
+			return err
+		}
+	}
+}
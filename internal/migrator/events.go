@@ -0,0 +1,58 @@
+package migrator
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/IBM/sarama"
+	"strconv"
+)
+
+// TopicInconsistent 校验发现两个库不一致的文章
+const TopicInconsistent = "article_migrator_inconsistent"
+
+// Direction 修复的时候以哪个库为准
+const (
+	DirectionSrc = "SRC"
+	DirectionDst = "DST"
+)
+
+const (
+	// InconsistentEventTypeTargetMissing 目标库里面没有
+	InconsistentEventTypeTargetMissing = "target_missing"
+	// InconsistentEventTypeBaseMissing 以它为准的库里面已经没有了，目标库要删掉
+	InconsistentEventTypeBaseMissing = "base_missing"
+	// InconsistentEventTypeNEQ 两边都有，但是 utime 不一样
+	InconsistentEventTypeNEQ = "neq"
+)
+
+type InconsistentEvent struct {
+	Id        int64  `json:"id"`
+	Direction string `json:"direction"`
+	Type      string `json:"type"`
+}
+
+type Producer interface {
+	ProduceInconsistentEvent(ctx context.Context, evt InconsistentEvent) error
+}
+
+type SaramaProducer struct {
+	producer sarama.SyncProducer
+}
+
+func NewSaramaProducer(producer sarama.SyncProducer) Producer {
+	return &SaramaProducer{producer: producer}
+}
+
+// ProduceInconsistentEvent 按照文章 ID 分区，同一篇文章的修复不会并发
+func (s *SaramaProducer) ProduceInconsistentEvent(ctx context.Context, evt InconsistentEvent) error {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: TopicInconsistent,
+		Key:   sarama.StringEncoder(strconv.FormatInt(evt.Id, 10)),
+		Value: sarama.ByteEncoder(data),
+	})
+	return err
+}
@@ -0,0 +1,73 @@
+package migrator
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM/sarama"
+	"time"
+	"we_book/internal/repository/dao/article"
+	"we_book/pkg/logger"
+	"we_book/pkg/saramax"
+)
+
+// Fixer 用 base 里面的文章覆盖 target，不管是哪一种不一致都是整篇覆盖
+type Fixer struct {
+	base   article.MigratableDAO
+	target article.MigratableDAO
+}
+
+func NewFixer(base, target article.MigratableDAO) *Fixer {
+	return &Fixer{base: base, target: target}
+}
+
+// Fix 修复的时候重新查 base，所以重复的消息和过时的消息都没有影响
+func (f *Fixer) Fix(ctx context.Context, id int64) error {
+	return article.Copy(ctx, f.base, f.target, id)
+}
+
+// Consumer 消费校验发出来的不一致事件
+type Consumer struct {
+	client   sarama.Client
+	srcFirst *Fixer
+	dstFirst *Fixer
+	l        logger.V1
+}
+
+// NewConsumer 修复和校验、双写用的是同一对库
+func NewConsumer(client sarama.Client, s *Scheduler, l logger.V1) *Consumer {
+	return &Consumer{
+		client:   client,
+		srcFirst: NewFixer(s.src, s.dst),
+		dstFirst: NewFixer(s.dst, s.src),
+		l:        l,
+	}
+}
+
+func (c *Consumer) Start() error {
+	cg, err := sarama.NewConsumerGroupFromClient("migrator_article", c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{TopicInconsistent},
+			saramax.NewHandler[InconsistentEvent](c.l, c.Consume))
+		if err != nil {
+			c.l.Error("循环消费消息失败", logger.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (c *Consumer) Consume(msg *sarama.ConsumerMessage, evt InconsistentEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	switch evt.Direction {
+	case DirectionSrc:
+		return c.srcFirst.Fix(ctx, evt.Id)
+	case DirectionDst:
+		return c.dstFirst.Fix(ctx, evt.Id)
+	default:
+		return fmt.Errorf("unknown direction %s", evt.Direction)
+	}
+}
@@ -0,0 +1,166 @@
+package migrator
+
+import (
+	"context"
+	"sync"
+	"time"
+	"we_book/internal/repository/dao"
+	"we_book/internal/repository/dao/article"
+	"we_book/pkg/logger"
+)
+
+// patternName 双写阶段在数据库里面的名字
+const patternName = "article"
+
+// Scheduler 管理文章的迁移，切换双写的阶段，启动、停止校验
+// 校验以当前读的那个库为准，切换阶段的时候正在跑的校验都会停掉
+// 双写阶段保存在数据库里面，每个实例定时同步，重启之后也不会回到 src_only
+type Scheduler struct {
+	lock     sync.Mutex
+	src      article.MigratableDAO
+	dst      article.MigratableDAO
+	dao      *article.DoubleWriteDAO
+	patterns dao.MigratorPatternDAO
+	producer Producer
+	l        logger.V1
+
+	cancelFull func()
+	cancelIncr func()
+}
+
+func NewScheduler(src, dst article.MigratableDAO, patterns dao.MigratorPatternDAO,
+	producer Producer, l logger.V1) *Scheduler {
+	return &Scheduler{
+		src:        src,
+		dst:        dst,
+		dao:        article.NewDoubleWriteDAO(src, dst, l),
+		patterns:   patterns,
+		producer:   producer,
+		l:          l,
+		cancelFull: func() {},
+		cancelIncr: func() {},
+	}
+}
+
+// DAO 业务上使用的双写 DAO
+func (s *Scheduler) DAO() *article.DoubleWriteDAO {
+	return s.dao
+}
+
+func (s *Scheduler) Pattern() string {
+	return s.dao.Pattern()
+}
+
+// UpdatePattern 先保存到数据库，其它实例在下一次同步的时候切换
+func (s *Scheduler) UpdatePattern(ctx context.Context, pattern string) error {
+	err := article.CheckPattern(pattern)
+	if err != nil {
+		return err
+	}
+	err = s.patterns.Set(ctx, patternName, pattern)
+	if err != nil {
+		return err
+	}
+	s.apply(pattern)
+	return nil
+}
+
+// Reload 从数据库里面读取双写阶段，没有设置过的话保持 src_only
+func (s *Scheduler) Reload(ctx context.Context) error {
+	pattern, err := s.patterns.Get(ctx, patternName)
+	if err != nil || pattern == "" {
+		return err
+	}
+	if article.CheckPattern(pattern) != nil {
+		// 不认识的阶段不切换，免得读写到错误的库上
+		s.l.Error("unknown double write pattern in db",
+			logger.String("pattern", pattern))
+		return article.ErrUnknownPattern
+	}
+	s.apply(pattern)
+	return nil
+}
+
+// Watch 每隔 interval 同步一次双写阶段，直到 ctx 被取消
+func (s *Scheduler) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		rctx, cancel := context.WithTimeout(ctx, time.Second)
+		err := s.Reload(rctx)
+		cancel()
+		if err != nil {
+			s.l.Error("reload double write pattern error", logger.Error(err))
+		}
+	}
+}
+
+// apply 阶段变了才切换，正在跑的校验以原来的库为准，要停掉
+func (s *Scheduler) apply(pattern string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.dao.Pattern() == pattern {
+		return
+	}
+	_ = s.dao.UpdatePattern(pattern)
+	s.cancelFull()
+	s.cancelIncr()
+	s.l.Info("double write pattern changed", logger.String("pattern", pattern))
+}
+
+// StartFull 全量校验一遍，已经在跑的会先停掉
+func (s *Scheduler) StartFull() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelFull()
+	s.cancelFull = s.start("full", s.newValidator())
+}
+
+func (s *Scheduler) StopFull() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelFull()
+}
+
+// StartIncr 从 utime 开始增量校验，每隔 interval 看一下有没有新修改的文章
+func (s *Scheduler) StartIncr(utime int64, interval time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelIncr()
+	s.cancelIncr = s.start("incr", s.newValidator().Incr(utime).SleepInterval(interval))
+}
+
+func (s *Scheduler) StopIncr() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelIncr()
+}
+
+func (s *Scheduler) start(name string, v *Validator) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		err := v.Validate(ctx)
+		if err != nil {
+			s.l.Error("validate articles error",
+				logger.String("validator", name),
+				logger.Error(err))
+			return
+		}
+		s.l.Info("validate articles finished", logger.String("validator", name))
+	}()
+	return cancel
+}
+
+func (s *Scheduler) newValidator() *Validator {
+	switch s.dao.Pattern() {
+	case article.PatternDstFirst, article.PatternDstOnly:
+		return NewValidator(s.dst, s.src, DirectionDst, s.producer, s.l)
+	default:
+		return NewValidator(s.src, s.dst, DirectionSrc, s.producer, s.l)
+	}
+}
@@ -0,0 +1,156 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"we_book/internal/repository/dao/article"
+	daomocks "we_book/internal/repository/dao/article/mocks"
+	patternmocks "we_book/internal/repository/dao/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestScheduler_DoubleWrite(t *testing.T) {
+	art := article.Article{Id: 1, Title: "标题", AuthorId: 123}
+	snapshot := article.Snapshot{Id: 1, Article: &art}
+	testCases := []struct {
+		name    string
+		pattern string
+		mock    func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO)
+
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name:    "只写源库",
+			pattern: article.PatternSrcOnly,
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				src := daomocks.NewMockMigratableDAO(ctrl)
+				dst := daomocks.NewMockMigratableDAO(ctrl)
+				src.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				return src, dst
+			},
+			wantId: 1,
+		},
+		{
+			name:    "先写源库，再把整篇文章复制到目标库",
+			pattern: article.PatternSrcFirst,
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				src := daomocks.NewMockMigratableDAO(ctrl)
+				dst := daomocks.NewMockMigratableDAO(ctrl)
+				src.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				src.EXPECT().Snapshot(gomock.Any(), int64(1)).Return(snapshot, nil)
+				dst.EXPECT().Overwrite(gomock.Any(), snapshot).Return(nil)
+				return src, dst
+			},
+			wantId: 1,
+		},
+		{
+			name:    "目标库失败不影响结果",
+			pattern: article.PatternDstFirst,
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				src := daomocks.NewMockMigratableDAO(ctrl)
+				dst := daomocks.NewMockMigratableDAO(ctrl)
+				dst.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				dst.EXPECT().Snapshot(gomock.Any(), int64(1)).Return(snapshot, nil)
+				src.EXPECT().Overwrite(gomock.Any(), snapshot).Return(errors.New("mock db error"))
+				return src, dst
+			},
+			wantId: 1,
+		},
+		{
+			name:    "主库失败不写另一个库",
+			pattern: article.PatternSrcFirst,
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				src := daomocks.NewMockMigratableDAO(ctrl)
+				dst := daomocks.NewMockMigratableDAO(ctrl)
+				src.EXPECT().Sync(gomock.Any(), art).Return(int64(0), errors.New("mock db error"))
+				return src, dst
+			},
+			wantErr: errors.New("mock db error"),
+		},
+		{
+			name:    "只写目标库",
+			pattern: article.PatternDstOnly,
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				src := daomocks.NewMockMigratableDAO(ctrl)
+				dst := daomocks.NewMockMigratableDAO(ctrl)
+				dst.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				return src, dst
+			},
+			wantId: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			src, dst := tc.mock(ctrl)
+			patterns := patternmocks.NewMockMigratorPatternDAO(ctrl)
+			patterns.EXPECT().Set(gomock.Any(), "article", tc.pattern).Return(nil)
+			s := NewScheduler(src, dst, patterns, nil, logger.NewNoLogger())
+			err := s.UpdatePattern(context.Background(), tc.pattern)
+			assert.NoError(t, err)
+			id, err := s.DAO().Sync(context.Background(), art)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func TestScheduler_UpdatePattern(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	src := daomocks.NewMockMigratableDAO(ctrl)
+	dst := daomocks.NewMockMigratableDAO(ctrl)
+	patterns := patternmocks.NewMockMigratorPatternDAO(ctrl)
+	s := NewScheduler(src, dst, patterns, nil, logger.NewNoLogger())
+	ctx := context.Background()
+	assert.Equal(t, article.PatternSrcOnly, s.Pattern())
+	assert.Equal(t, article.ErrUnknownPattern, s.UpdatePattern(ctx, "abc"))
+	assert.Equal(t, article.PatternSrcOnly, s.Pattern())
+
+	// 保存失败的话不切换
+	patterns.EXPECT().Set(gomock.Any(), "article", article.PatternSrcFirst).
+		Return(errors.New("mock db error"))
+	assert.Equal(t, errors.New("mock db error"), s.UpdatePattern(ctx, article.PatternSrcFirst))
+	assert.Equal(t, article.PatternSrcOnly, s.Pattern())
+
+	// 切到读目标库之后，读请求都走目标库
+	patterns.EXPECT().Set(gomock.Any(), "article", article.PatternDstFirst).Return(nil)
+	assert.NoError(t, s.UpdatePattern(ctx, article.PatternDstFirst))
+	dst.EXPECT().GetById(gomock.Any(), int64(1)).Return(article.Article{Id: 1}, nil)
+	res, err := s.DAO().GetById(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.Id)
+}
+
+func TestScheduler_Reload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	patterns := patternmocks.NewMockMigratorPatternDAO(ctrl)
+	s := NewScheduler(daomocks.NewMockMigratableDAO(ctrl), daomocks.NewMockMigratableDAO(ctrl),
+		patterns, nil, logger.NewNoLogger())
+	ctx := context.Background()
+
+	// 从来没有切换过
+	patterns.EXPECT().Get(gomock.Any(), "article").Return("", nil)
+	assert.NoError(t, s.Reload(ctx))
+	assert.Equal(t, article.PatternSrcOnly, s.Pattern())
+
+	// 别的实例切换了，或者重启之前已经切换过了
+	patterns.EXPECT().Get(gomock.Any(), "article").Return(article.PatternDstOnly, nil)
+	assert.NoError(t, s.Reload(ctx))
+	assert.Equal(t, article.PatternDstOnly, s.Pattern())
+
+	patterns.EXPECT().Get(gomock.Any(), "article").Return("abc", nil)
+	assert.Equal(t, article.ErrUnknownPattern, s.Reload(ctx))
+	assert.Equal(t, article.PatternDstOnly, s.Pattern())
+
+	patterns.EXPECT().Get(gomock.Any(), "article").Return("", errors.New("mock db error"))
+	assert.Equal(t, errors.New("mock db error"), s.Reload(ctx))
+	assert.Equal(t, article.PatternDstOnly, s.Pattern())
+}
@@ -0,0 +1,153 @@
+package migrator
+
+import (
+	"context"
+	"golang.org/x/sync/errgroup"
+	"time"
+	"we_book/internal/repository/dao/article"
+	"we_book/pkg/logger"
+)
+
+// Validator 以 base 为准比对两个库的文章，只比较 id 和 utime
+// 不一致的文章发到 Kafka，由 Fixer 用 base 覆盖 target
+// 双写之前的存量数据也是靠全量校验复制过去的
+type Validator struct {
+	base   article.MigratableDAO
+	target article.MigratableDAO
+	// direction 修复的时候以哪个库为准
+	direction string
+	producer  Producer
+	l         logger.V1
+
+	batchSize int
+	// utime 增量校验的起点，为 0 是全量校验
+	utime int64
+	// sleepInterval 小于等于 0 的话校验完一遍就退出，否则一直等新数据
+	sleepInterval time.Duration
+}
+
+func NewValidator(base, target article.MigratableDAO, direction string,
+	producer Producer, l logger.V1) *Validator {
+	return &Validator{
+		base:      base,
+		target:    target,
+		direction: direction,
+		producer:  producer,
+		l:         l,
+		batchSize: 100,
+	}
+}
+
+// Incr 校验 utime 之后修改过的文章
+func (v *Validator) Incr(utime int64) *Validator {
+	v.utime = utime
+	return v
+}
+
+func (v *Validator) SleepInterval(interval time.Duration) *Validator {
+	v.sleepInterval = interval
+	return v
+}
+
+// Validate 两个方向同时校验，ctx 取消或者出错就退出
+func (v *Validator) Validate(ctx context.Context) error {
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return v.validate(ctx, v.base, v.target, v.baseToTarget)
+	})
+	eg.Go(func() error {
+		return v.validate(ctx, v.target, v.base, v.targetToBase)
+	})
+	return eg.Wait()
+}
+
+// validate 一批一批地扫描 from，再去 to 里面批量查询比对
+func (v *Validator) validate(ctx context.Context, from, to article.MigratableDAO,
+	compare func(ctx context.Context, src []article.Article, dst map[int64]article.Article)) error {
+	var lastId, lastUtime = int64(0), v.utime
+	for {
+		src, err := v.scan(ctx, from, lastId, lastUtime)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(src) == 0 {
+			if v.sleepInterval <= 0 {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(v.sleepInterval):
+			}
+			continue
+		}
+		ids := make([]int64, 0, len(src))
+		for _, art := range src {
+			ids = append(ids, art.Id)
+		}
+		dctx, cancel := context.WithTimeout(ctx, time.Second)
+		dst, err := to.FindByIds(dctx, ids)
+		cancel()
+		if err != nil {
+			return err
+		}
+		dstMap := make(map[int64]article.Article, len(dst))
+		for _, art := range dst {
+			dstMap[art.Id] = art
+		}
+		compare(ctx, src, dstMap)
+		last := src[len(src)-1]
+		lastId, lastUtime = last.Id, last.Utime
+	}
+}
+
+// scan 全量校验按照 id 扫描，增量校验按照 utime 扫描
+func (v *Validator) scan(ctx context.Context, dao article.MigratableDAO, id, utime int64) ([]article.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if v.utime == 0 {
+		return dao.ScanById(ctx, id, v.batchSize)
+	}
+	return dao.ScanByUtime(ctx, utime, id, v.batchSize)
+}
+
+func (v *Validator) baseToTarget(ctx context.Context, src []article.Article, dst map[int64]article.Article) {
+	for _, art := range src {
+		t, ok := dst[art.Id]
+		switch {
+		case !ok:
+			v.notify(ctx, art.Id, InconsistentEventTypeTargetMissing)
+		case t.Utime != art.Utime:
+			v.notify(ctx, art.Id, InconsistentEventTypeNEQ)
+		}
+	}
+}
+
+// targetToBase 只需要找出 target 多出来的，两边都有的在另一个方向比较过了
+func (v *Validator) targetToBase(ctx context.Context, src []article.Article, dst map[int64]article.Article) {
+	for _, art := range src {
+		if _, ok := dst[art.Id]; !ok {
+			v.notify(ctx, art.Id, InconsistentEventTypeBaseMissing)
+		}
+	}
+}
+
+// notify 发送失败只记日志，下一次校验还会发现
+func (v *Validator) notify(ctx context.Context, id int64, typ string) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	err := v.producer.ProduceInconsistentEvent(ctx, InconsistentEvent{
+		Id:        id,
+		Direction: v.direction,
+		Type:      typ,
+	})
+	if err != nil {
+		v.l.Error("send inconsistent event error",
+			logger.Int64("id", id),
+			logger.String("type", typ),
+			logger.Error(err))
+	}
+}
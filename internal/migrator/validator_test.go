@@ -0,0 +1,122 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"we_book/internal/repository/dao/article"
+	daomocks "we_book/internal/repository/dao/article/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO)
+		incr int64
+		// sendErr 发送不一致事件的错误
+		sendErr error
+
+		wantEvts []InconsistentEvent
+		wantErr  error
+	}{
+		{
+			name: "全量校验，两边不一致",
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				base := daomocks.NewMockMigratableDAO(ctrl)
+				target := daomocks.NewMockMigratableDAO(ctrl)
+				base.EXPECT().ScanById(gomock.Any(), int64(0), 100).Return([]article.Article{
+					{Id: 1, Utime: 100},
+					{Id: 2, Utime: 200},
+					{Id: 3, Utime: 300},
+				}, nil)
+				target.EXPECT().FindByIds(gomock.Any(), []int64{1, 2, 3}).Return([]article.Article{
+					{Id: 1, Utime: 100},
+					{Id: 3, Utime: 301},
+				}, nil)
+				base.EXPECT().ScanById(gomock.Any(), int64(3), 100).Return(nil, nil)
+
+				target.EXPECT().ScanById(gomock.Any(), int64(0), 100).Return([]article.Article{
+					{Id: 1, Utime: 100},
+					{Id: 3, Utime: 301},
+					{Id: 4, Utime: 400},
+				}, nil)
+				base.EXPECT().FindByIds(gomock.Any(), []int64{1, 3, 4}).Return([]article.Article{
+					{Id: 1, Utime: 100},
+					{Id: 3, Utime: 300},
+				}, nil)
+				target.EXPECT().ScanById(gomock.Any(), int64(4), 100).Return(nil, nil)
+				return base, target
+			},
+			wantEvts: []InconsistentEvent{
+				{Id: 2, Direction: DirectionSrc, Type: InconsistentEventTypeTargetMissing},
+				{Id: 3, Direction: DirectionSrc, Type: InconsistentEventTypeNEQ},
+				{Id: 4, Direction: DirectionSrc, Type: InconsistentEventTypeBaseMissing},
+			},
+		},
+		{
+			name: "增量校验按照 utime 扫描，发送失败不影响",
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				base := daomocks.NewMockMigratableDAO(ctrl)
+				target := daomocks.NewMockMigratableDAO(ctrl)
+				base.EXPECT().ScanByUtime(gomock.Any(), int64(1000), int64(0), 100).Return([]article.Article{
+					{Id: 5, Utime: 1001},
+				}, nil)
+				target.EXPECT().FindByIds(gomock.Any(), []int64{5}).Return(nil, nil)
+				base.EXPECT().ScanByUtime(gomock.Any(), int64(1001), int64(5), 100).Return(nil, nil)
+
+				target.EXPECT().ScanByUtime(gomock.Any(), int64(1000), int64(0), 100).Return(nil, nil)
+				return base, target
+			},
+			incr:    1000,
+			sendErr: errors.New("mock kafka error"),
+			wantEvts: []InconsistentEvent{
+				{Id: 5, Direction: DirectionSrc, Type: InconsistentEventTypeTargetMissing},
+			},
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (article.MigratableDAO, article.MigratableDAO) {
+				base := daomocks.NewMockMigratableDAO(ctrl)
+				target := daomocks.NewMockMigratableDAO(ctrl)
+				base.EXPECT().ScanById(gomock.Any(), int64(0), 100).Return(nil, errors.New("mock db error"))
+				target.EXPECT().ScanById(gomock.Any(), int64(0), 100).Return(nil, nil)
+				return base, target
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			base, target := tc.mock(ctrl)
+			producer := &recordProducer{err: tc.sendErr}
+			v := NewValidator(base, target, DirectionSrc, producer, logger.NewNoLogger())
+			if tc.incr > 0 {
+				v = v.Incr(tc.incr)
+			}
+			err := v.Validate(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			// 两个方向是并发校验的，不保证顺序
+			assert.ElementsMatch(t, tc.wantEvts, producer.evts)
+		})
+	}
+}
+
+type recordProducer struct {
+	lock sync.Mutex
+	evts []InconsistentEvent
+	err  error
+}
+
+func (r *recordProducer) ProduceInconsistentEvent(ctx context.Context, evt InconsistentEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.evts = append(r.evts, evt)
+	return r.err
+}
@@ -2,9 +2,13 @@ package article
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
+	"strconv"
 	"time"
 	"we_book/internal/domain"
 	"we_book/internal/repository"
@@ -14,17 +18,39 @@ import (
 	"we_book/pkg/logger"
 )
 
+var ErrArticleNotFound = errors.New("article not found")
+
+// ErrRevisionNotFound 版本不存在
+var ErrRevisionNotFound = errors.New("revision not found")
+
+var pubCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "we_book_ypb",
+	Subsystem: "we_book",
+	Name:      "article_pub_cache",
+	Help:      "读者端文章缓存的命中情况",
+}, []string{"result"})
+
+func init() {
+	prometheus.MustRegister(pubCacheCounter)
+}
+
 type CacheArticleRepository struct {
 	dao article.ArticleDAO
 
 	userRepo repository.UserRepository
-	cache    cache.ArticleCache
+	// uploadRepo 为 nil 的时候不记录文章引用的图片
+	uploadRepo repository.UploadRepository
+	// collabRepo 为 nil 的时候只署所有者的名字
+	collabRepo repository.CollaboratorRepository
+	cache      cache.ArticleCache
 
 	authorDAO article.AuthorDao
 	readerDAO article.ArticleDAO
 
 	db *gorm.DB
 	l  logger.V1
+	// sf 合并同一篇文章并发的回源请求
+	sf singleflight.Group
 }
 
 func (c *CacheArticleRepository) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error) {
@@ -43,47 +69,203 @@ type ArticleRepository interface {
 	Sync(ctx context.Context, article domain.Article) (int64, error)
 	SyncStatus(ctx context.Context, id int64, author int64, status domain.ArticleStatus) error
 	List(ctx context.Context, uid int64, set int, limit int) ([]domain.Article, error)
+	// ListByCursor 按照 utime、id 倒序，cursor 是零值的时候返回第一页
+	ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	// ListByLength 按照字数排序，asc 为 true 的时候短的在前面
+	ListByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 	GetPubById(ctx context.Context, id int64) (domain.Article, error)
 	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error)
+	ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]domain.ArticleRevision, error)
+	GetRevisionById(ctx context.Context, id int64) (domain.ArticleRevision, error)
+	// UpdateStatus 只修改制作库中的状态
+	UpdateStatus(ctx context.Context, id int64, author int64, status domain.ArticleStatus) error
+	ListByStatus(ctx context.Context, uid int64, status domain.ArticleStatus, offset int, limit int) ([]domain.Article, error)
+	ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error)
+	ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error)
+	PopularTags(ctx context.Context, limit int) ([]domain.Tag, error)
+	// Trash 移到回收站，同时从线上库删掉
+	Trash(ctx context.Context, id int64, author int64) error
+	Restore(ctx context.Context, id int64, author int64) error
+	// ListTrashedBefore 按照 dtime 正序返回移到回收站早于 t 的文章
+	ListTrashedBefore(ctx context.Context, t time.Time, limit int) ([]domain.Article, error)
+	// Purge 彻底删除回收站里的文章，不在回收站里返回 ErrArticleNotFound
+	Purge(ctx context.Context, id int64) error
+	// GetRendered 查询某个版本渲染好的内容，只有缓存
+	GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error)
+	SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error
+	ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// GetFeed 订阅源只有缓存，发表、撤回的时候会删掉
+	GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error)
+	SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error
+	// FeedModified 订阅源最后一次失效的时间，没有失效过返回零值
+	FeedModified(ctx context.Context, uid int64) (time.Time, error)
+	// BackfillStats 按照 id 升序处理 id 大于 startId 的一批文章，补上字数统计和摘要
+	// 返回这一批最大的 id 和文章数
+	BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error)
+	// DelBylines 协作者变了，删掉和文章一起缓存的署名，下一次读的时候重新查
+	DelBylines(ctx context.Context, id int64)
 }
 
-func NewArticleRepository(dao article.ArticleDAO) ArticleRepository {
-	return &CacheArticleRepository{dao: dao}
+func NewArticleRepository(dao article.ArticleDAO, cache cache.ArticleCache,
+	userRepo repository.UserRepository, uploadRepo repository.UploadRepository,
+	collabRepo repository.CollaboratorRepository, l logger.V1) ArticleRepository {
+	return &CacheArticleRepository{
+		dao:        dao,
+		cache:      cache,
+		userRepo:   userRepo,
+		uploadRepo: uploadRepo,
+		collabRepo: collabRepo,
+		l:          l,
+	}
 }
 
-func (c CacheArticleRepository) toEntity(art domain.Article) article.Article {
-	return article.Article{
+// toEntity 保存的路径都会经过这里，字数统计和摘要也在这里算
+func (c *CacheArticleRepository) toEntity(art domain.Article) article.Article {
+	return withStats(article.Article{
 		Id:       art.Id,
 		Title:    art.Title,
 		Content:  art.Content,
 		AuthorId: art.Author.Id,
+		EditorId: art.Editor.Id,
+		Status:   uint8(art.Status),
+		Category: art.Category,
+		Tags:     art.Tags,
+	})
+}
+
+// withStats 按照内容算字数统计和摘要
+func withStats(art article.Article) article.Article {
+	stats := domain.NewArticleStats(art.Content)
+	art.WordCnt = stats.Words
+	art.CharCnt = stats.Chars
+	art.ImageCnt = stats.Images
+	art.ReadingTime = int64(stats.ReadingTime / time.Second)
+	art.Abstract = domain.NewAbstract(art.Content)
+	return art
+}
+
+// missingStats 统计上线之前保存的文章，字数统计和摘要都是零值
+func missingStats(art article.Article) bool {
+	return art.Content != "" && (art.CharCnt == 0 || art.Abstract == "")
+}
+
+// BackfillStats 制作库和线上库各自按照自己的内容算，已经有统计的文章跳过
+func (c *CacheArticleRepository) BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error) {
+	arts, err := c.dao.ScanById(ctx, startId, limit)
+	if err != nil || len(arts) == 0 {
+		return startId, 0, err
 	}
+	for _, art := range arts {
+		if missingStats(art) {
+			err = c.dao.UpdateStats(ctx, withStats(art))
+			if err != nil {
+				return startId, 0, err
+			}
+		}
+		pub, err := c.dao.GetPubById(ctx, art.Id)
+		if errors.Is(err, dao.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return startId, 0, err
+		}
+		if missingStats(dao.Article(pub)) {
+			err = c.dao.UpdatePubStats(ctx, dao.PublishedArticle(withStats(dao.Article(pub))))
+			if err != nil {
+				return startId, 0, err
+			}
+			c.delPub(ctx, art.Id)
+		}
+	}
+	return arts[len(arts)-1].Id, len(arts), nil
 }
 
+// GetPubById 读者端先查缓存，缓存里面放的是带上作者名字的文章
+// 同一篇文章同时只有一个请求回源，不存在的文章缓存一个空值
 func (c *CacheArticleRepository) GetPubById(ctx context.Context, id int64) (domain.Article, error) {
+	res, err := c.cache.GetPub(ctx, id)
+	switch {
+	case err == nil:
+		pubCacheCounter.WithLabelValues("hit").Inc()
+		return res, nil
+	case errors.Is(err, cache.ErrPubNotExist):
+		pubCacheCounter.WithLabelValues("hit_not_exist").Inc()
+		return domain.Article{}, ErrArticleNotFound
+	}
+	pubCacheCounter.WithLabelValues("miss").Inc()
+	ch := c.sf.DoChan(strconv.FormatInt(id, 10), func() (interface{}, error) {
+		// 回源的结果所有等待的请求共用，不能因为第一个请求取消了就一起失败
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadPubTimeout)
+		defer cancel()
+		return c.loadPub(ctx, id)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return domain.Article{}, res.Err
+		}
+		return res.Val.(domain.Article), nil
+	case <-ctx.Done():
+		return domain.Article{}, ctx.Err()
+	}
+}
+
+// loadPubTimeout 回源最多等这么久
+const loadPubTimeout = time.Second * 3
+
+// loadPub 回源并且回写缓存，撤回的文章当作不存在
+func (c *CacheArticleRepository) loadPub(ctx context.Context, id int64) (domain.Article, error) {
 	art, err := c.dao.GetPubById(ctx, id)
+	if err == nil && art.Status != uint8(domain.ArticleStatusPublished) {
+		err = dao.ErrRecordNotFound
+	}
+	if errors.Is(err, dao.ErrRecordNotFound) {
+		er := c.cache.SetPubNotExist(ctx, id)
+		if er != nil {
+			c.l.Error("set pub not exist cache err",
+				logger.Int64("aid", id),
+				logger.Error(er))
+		}
+		return domain.Article{}, ErrArticleNotFound
+	}
 	if err != nil {
 		return domain.Article{}, err
 	}
+	res := c.toDomain(dao.Article(art))
 	usr, err := c.userRepo.FindById(ctx, art.AuthorId)
-	res := domain.Article{
-		Id:      art.Id,
-		Title:   art.Title,
-		Content: art.Content,
-		Status:  domain.ArticleStatus(art.Status),
-		Author: domain.Author{
-			Id:   usr.Id,
-			Name: usr.NickName,
-		},
-		Ctime: time.UnixMilli(art.Ctime),
-		Utime: time.UnixMilli(art.Utime),
+	if err != nil {
+		// 拿不到作者名字也要能看文章，这种不回写缓存
+		c.l.Error("find author err",
+			logger.Int64("aid", id),
+			logger.Int64("author", art.AuthorId),
+			logger.Error(err))
+		return res, nil
+	}
+	res.Author.Name = usr.NickName
+	res.Bylines, err = c.bylines(ctx, res)
+	if err != nil {
+		// 署名不全的文章也不回写缓存
+		c.l.Error("find article bylines err",
+			logger.Int64("aid", id),
+			logger.Error(err))
+		return res, nil
+	}
+	er := c.cache.SetPub(ctx, res)
+	if er != nil {
+		c.l.Error("set pub cache err",
+			logger.Int64("aid", id),
+			logger.Error(er))
 	}
 	return res, nil
 }
 
 func (c *CacheArticleRepository) GetById(ctx context.Context, id int64) (domain.Article, error) {
 	data, err := c.dao.GetById(ctx, id)
+	if errors.Is(err, dao.ErrRecordNotFound) {
+		return domain.Article{}, ErrArticleNotFound
+	}
 	if err != nil {
 		return domain.Article{}, err
 	}
@@ -91,17 +273,69 @@ func (c *CacheArticleRepository) GetById(ctx context.Context, id int64) (domain.
 }
 
 func (c *CacheArticleRepository) SyncStatus(ctx context.Context, id int64, author int64, status domain.ArticleStatus) error {
-	return c.dao.SyncStatus(ctx, id, author, uint8(status))
+	err := c.dao.SyncStatus(ctx, id, author, uint8(status))
+	if err != nil {
+		return err
+	}
+	c.delPub(ctx, id)
+	c.delFeeds(ctx, author)
+	return nil
+}
+
+func (c *CacheArticleRepository) bylines(ctx context.Context, art domain.Article) ([]domain.Author, error) {
+	if c.collabRepo == nil {
+		return []domain.Author{art.Author}, nil
+	}
+	collabs, err := c.collabRepo.ListByArticle(ctx, art.Id)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewBylines(art.Author, collabs), nil
+}
+
+func (c *CacheArticleRepository) DelBylines(ctx context.Context, id int64) {
+	c.delPub(ctx, id)
+}
+
+// delPub 线上库变了就删掉读者端的缓存，下一次读的时候回源
+func (c *CacheArticleRepository) delPub(ctx context.Context, id int64) {
+	err := c.cache.DelPub(ctx, id)
+	if err != nil {
+		c.l.Error("del pub cache err",
+			logger.Int64("aid", id),
+			logger.Error(err))
+	}
+}
+
+// delFeeds 线上库变了，作者的和全站的订阅源都要重新生成
+func (c *CacheArticleRepository) delFeeds(ctx context.Context, author int64) {
+	err := c.cache.DelFeeds(ctx, author)
+	if err != nil {
+		c.l.Error("del feeds cache err",
+			logger.Int64("author", author),
+			logger.Error(err))
+	}
 }
 
 // Sync 数据在同一个表中
 func (c *CacheArticleRepository) Sync(ctx context.Context, art domain.Article) (int64, error) {
 	id, err := c.dao.Sync(ctx, c.toEntity(art))
 	if err == nil {
-		c.cache.DelFirstPage(ctx, art.Author.Id)
-		er := c.cache.Set(ctx, art)
+		// 缓存照样要删，文章已经发表出去了
+		err = c.trackUploads(ctx, id, art.Content, domain.UploadRefDraft, domain.UploadRefPublished)
+		c.delPub(ctx, id)
+		c.delFeeds(ctx, art.Author.Id)
+		er := c.cache.DelFirstPage(ctx, art.Author.Id)
+		if er != nil {
+			c.l.Error("del first page err",
+				logger.Int64("author", art.Author.Id),
+				logger.Error(er))
+		}
+		er = c.cache.Set(ctx, art)
 		if er != nil {
-			fmt.Errorf("set cache err: %v", er)
+			c.l.Error("set cache err",
+				logger.Int64("aid", art.Id),
+				logger.Error(er))
 		}
 	}
 	return id, err
@@ -158,59 +392,146 @@ func (c *CacheArticleRepository) Create(ctx context.Context, art domain.Article)
 	defer func() {
 		err := c.cache.DelFirstPage(ctx, art.Author.Id)
 		if err != nil {
-			fmt.Errorf("del first page err: %v", err)
+			c.l.Error("del first page err",
+				logger.Int64("author", art.Author.Id),
+				logger.Error(err))
 		}
 	}()
-	return c.dao.Insert(ctx, article.Article{
-		Id:       art.Id,
-		Title:    art.Title,
-		Content:  art.Content,
-		AuthorId: art.Author.Id,
-		Status:   uint8(art.Status),
-	})
+	id, err := c.dao.Insert(ctx, c.toEntity(art))
+	if err == nil {
+		err = c.trackUploads(ctx, id, art.Content, domain.UploadRefDraft)
+	}
+	return id, err
 }
 
 func (c *CacheArticleRepository) Update(ctx context.Context, art domain.Article) error {
 	defer func() {
 		err := c.cache.DelFirstPage(ctx, art.Author.Id)
 		if err != nil {
-			fmt.Errorf("del first page err: %v", err)
+			c.l.Error("del first page err",
+				logger.Int64("author", art.Author.Id),
+				logger.Error(err))
 		}
 	}()
-	return c.dao.UpdateById(ctx, article.Article{
-		Id:       art.Id,
-		Title:    art.Title,
-		Content:  art.Content,
-		AuthorId: art.Author.Id,
-		Status:   uint8(art.Status),
-	})
+	err := c.dao.UpdateById(ctx, c.toEntity(art))
+	if err == nil {
+		err = c.trackUploads(ctx, art.Id, art.Content, domain.UploadRefDraft)
+	}
+	return err
+}
+
+// trackUploads 记录文章引用了哪些图片，清理任务不会删除被引用的图片
+// 引用没记上的话图片过了保护期就会被清理掉，所以失败了整个保存都算失败，让作者重试
+func (c *CacheArticleRepository) trackUploads(ctx context.Context, aid int64, content string,
+	types ...domain.UploadRefType) error {
+	if c.uploadRepo == nil {
+		return nil
+	}
+	for _, typ := range types {
+		err := c.uploadRepo.SetRefs(ctx, aid, typ, content)
+		if err != nil {
+			return fmt.Errorf("set upload refs of article %d: %w", aid, err)
+		}
+	}
+	return nil
 }
 
+// firstPageSize 第一页缓存这么多条，limit 不超过它的第一页请求都走缓存
+const firstPageSize = 100
+
 func (c *CacheArticleRepository) List(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
 	// 优先返回第一页数据
-	if offset == 0 && limit <= 100 {
-		data, err := c.cache.GetFirstPage(ctx, uid)
-		if err == nil {
-			go func() {
-				c.preCache(ctx, data)
-			}()
-			return data, nil
-		}
+	if offset == 0 && limit <= firstPageSize {
+		return c.firstPage(ctx, uid, limit)
 	}
 	res, err := c.dao.GetByAuthor(ctx, uid, offset, limit)
 	if err != nil {
 		return nil, err
 	}
-	data := slice.Map[dao.Article, domain.Article](res, func(idx int, item dao.Article) domain.Article {
+	return slice.Map[dao.Article, domain.Article](res, func(idx int, item dao.Article) domain.Article {
+		return c.toDomain(item)
+	}), nil
+}
+
+// ListByLength 不常用，不走缓存
+func (c *CacheArticleRepository) ListByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]domain.Article, error) {
+	res, err := c.dao.GetByAuthorOrderByLength(ctx, uid, asc, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Article) domain.Article {
+		return c.toDomain(src)
+	}), nil
+}
+
+func (c *CacheArticleRepository) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	// 偏移量分页按照创建时间排序，第一页的缓存不能给游标分页用
+	utime, id := cursorArgs(cursor)
+	res, err := c.dao.GetByAuthorCursor(ctx, uid, utime, id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Article) domain.Article {
+		return c.toDomain(src)
+	}), nil
+}
+
+// firstPage 缓存里面有 firstPageSize 条，或者作者一共都没有这么多篇
+func (c *CacheArticleRepository) firstPage(ctx context.Context, uid int64, limit int) ([]domain.Article, error) {
+	data, err := c.cache.GetFirstPage(ctx, uid)
+	if err == nil && (len(data) >= limit || len(data) < firstPageSize) {
+		go func() {
+			c.preCache(ctx, data)
+		}()
+		return headOf(data, limit), nil
+	}
+	res, err := c.dao.GetByAuthor(ctx, uid, 0, firstPageSize)
+	if err != nil {
+		return nil, err
+	}
+	data = slice.Map[dao.Article, domain.Article](res, func(idx int, item dao.Article) domain.Article {
 		return c.toDomain(item)
 	})
+	// SetFirstPage 会把内容换成摘要，所以缓存一份拷贝
+	cached := make([]domain.Article, len(data))
+	copy(cached, data)
 	// 注意回写入缓存
 	go func() {
-		err = c.cache.SetFirstPage(ctx, uid, data)
-		fmt.Errorf("set first page err: %v", err)
 		c.preCache(ctx, data)
+		er := c.cache.SetFirstPage(ctx, uid, cached)
+		if er != nil {
+			c.l.Error("set first page err",
+				logger.Int64("author", uid),
+				logger.Error(er))
+		}
 	}()
-	return data, nil
+	return headOf(data, limit), nil
+}
+
+// cursorArgs 零值的 time.Time 换成毫秒不是 0，要单独处理
+func cursorArgs(cursor domain.ArticleCursor) (int64, int64) {
+	if cursor.IsZero() {
+		return 0, 0
+	}
+	return cursor.Utime.UnixMilli(), cursor.Id
+}
+
+func headOf(arts []domain.Article, limit int) []domain.Article {
+	if len(arts) > limit {
+		return arts[:limit]
+	}
+	return arts
+}
+
+func (c *CacheArticleRepository) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	utime, id := cursorArgs(cursor)
+	res, err := c.dao.ListPubCursor(ctx, utime, id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Article) domain.Article {
+		return c.toDomain(src)
+	}), nil
 }
 
 func (c *CacheArticleRepository) preCache(ctx context.Context, data []domain.Article) {
@@ -218,7 +539,9 @@ func (c *CacheArticleRepository) preCache(ctx context.Context, data []domain.Art
 	if len(data) > 0 && len(data[0].Content) < MAX_CACHE_SIZE {
 		err := c.cache.Set(ctx, data[0])
 		if err != nil {
-			fmt.Errorf("set cache err: %v", err)
+			c.l.Error("set cache err",
+				logger.Int64("aid", data[0].Id),
+				logger.Error(err))
 		}
 	}
 }
@@ -232,7 +555,213 @@ func (c *CacheArticleRepository) toDomain(item article.Article) domain.Article {
 		Author: domain.Author{
 			Id: item.AuthorId,
 		},
-		Ctime: time.UnixMilli(item.Ctime),
-		Utime: time.UnixMilli(item.Utime),
+		Category:   item.Category,
+		Tags:       item.Tags,
+		RevisionId: item.RevisionId,
+		Ctime:      time.UnixMilli(item.Ctime),
+		Utime:      time.UnixMilli(item.Utime),
+		Dtime:      dtimeToDomain(item.Dtime),
+		Stats: domain.ArticleStats{
+			Words:       item.WordCnt,
+			Chars:       item.CharCnt,
+			Images:      item.ImageCnt,
+			ReadingTime: time.Duration(item.ReadingTime) * time.Second,
+		},
+		Summary: item.Abstract,
+	}
+}
+
+func dtimeToDomain(dtime int64) time.Time {
+	if dtime == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(dtime)
+}
+
+func (c *CacheArticleRepository) ListRevisions(ctx context.Context, aid int64, offset int, limit int) ([]domain.ArticleRevision, error) {
+	res, err := c.dao.GetRevisions(ctx, aid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.ArticleRevision) domain.ArticleRevision {
+		return c.revisionToDomain(src)
+	}), nil
+}
+
+func (c *CacheArticleRepository) GetRevisionById(ctx context.Context, id int64) (domain.ArticleRevision, error) {
+	rev, err := c.dao.GetRevisionById(ctx, id)
+	if errors.Is(err, dao.ErrRecordNotFound) {
+		return domain.ArticleRevision{}, ErrRevisionNotFound
+	}
+	if err != nil {
+		return domain.ArticleRevision{}, err
 	}
+	return c.revisionToDomain(rev), nil
+}
+
+func (c *CacheArticleRepository) revisionToDomain(rev article.ArticleRevision) domain.ArticleRevision {
+	editor := rev.EditorId
+	if editor == 0 {
+		// 之前的版本没有记修改的人，都当成作者
+		editor = rev.AuthorId
+	}
+	return domain.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Version:   rev.Version,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Author: domain.Author{
+			Id: rev.AuthorId,
+		},
+		Editor: domain.Author{
+			Id: editor,
+		},
+		Ctime: time.UnixMilli(rev.Ctime),
+	}
+}
+
+func (c *CacheArticleRepository) UpdateStatus(ctx context.Context, id int64, author int64, status domain.ArticleStatus) error {
+	err := c.dao.UpdateStatus(ctx, id, author, uint8(status))
+	if err != nil {
+		return err
+	}
+	err = c.cache.DelFirstPage(ctx, author)
+	if err != nil {
+		c.l.Error("del first page err",
+			logger.Int64("author", author),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (c *CacheArticleRepository) ListByStatus(ctx context.Context, uid int64, status domain.ArticleStatus, offset int, limit int) ([]domain.Article, error) {
+	res, err := c.dao.GetByStatus(ctx, uid, uint8(status), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Article) domain.Article {
+		return c.toDomain(src)
+	}), nil
+}
+
+func (c *CacheArticleRepository) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error) {
+	res, err := c.dao.ListPubByTag(ctx, tag, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.PublishedArticle) domain.Article {
+		return c.toDomain(dao.Article(src))
+	}), nil
+}
+
+func (c *CacheArticleRepository) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error) {
+	res, err := c.dao.ListPubByCategory(ctx, category, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.PublishedArticle) domain.Article {
+		return c.toDomain(dao.Article(src))
+	}), nil
+}
+
+func (c *CacheArticleRepository) PopularTags(ctx context.Context, limit int) ([]domain.Tag, error) {
+	res, err := c.dao.GetPopularTags(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Tag) domain.Tag {
+		return domain.Tag{
+			Name: src.Name,
+			Cnt:  src.Cnt,
+		}
+	}), nil
+}
+
+func (c *CacheArticleRepository) Trash(ctx context.Context, id int64, author int64) error {
+	err := c.dao.Trash(ctx, id, author)
+	if err != nil {
+		return err
+	}
+	c.delPub(ctx, id)
+	c.delFeeds(ctx, author)
+	err = c.cache.DelFirstPage(ctx, author)
+	if err != nil {
+		c.l.Error("del first page err",
+			logger.Int64("author", author),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (c *CacheArticleRepository) Restore(ctx context.Context, id int64, author int64) error {
+	err := c.dao.Restore(ctx, id, author)
+	if err != nil {
+		return err
+	}
+	err = c.cache.DelFirstPage(ctx, author)
+	if err != nil {
+		c.l.Error("del first page err",
+			logger.Int64("author", author),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (c *CacheArticleRepository) ListTrashedBefore(ctx context.Context, t time.Time, limit int) ([]domain.Article, error) {
+	res, err := c.dao.ListTrashedBefore(ctx, t.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Article) domain.Article {
+		return c.toDomain(src)
+	}), nil
+}
+
+func (c *CacheArticleRepository) Purge(ctx context.Context, id int64) error {
+	err := c.dao.Purge(ctx, id)
+	if errors.Is(err, dao.ErrRecordNotFound) {
+		return ErrArticleNotFound
+	}
+	if err != nil || c.uploadRepo == nil {
+		return err
+	}
+	// 文章已经删掉了，引用删不掉只是图片晚一点被清理
+	er := c.uploadRepo.DeleteRefs(ctx, id)
+	if er != nil {
+		c.l.Error("delete upload refs err",
+			logger.Int64("aid", id),
+			logger.Error(er))
+	}
+	return nil
+}
+
+func (c *CacheArticleRepository) GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error) {
+	return c.cache.GetRendered(ctx, revisionId)
+}
+
+func (c *CacheArticleRepository) SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error {
+	return c.cache.SetRendered(ctx, revisionId, rc)
+}
+
+func (c *CacheArticleRepository) ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	res, err := c.dao.ListPubByAuthor(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.PublishedArticle) domain.Article {
+		return c.toDomain(dao.Article(src))
+	}), nil
+}
+
+func (c *CacheArticleRepository) GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	return c.cache.GetFeed(ctx, format, uid)
+}
+
+func (c *CacheArticleRepository) SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error {
+	return c.cache.SetFeed(ctx, format, uid, doc)
+}
+
+func (c *CacheArticleRepository) FeedModified(ctx context.Context, uid int64) (time.Time, error) {
+	return c.cache.GetFeedModified(ctx, uid)
 }
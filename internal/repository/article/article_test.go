@@ -0,0 +1,436 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"sync"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/cache"
+	cachemocks "we_book/internal/repository/cache/mocks"
+	dao "we_book/internal/repository/dao/article"
+	daomocks "we_book/internal/repository/dao/article/mocks"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestCacheArticleRepository_GetPubById(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	pub := domain.Article{
+		Id:      1,
+		Title:   "标题",
+		Content: "内容",
+		Status:  domain.ArticleStatusPublished,
+		Author: domain.Author{
+			Id:   123,
+			Name: "作者",
+		},
+		Ctime: now,
+		Utime: now,
+	}
+	// 回源的时候没有协作者，只署所有者的名字
+	loaded := pub
+	loaded.Bylines = []domain.Author{pub.Author}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository)
+
+		id      int64
+		wantArt domain.Article
+		wantErr error
+	}{
+		{
+			name: "命中缓存",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(1)).Return(pub, nil)
+				return daomocks.NewMockArticleDAO(ctrl), c, repomocks.NewMockUserRepository(ctrl)
+			},
+			id:      1,
+			wantArt: pub,
+		},
+		{
+			name: "命中空值缓存",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(2)).Return(domain.Article{}, cache.ErrPubNotExist)
+				return daomocks.NewMockArticleDAO(ctrl), c, repomocks.NewMockUserRepository(ctrl)
+			},
+			id:      2,
+			wantErr: ErrArticleNotFound,
+		},
+		{
+			name: "未命中，回源并回写缓存",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(1)).Return(domain.Article{}, cache.ErrKeyNotExists)
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetPubById(gomock.Any(), int64(1)).Return(dao.PublishedArticle{
+					Id:       1,
+					Title:    "标题",
+					Content:  "内容",
+					AuthorId: 123,
+					Status:   uint8(domain.ArticleStatusPublished),
+					Ctime:    now.UnixMilli(),
+					Utime:    now.UnixMilli(),
+				}, nil)
+				u := repomocks.NewMockUserRepository(ctrl)
+				u.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, NickName: "作者"}, nil)
+				c.EXPECT().SetPub(gomock.Any(), loaded).Return(nil)
+				return d, c, u
+			},
+			id:      1,
+			wantArt: loaded,
+		},
+		{
+			name: "文章不存在，缓存空值",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(3)).Return(domain.Article{}, cache.ErrKeyNotExists)
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetPubById(gomock.Any(), int64(3)).
+					Return(dao.PublishedArticle{}, dao.ErrRecordNotFound)
+				c.EXPECT().SetPubNotExist(gomock.Any(), int64(3)).Return(nil)
+				return d, c, repomocks.NewMockUserRepository(ctrl)
+			},
+			id:      3,
+			wantErr: ErrArticleNotFound,
+		},
+		{
+			name: "文章已经撤回，当作不存在",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(4)).Return(domain.Article{}, cache.ErrKeyNotExists)
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetPubById(gomock.Any(), int64(4)).Return(dao.PublishedArticle{
+					Id:       4,
+					AuthorId: 123,
+					Status:   uint8(domain.ArticleStatusPrivate),
+				}, nil)
+				c.EXPECT().SetPubNotExist(gomock.Any(), int64(4)).Return(nil)
+				return d, c, repomocks.NewMockUserRepository(ctrl)
+			},
+			id:      4,
+			wantErr: ErrArticleNotFound,
+		},
+		{
+			name: "数据库错误，不缓存空值",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache, *repomocks.MockUserRepository) {
+				c := cachemocks.NewMockArticleCache(ctrl)
+				c.EXPECT().GetPub(gomock.Any(), int64(5)).Return(domain.Article{}, cache.ErrKeyNotExists)
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetPubById(gomock.Any(), int64(5)).
+					Return(dao.PublishedArticle{}, errors.New("db error"))
+				return d, c, repomocks.NewMockUserRepository(ctrl)
+			},
+			id:      5,
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c, u := tc.mock(ctrl)
+			repo := NewArticleRepository(d, c, u, nil, nil, logger.NewNoLogger())
+			art, err := repo.GetPubById(context.Background(), tc.id)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantArt, art)
+		})
+	}
+}
+
+func TestCacheArticleRepository_GetPubByIdSingleflight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	const n = 10
+	c := cachemocks.NewMockArticleCache(ctrl)
+	c.EXPECT().GetPub(gomock.Any(), int64(1)).
+		Return(domain.Article{}, cache.ErrKeyNotExists).Times(n)
+	c.EXPECT().SetPubNotExist(gomock.Any(), int64(1)).Return(nil).MaxTimes(n)
+	d := daomocks.NewMockArticleDAO(ctrl)
+	release := make(chan struct{})
+	var loads int
+	d.EXPECT().GetPubById(gomock.Any(), int64(1)).
+		DoAndReturn(func(ctx context.Context, id int64) (dao.PublishedArticle, error) {
+			loads++
+			<-release
+			return dao.PublishedArticle{}, dao.ErrRecordNotFound
+		}).MinTimes(1).MaxTimes(n)
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), nil, nil, logger.NewNoLogger())
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := repo.GetPubById(context.Background(), 1)
+			assert.Equal(t, ErrArticleNotFound, err)
+		}()
+	}
+	// 等所有请求都进到 singleflight 里面
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 1, loads)
+}
+
+func TestCacheArticleRepository_GetPubByIdBylines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := cachemocks.NewMockArticleCache(ctrl)
+	c.EXPECT().GetPub(gomock.Any(), int64(1)).Return(domain.Article{}, cache.ErrKeyNotExists)
+	d := daomocks.NewMockArticleDAO(ctrl)
+	d.EXPECT().GetPubById(gomock.Any(), int64(1)).Return(dao.PublishedArticle{
+		Id:       1,
+		AuthorId: 123,
+		Status:   uint8(domain.ArticleStatusPublished),
+	}, nil)
+	u := repomocks.NewMockUserRepository(ctrl)
+	u.EXPECT().FindById(gomock.Any(), int64(123)).
+		Return(domain.User{Id: 123, NickName: "作者"}, nil)
+	collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+	collabRepo.EXPECT().ListByArticle(gomock.Any(), int64(1)).Return([]domain.Collaborator{
+		{User: domain.Author{Id: 2, Name: "编辑"}, Role: domain.CollaboratorRoleEditor, Status: domain.InvitationStatusAccepted},
+		{User: domain.Author{Id: 3, Name: "只读"}, Role: domain.CollaboratorRoleViewer, Status: domain.InvitationStatusAccepted},
+	}, nil)
+	wantBylines := []domain.Author{
+		{Id: 123, Name: "作者"},
+		{Id: 2, Name: "编辑"},
+	}
+	// 署名和文章一起缓存，下一次读的时候不用再查协作者
+	c.EXPECT().SetPub(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, art domain.Article) error {
+			assert.Equal(t, wantBylines, art.Bylines)
+			return nil
+		})
+	repo := NewArticleRepository(d, c, u, nil, collabRepo, logger.NewNoLogger())
+	art, err := repo.GetPubById(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, wantBylines, art.Bylines)
+}
+
+func TestCacheArticleRepository_GetPubByIdFirstCallerCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := cachemocks.NewMockArticleCache(ctrl)
+	c.EXPECT().GetPub(gomock.Any(), int64(1)).
+		Return(domain.Article{}, cache.ErrKeyNotExists).Times(2)
+	c.EXPECT().SetPubNotExist(gomock.Any(), int64(1)).Return(nil)
+	d := daomocks.NewMockArticleDAO(ctrl)
+	release := make(chan struct{})
+	d.EXPECT().GetPubById(gomock.Any(), int64(1)).
+		DoAndReturn(func(ctx context.Context, id int64) (dao.PublishedArticle, error) {
+			<-release
+			// 第一个请求取消了，回源用的 ctx 还是好的
+			if ctx.Err() != nil {
+				return dao.PublishedArticle{}, ctx.Err()
+			}
+			return dao.PublishedArticle{}, dao.ErrRecordNotFound
+		})
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), nil, nil, logger.NewNoLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := repo.GetPubById(ctx, 1)
+		first <- err
+	}()
+	second := make(chan error, 1)
+	go func() {
+		_, err := repo.GetPubById(context.Background(), 1)
+		second <- err
+	}()
+	// 等两个请求都进到 singleflight 里面
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	assert.Equal(t, context.Canceled, <-first)
+	close(release)
+	assert.Equal(t, ErrArticleNotFound, <-second)
+}
+
+func TestCacheArticleRepository_ListByCursor(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	arts := func(ids ...int64) []domain.Article {
+		res := make([]domain.Article, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, domain.Article{
+				Id:     id,
+				Author: domain.Author{Id: 123},
+				Ctime:  now,
+				Utime:  now,
+			})
+		}
+		return res
+	}
+	entities := func(ids ...int64) []dao.Article {
+		res := make([]dao.Article, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, dao.Article{
+				Id:       id,
+				AuthorId: 123,
+				Ctime:    now.UnixMilli(),
+				Utime:    now.UnixMilli(),
+			})
+		}
+		return res
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache)
+
+		cursor domain.ArticleCursor
+		limit  int
+
+		wantArts []domain.Article
+		wantErr  error
+	}{
+		{
+			name: "第一页不走缓存，和后面的页一样按照 utime、id 查询",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache) {
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetByAuthorCursor(gomock.Any(), int64(123), int64(0), int64(0), 2).
+					Return(entities(3, 2), nil)
+				return d, cachemocks.NewMockArticleCache(ctrl)
+			},
+			limit:    2,
+			wantArts: arts(3, 2),
+		},
+		{
+			name: "后面的页直接查数据库",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.ArticleCache) {
+				d := daomocks.NewMockArticleDAO(ctrl)
+				d.EXPECT().GetByAuthorCursor(gomock.Any(), int64(123), now.UnixMilli(), int64(3), 2).
+					Return(entities(2, 1), nil)
+				return d, cachemocks.NewMockArticleCache(ctrl)
+			},
+			cursor:   domain.ArticleCursor{Utime: now, Id: 3},
+			limit:    2,
+			wantArts: arts(2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c := tc.mock(ctrl)
+			repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), nil, nil, logger.NewNoLogger())
+			res, err := repo.ListByCursor(context.Background(), 123, tc.cursor, tc.limit)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantArts, res)
+			// 等回写缓存的 goroutine 跑完
+			time.Sleep(10 * time.Millisecond)
+		})
+	}
+}
+
+func TestCacheArticleRepository_UpdateStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := daomocks.NewMockArticleDAO(ctrl)
+	c := cachemocks.NewMockArticleCache(ctrl)
+	// 6 个汉字、2 个英文单词、1 张图片：6/300 + 2/200 分钟，图片 12 秒
+	// 摘要里面没有图片
+	d.EXPECT().UpdateById(gomock.Any(), dao.Article{
+		Id:          1,
+		Title:       "标题",
+		Content:     "学习 Go 语言 hello\n\n![a](a.png)\n\n再见",
+		AuthorId:    123,
+		WordCnt:     8,
+		CharCnt:     13,
+		ImageCnt:    1,
+		ReadingTime: 14,
+		Abstract:    "学习 Go 语言 hello 再见",
+	}).Return(nil)
+	c.EXPECT().DelFirstPage(gomock.Any(), int64(123)).Return(nil)
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), nil, nil, logger.NewNoLogger())
+	err := repo.Update(context.Background(), domain.Article{
+		Id:      1,
+		Title:   "标题",
+		Content: "学习 Go 语言 hello\n\n![a](a.png)\n\n再见",
+		Author:  domain.Author{Id: 123},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCacheArticleRepository_UpdateTracksUploads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := daomocks.NewMockArticleDAO(ctrl)
+	c := cachemocks.NewMockArticleCache(ctrl)
+	uploadRepo := repomocks.NewMockUploadRepository(ctrl)
+	content := "![a](/files/img/123/20261018/0123456789abcdef0123456789abcdef.png)"
+	d.EXPECT().UpdateById(gomock.Any(), gomock.Any()).Return(nil)
+	c.EXPECT().DelFirstPage(gomock.Any(), int64(123)).Return(nil)
+	uploadRepo.EXPECT().SetRefs(gomock.Any(), int64(1), domain.UploadRefDraft, content).Return(nil)
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), uploadRepo, nil, logger.NewNoLogger())
+	err := repo.Update(context.Background(), domain.Article{
+		Id:      1,
+		Content: content,
+		Author:  domain.Author{Id: 123},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCacheArticleRepository_UpdateTrackUploadsFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := daomocks.NewMockArticleDAO(ctrl)
+	c := cachemocks.NewMockArticleCache(ctrl)
+	uploadRepo := repomocks.NewMockUploadRepository(ctrl)
+	content := "![a](/files/img/123/20261018/0123456789abcdef0123456789abcdef.png)"
+	d.EXPECT().UpdateById(gomock.Any(), gomock.Any()).Return(nil)
+	c.EXPECT().DelFirstPage(gomock.Any(), int64(123)).Return(nil)
+	uploadRepo.EXPECT().SetRefs(gomock.Any(), int64(1), domain.UploadRefDraft, content).
+		Return(errors.New("mock db error"))
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), uploadRepo, nil, logger.NewNoLogger())
+	err := repo.Update(context.Background(), domain.Article{
+		Id:      1,
+		Content: content,
+		Author:  domain.Author{Id: 123},
+	})
+	// 引用没记上，保存要失败
+	assert.Error(t, err)
+}
+
+func TestCacheArticleRepository_BackfillStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := daomocks.NewMockArticleDAO(ctrl)
+	c := cachemocks.NewMockArticleCache(ctrl)
+	d.EXPECT().ScanById(gomock.Any(), int64(0), 2).Return([]dao.Article{
+		{Id: 1, Content: "草稿 hello"},
+		// 已经统计过，线上库也没有
+		{Id: 3, Content: "你好", CharCnt: 2, Abstract: "你好"},
+	}, nil)
+	d.EXPECT().UpdateStats(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, art dao.Article) error {
+			assert.Equal(t, int64(1), art.Id)
+			assert.Equal(t, 3, art.WordCnt)
+			assert.Equal(t, "草稿 hello", art.Abstract)
+			return nil
+		})
+	// 线上库用自己的内容算
+	d.EXPECT().GetPubById(gomock.Any(), int64(1)).
+		Return(dao.PublishedArticle{Id: 1, Content: "线上"}, nil)
+	d.EXPECT().UpdatePubStats(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, pub dao.PublishedArticle) error {
+			assert.Equal(t, 2, pub.WordCnt)
+			assert.Equal(t, "线上", pub.Abstract)
+			return nil
+		})
+	c.EXPECT().DelPub(gomock.Any(), int64(1)).Return(nil)
+	d.EXPECT().GetPubById(gomock.Any(), int64(3)).
+		Return(dao.PublishedArticle{}, dao.ErrRecordNotFound)
+	repo := NewArticleRepository(d, c, repomocks.NewMockUserRepository(ctrl), nil, nil, logger.NewNoLogger())
+	next, n, err := repo.BackfillStats(context.Background(), 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), next)
+	assert.Equal(t, 2, n)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/repository/article/gorm.go
+// Source: internal/repository/article/article.go
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/repository/article/gorm.go -package=articlerepomock -destination=internal/repository/article/mocks/article.mock.go
+//	mockgen -source=internal/repository/article/article.go -package=articlerepomock -destination=internal/repository/article/mocks/article.mock.go
 //
 
 // Package articlerepomock is a generated GoMock package.
@@ -12,6 +12,7 @@ package articlerepomock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	domain "we_book/internal/domain"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,22 @@ func (m *MockArticleRepository) EXPECT() *MockArticleRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BackfillStats mocks base method.
+func (m *MockArticleRepository) BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillStats", ctx, startId, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BackfillStats indicates an expected call of BackfillStats.
+func (mr *MockArticleRepositoryMockRecorder) BackfillStats(ctx, startId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillStats", reflect.TypeOf((*MockArticleRepository)(nil).BackfillStats), ctx, startId, limit)
+}
+
 // Create mocks base method.
 func (m *MockArticleRepository) Create(ctx context.Context, article domain.Article) (int64, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +72,387 @@ func (mr *MockArticleRepositoryMockRecorder) Create(ctx, article any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockArticleRepository)(nil).Create), ctx, article)
 }
 
+// DelBylines mocks base method.
+func (m *MockArticleRepository) DelBylines(ctx context.Context, id int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DelBylines", ctx, id)
+}
+
+// DelBylines indicates an expected call of DelBylines.
+func (mr *MockArticleRepositoryMockRecorder) DelBylines(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelBylines", reflect.TypeOf((*MockArticleRepository)(nil).DelBylines), ctx, id)
+}
+
+// FeedModified mocks base method.
+func (m *MockArticleRepository) FeedModified(ctx context.Context, uid int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedModified", ctx, uid)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeedModified indicates an expected call of FeedModified.
+func (mr *MockArticleRepositoryMockRecorder) FeedModified(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedModified", reflect.TypeOf((*MockArticleRepository)(nil).FeedModified), ctx, uid)
+}
+
+// GetById mocks base method.
+func (m *MockArticleRepository) GetById(ctx context.Context, id int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockArticleRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockArticleRepository)(nil).GetById), ctx, id)
+}
+
+// GetFeed mocks base method.
+func (m *MockArticleRepository) GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, format, uid)
+	ret0, _ := ret[0].(domain.FeedDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockArticleRepositoryMockRecorder) GetFeed(ctx, format, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockArticleRepository)(nil).GetFeed), ctx, format, uid)
+}
+
+// GetPubById mocks base method.
+func (m *MockArticleRepository) GetPubById(ctx context.Context, id int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubById", ctx, id)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubById indicates an expected call of GetPubById.
+func (mr *MockArticleRepositoryMockRecorder) GetPubById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubById", reflect.TypeOf((*MockArticleRepository)(nil).GetPubById), ctx, id)
+}
+
+// GetRendered mocks base method.
+func (m *MockArticleRepository) GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRendered", ctx, revisionId)
+	ret0, _ := ret[0].(domain.RenderedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRendered indicates an expected call of GetRendered.
+func (mr *MockArticleRepositoryMockRecorder) GetRendered(ctx, revisionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRendered", reflect.TypeOf((*MockArticleRepository)(nil).GetRendered), ctx, revisionId)
+}
+
+// GetRevisionById mocks base method.
+func (m *MockArticleRepository) GetRevisionById(ctx context.Context, id int64) (domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionById", ctx, id)
+	ret0, _ := ret[0].(domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionById indicates an expected call of GetRevisionById.
+func (mr *MockArticleRepositoryMockRecorder) GetRevisionById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionById", reflect.TypeOf((*MockArticleRepository)(nil).GetRevisionById), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockArticleRepository) List(ctx context.Context, uid int64, set, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, uid, set, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockArticleRepositoryMockRecorder) List(ctx, uid, set, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockArticleRepository)(nil).List), ctx, uid, set, limit)
+}
+
+// ListByCursor mocks base method.
+func (m *MockArticleRepository) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCursor", ctx, uid, cursor, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCursor indicates an expected call of ListByCursor.
+func (mr *MockArticleRepositoryMockRecorder) ListByCursor(ctx, uid, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCursor", reflect.TypeOf((*MockArticleRepository)(nil).ListByCursor), ctx, uid, cursor, limit)
+}
+
+// ListByLength mocks base method.
+func (m *MockArticleRepository) ListByLength(ctx context.Context, uid int64, asc bool, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByLength", ctx, uid, asc, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByLength indicates an expected call of ListByLength.
+func (mr *MockArticleRepositoryMockRecorder) ListByLength(ctx, uid, asc, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByLength", reflect.TypeOf((*MockArticleRepository)(nil).ListByLength), ctx, uid, asc, offset, limit)
+}
+
+// ListByStatus mocks base method.
+func (m *MockArticleRepository) ListByStatus(ctx context.Context, uid int64, status domain.ArticleStatus, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, uid, status, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStatus indicates an expected call of ListByStatus.
+func (mr *MockArticleRepositoryMockRecorder) ListByStatus(ctx, uid, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MockArticleRepository)(nil).ListByStatus), ctx, uid, status, offset, limit)
+}
+
+// ListPub mocks base method.
+func (m *MockArticleRepository) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPub", ctx, start, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPub indicates an expected call of ListPub.
+func (mr *MockArticleRepositoryMockRecorder) ListPub(ctx, start, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleRepository)(nil).ListPub), ctx, start, offset, limit)
+}
+
+// ListPubByAuthor mocks base method.
+func (m *MockArticleRepository) ListPubByAuthor(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByAuthor", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByAuthor indicates an expected call of ListPubByAuthor.
+func (mr *MockArticleRepositoryMockRecorder) ListPubByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByAuthor", reflect.TypeOf((*MockArticleRepository)(nil).ListPubByAuthor), ctx, uid, offset, limit)
+}
+
+// ListPubByCategory mocks base method.
+func (m *MockArticleRepository) ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCategory", ctx, category, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCategory indicates an expected call of ListPubByCategory.
+func (mr *MockArticleRepositoryMockRecorder) ListPubByCategory(ctx, category, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCategory", reflect.TypeOf((*MockArticleRepository)(nil).ListPubByCategory), ctx, category, offset, limit)
+}
+
+// ListPubByCursor mocks base method.
+func (m *MockArticleRepository) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCursor", ctx, cursor, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCursor indicates an expected call of ListPubByCursor.
+func (mr *MockArticleRepositoryMockRecorder) ListPubByCursor(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCursor", reflect.TypeOf((*MockArticleRepository)(nil).ListPubByCursor), ctx, cursor, limit)
+}
+
+// ListPubByTag mocks base method.
+func (m *MockArticleRepository) ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByTag", ctx, tag, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByTag indicates an expected call of ListPubByTag.
+func (mr *MockArticleRepositoryMockRecorder) ListPubByTag(ctx, tag, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByTag", reflect.TypeOf((*MockArticleRepository)(nil).ListPubByTag), ctx, tag, offset, limit)
+}
+
+// ListRevisions mocks base method.
+func (m *MockArticleRepository) ListRevisions(ctx context.Context, aid int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, aid, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockArticleRepositoryMockRecorder) ListRevisions(ctx, aid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockArticleRepository)(nil).ListRevisions), ctx, aid, offset, limit)
+}
+
+// ListTrashedBefore mocks base method.
+func (m *MockArticleRepository) ListTrashedBefore(ctx context.Context, t time.Time, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedBefore", ctx, t, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedBefore indicates an expected call of ListTrashedBefore.
+func (mr *MockArticleRepositoryMockRecorder) ListTrashedBefore(ctx, t, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedBefore", reflect.TypeOf((*MockArticleRepository)(nil).ListTrashedBefore), ctx, t, limit)
+}
+
+// PopularTags mocks base method.
+func (m *MockArticleRepository) PopularTags(ctx context.Context, limit int) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopularTags", ctx, limit)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopularTags indicates an expected call of PopularTags.
+func (mr *MockArticleRepositoryMockRecorder) PopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopularTags", reflect.TypeOf((*MockArticleRepository)(nil).PopularTags), ctx, limit)
+}
+
+// Purge mocks base method.
+func (m *MockArticleRepository) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockArticleRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockArticleRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockArticleRepository) Restore(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleRepositoryMockRecorder) Restore(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticleRepository)(nil).Restore), ctx, id, author)
+}
+
+// SetFeed mocks base method.
+func (m *MockArticleRepository) SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeed", ctx, format, uid, doc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeed indicates an expected call of SetFeed.
+func (mr *MockArticleRepositoryMockRecorder) SetFeed(ctx, format, uid, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeed", reflect.TypeOf((*MockArticleRepository)(nil).SetFeed), ctx, format, uid, doc)
+}
+
+// SetRendered mocks base method.
+func (m *MockArticleRepository) SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRendered", ctx, revisionId, rc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRendered indicates an expected call of SetRendered.
+func (mr *MockArticleRepositoryMockRecorder) SetRendered(ctx, revisionId, rc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRendered", reflect.TypeOf((*MockArticleRepository)(nil).SetRendered), ctx, revisionId, rc)
+}
+
+// Sync mocks base method.
+func (m *MockArticleRepository) Sync(ctx context.Context, article domain.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, article)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockArticleRepositoryMockRecorder) Sync(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockArticleRepository)(nil).Sync), ctx, article)
+}
+
+// SyncStatus mocks base method.
+func (m *MockArticleRepository) SyncStatus(ctx context.Context, id, author int64, status domain.ArticleStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", ctx, id, author, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockArticleRepositoryMockRecorder) SyncStatus(ctx, id, author, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockArticleRepository)(nil).SyncStatus), ctx, id, author, status)
+}
+
+// Trash mocks base method.
+func (m *MockArticleRepository) Trash(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockArticleRepositoryMockRecorder) Trash(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockArticleRepository)(nil).Trash), ctx, id, author)
+}
+
 // Update mocks base method.
 func (m *MockArticleRepository) Update(ctx context.Context, article domain.Article) error {
 	m.ctrl.T.Helper()
@@ -68,3 +466,17 @@ func (mr *MockArticleRepositoryMockRecorder) Update(ctx, article any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockArticleRepository)(nil).Update), ctx, article)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockArticleRepository) UpdateStatus(ctx context.Context, id, author int64, status domain.ArticleStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, author, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockArticleRepositoryMockRecorder) UpdateStatus(ctx, id, author, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockArticleRepository)(nil).UpdateStatus), ctx, id, author, status)
+}
@@ -1,14 +1,22 @@
 package cache
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
 	"time"
 	"we_book/internal/domain"
 )
 
+// ErrPubNotExist 之前查过数据库，线上库没有这篇文章
+var ErrPubNotExist = errors.New("published article does not exist")
+
+// pubNotExistVal 空值缓存，不会和 JSON 冲突
+var pubNotExistVal = []byte("-")
+
 type RedisArticleCache struct {
 	client redis.Cmdable
 }
@@ -25,7 +33,23 @@ type ArticleCache interface {
 
 	// SetPub 正常来说，创作者和读者的 Redis 集群要分开，因为读者是一个核心中的核心
 	SetPub(ctx context.Context, article domain.Article) error
+	// GetPub 命中空值缓存的时候返回 ErrPubNotExist
 	GetPub(ctx context.Context, id int64) (domain.Article, error)
+	// SetPubNotExist 缓存一个空值，防止不存在的 ID 一直打到数据库上
+	SetPubNotExist(ctx context.Context, id int64) error
+	DelPub(ctx context.Context, id int64) error
+
+	// GetRendered 渲染结果按照版本缓存，版本的内容不会变
+	GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error)
+	SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error
+
+	// GetFeed uid 为 0 是全站的订阅源
+	GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error)
+	SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error
+	// DelFeeds 删掉作者和全站所有格式的订阅源，同时记下删除的时间
+	DelFeeds(ctx context.Context, uid int64) error
+	// GetFeedModified 订阅源最后一次失效的时间，没有失效过返回零值
+	GetFeedModified(ctx context.Context, uid int64) (time.Time, error)
 }
 
 func NewRedisArticleCache(client redis.Cmdable) ArticleCache {
@@ -65,7 +89,7 @@ func (r *RedisArticleCache) Set(ctx context.Context, art domain.Article) error {
 	if err != nil {
 		return err
 	}
-	return r.client.Set(ctx, r.firstPageKey(art.Id), data, time.Minute).Err()
+	return r.client.Set(ctx, r.authorArtKey(art.Id), data, time.Minute).Err()
 }
 
 func (r RedisArticleCache) Get(ctx context.Context, id int64) (domain.Article, error) {
@@ -91,11 +115,102 @@ func (r RedisArticleCache) GetPub(ctx context.Context, id int64) (domain.Article
 	if err != nil {
 		return domain.Article{}, err
 	}
+	if bytes.Equal(data, pubNotExistVal) {
+		return domain.Article{}, ErrPubNotExist
+	}
 	var res domain.Article
 	err = json.Unmarshal(data, &res)
 	return res, err
 }
 
+func (r RedisArticleCache) SetPubNotExist(ctx context.Context, id int64) error {
+	// 过期时间短一点，文章发表之后最多一分钟就能读到
+	return r.client.Set(ctx, r.readerArtKey(id), pubNotExistVal, time.Minute).Err()
+}
+
+func (r RedisArticleCache) DelPub(ctx context.Context, id int64) error {
+	return r.client.Del(ctx, r.readerArtKey(id)).Err()
+}
+
+func (r RedisArticleCache) GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error) {
+	data, err := r.client.Get(ctx, r.renderedKey(revisionId)).Bytes()
+	if err != nil {
+		return domain.RenderedContent{}, err
+	}
+	var res domain.RenderedContent
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+func (r RedisArticleCache) SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error {
+	data, err := json.Marshal(rc)
+	if err != nil {
+		return err
+	}
+	// 内容不会变，过期只是为了淘汰没人看的版本
+	return r.client.Set(ctx, r.renderedKey(revisionId), data, time.Hour*24).Err()
+}
+
+func (r RedisArticleCache) GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	data, err := r.client.Get(ctx, r.feedKey(format, uid)).Bytes()
+	if err != nil {
+		return domain.FeedDoc{}, err
+	}
+	var res domain.FeedDoc
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+func (r RedisArticleCache) SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, r.feedKey(format, uid), data, time.Hour).Err()
+}
+
+func (r RedisArticleCache) DelFeeds(ctx context.Context, uid int64) error {
+	keys := make([]string, 0, len(feedFormats)*2)
+	for _, format := range feedFormats {
+		keys = append(keys, r.feedKey(format, 0), r.feedKey(format, uid))
+	}
+	now := time.Now().UnixMilli()
+	// 失效时间不过期，撤回之后订阅源的 Last-Modified 不能往回走
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.Set(ctx, r.feedModifiedKey(0), now, 0)
+		pipe.Set(ctx, r.feedModifiedKey(uid), now, 0)
+		return nil
+	})
+	return err
+}
+
+func (r RedisArticleCache) GetFeedModified(ctx context.Context, uid int64) (time.Time, error) {
+	ms, err := r.client.Get(ctx, r.feedModifiedKey(uid)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// feedFormats 和 service 里面支持的格式保持一致
+var feedFormats = []string{"rss", "atom"}
+
+func (r *RedisArticleCache) feedKey(format string, uid int64) string {
+	return fmt.Sprintf("article:feed:%s:%d", format, uid)
+}
+
+func (r *RedisArticleCache) feedModifiedKey(uid int64) string {
+	return fmt.Sprintf("article:feed_modified:%d", uid)
+}
+
+func (r *RedisArticleCache) renderedKey(revisionId int64) string {
+	return fmt.Sprintf("article:rendered:%d", revisionId)
+}
+
 func (r *RedisArticleCache) authorArtKey(id int64) string {
 	return fmt.Sprintf("article:author:%d", id)
 }
@@ -0,0 +1,258 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/cache/article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/cache/article.go -package=svcmocks -destination=internal/repository/cache/mocks/article.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleCache is a mock of ArticleCache interface.
+type MockArticleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleCacheMockRecorder
+}
+
+// MockArticleCacheMockRecorder is the mock recorder for MockArticleCache.
+type MockArticleCacheMockRecorder struct {
+	mock *MockArticleCache
+}
+
+// NewMockArticleCache creates a new mock instance.
+func NewMockArticleCache(ctrl *gomock.Controller) *MockArticleCache {
+	mock := &MockArticleCache{ctrl: ctrl}
+	mock.recorder = &MockArticleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleCache) EXPECT() *MockArticleCacheMockRecorder {
+	return m.recorder
+}
+
+// DelFeeds mocks base method.
+func (m *MockArticleCache) DelFeeds(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFeeds", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelFeeds indicates an expected call of DelFeeds.
+func (mr *MockArticleCacheMockRecorder) DelFeeds(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFeeds", reflect.TypeOf((*MockArticleCache)(nil).DelFeeds), ctx, uid)
+}
+
+// DelFirstPage mocks base method.
+func (m *MockArticleCache) DelFirstPage(ctx context.Context, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFirstPage", ctx, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelFirstPage indicates an expected call of DelFirstPage.
+func (mr *MockArticleCacheMockRecorder) DelFirstPage(ctx, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFirstPage", reflect.TypeOf((*MockArticleCache)(nil).DelFirstPage), ctx, author)
+}
+
+// DelPub mocks base method.
+func (m *MockArticleCache) DelPub(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelPub", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelPub indicates an expected call of DelPub.
+func (mr *MockArticleCacheMockRecorder) DelPub(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelPub", reflect.TypeOf((*MockArticleCache)(nil).DelPub), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockArticleCache) Get(ctx context.Context, id int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockArticleCacheMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockArticleCache)(nil).Get), ctx, id)
+}
+
+// GetFeed mocks base method.
+func (m *MockArticleCache) GetFeed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, format, uid)
+	ret0, _ := ret[0].(domain.FeedDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockArticleCacheMockRecorder) GetFeed(ctx, format, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockArticleCache)(nil).GetFeed), ctx, format, uid)
+}
+
+// GetFeedModified mocks base method.
+func (m *MockArticleCache) GetFeedModified(ctx context.Context, uid int64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedModified", ctx, uid)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedModified indicates an expected call of GetFeedModified.
+func (mr *MockArticleCacheMockRecorder) GetFeedModified(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedModified", reflect.TypeOf((*MockArticleCache)(nil).GetFeedModified), ctx, uid)
+}
+
+// GetFirstPage mocks base method.
+func (m *MockArticleCache) GetFirstPage(ctx context.Context, author int64) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstPage", ctx, author)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstPage indicates an expected call of GetFirstPage.
+func (mr *MockArticleCacheMockRecorder) GetFirstPage(ctx, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstPage", reflect.TypeOf((*MockArticleCache)(nil).GetFirstPage), ctx, author)
+}
+
+// GetPub mocks base method.
+func (m *MockArticleCache) GetPub(ctx context.Context, id int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPub", ctx, id)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPub indicates an expected call of GetPub.
+func (mr *MockArticleCacheMockRecorder) GetPub(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPub", reflect.TypeOf((*MockArticleCache)(nil).GetPub), ctx, id)
+}
+
+// GetRendered mocks base method.
+func (m *MockArticleCache) GetRendered(ctx context.Context, revisionId int64) (domain.RenderedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRendered", ctx, revisionId)
+	ret0, _ := ret[0].(domain.RenderedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRendered indicates an expected call of GetRendered.
+func (mr *MockArticleCacheMockRecorder) GetRendered(ctx, revisionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRendered", reflect.TypeOf((*MockArticleCache)(nil).GetRendered), ctx, revisionId)
+}
+
+// Set mocks base method.
+func (m *MockArticleCache) Set(ctx context.Context, art domain.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, art)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockArticleCacheMockRecorder) Set(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockArticleCache)(nil).Set), ctx, art)
+}
+
+// SetFeed mocks base method.
+func (m *MockArticleCache) SetFeed(ctx context.Context, format string, uid int64, doc domain.FeedDoc) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeed", ctx, format, uid, doc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeed indicates an expected call of SetFeed.
+func (mr *MockArticleCacheMockRecorder) SetFeed(ctx, format, uid, doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeed", reflect.TypeOf((*MockArticleCache)(nil).SetFeed), ctx, format, uid, doc)
+}
+
+// SetFirstPage mocks base method.
+func (m *MockArticleCache) SetFirstPage(ctx context.Context, author int64, arts []domain.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFirstPage", ctx, author, arts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFirstPage indicates an expected call of SetFirstPage.
+func (mr *MockArticleCacheMockRecorder) SetFirstPage(ctx, author, arts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFirstPage", reflect.TypeOf((*MockArticleCache)(nil).SetFirstPage), ctx, author, arts)
+}
+
+// SetPub mocks base method.
+func (m *MockArticleCache) SetPub(ctx context.Context, article domain.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPub", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPub indicates an expected call of SetPub.
+func (mr *MockArticleCacheMockRecorder) SetPub(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPub", reflect.TypeOf((*MockArticleCache)(nil).SetPub), ctx, article)
+}
+
+// SetPubNotExist mocks base method.
+func (m *MockArticleCache) SetPubNotExist(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPubNotExist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPubNotExist indicates an expected call of SetPubNotExist.
+func (mr *MockArticleCacheMockRecorder) SetPubNotExist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPubNotExist", reflect.TypeOf((*MockArticleCache)(nil).SetPubNotExist), ctx, id)
+}
+
+// SetRendered mocks base method.
+func (m *MockArticleCache) SetRendered(ctx context.Context, revisionId int64, rc domain.RenderedContent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRendered", ctx, revisionId, rc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRendered indicates an expected call of SetRendered.
+func (mr *MockArticleCacheMockRecorder) SetRendered(ctx, revisionId, rc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRendered", reflect.TypeOf((*MockArticleCache)(nil).SetRendered), ctx, revisionId, rc)
+}
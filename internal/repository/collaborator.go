@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao"
+	"we_book/pkg/logger"
+)
+
+var ErrCollaboratorNotFound = dao.ErrCollaboratorNotFound
+
+//go:generate mockgen -source=collaborator.go -destination=mocks/collaborator.mock.go -package=svcmocks
+type CollaboratorRepository interface {
+	// Invite 重复邀请会覆盖上一次的角色，重新等待对方接受
+	// 被邀请的人不存在返回 ErrUserNotFound
+	Invite(ctx context.Context, c domain.Collaborator) error
+	Get(ctx context.Context, aid, uid int64) (domain.Collaborator, error)
+	// UpdateStatus 只修改状态是 from 的邀请
+	UpdateStatus(ctx context.Context, aid, uid int64, from, to domain.InvitationStatus) error
+	Delete(ctx context.Context, aid, uid int64) error
+	DeleteByArticle(ctx context.Context, aid int64) error
+	// ListByArticle 返回文章所有的协作者，带上名字
+	ListByArticle(ctx context.Context, aid int64) ([]domain.Collaborator, error)
+	// ListPending 返回用户还没有处理的邀请
+	ListPending(ctx context.Context, uid int64, offset int, limit int) ([]domain.Collaborator, error)
+}
+
+type GORMCollaboratorRepository struct {
+	dao      dao.CollaboratorDAO
+	userRepo UserRepository
+	l        logger.V1
+}
+
+func NewCollaboratorRepository(dao dao.CollaboratorDAO, userRepo UserRepository, l logger.V1) CollaboratorRepository {
+	return &GORMCollaboratorRepository{
+		dao:      dao,
+		userRepo: userRepo,
+		l:        l,
+	}
+}
+
+func (c *GORMCollaboratorRepository) Invite(ctx context.Context, collab domain.Collaborator) error {
+	_, err := c.userRepo.FindById(ctx, collab.User.Id)
+	if err != nil {
+		return err
+	}
+	return c.dao.Upsert(ctx, c.toEntity(collab))
+}
+
+func (c *GORMCollaboratorRepository) Get(ctx context.Context, aid, uid int64) (domain.Collaborator, error) {
+	res, err := c.dao.Get(ctx, aid, uid)
+	if err != nil {
+		return domain.Collaborator{}, err
+	}
+	return c.toDomain(res), nil
+}
+
+func (c *GORMCollaboratorRepository) UpdateStatus(ctx context.Context, aid, uid int64, from, to domain.InvitationStatus) error {
+	return c.dao.UpdateStatus(ctx, aid, uid, from.ToUint8(), to.ToUint8())
+}
+
+func (c *GORMCollaboratorRepository) Delete(ctx context.Context, aid, uid int64) error {
+	return c.dao.Delete(ctx, aid, uid)
+}
+
+func (c *GORMCollaboratorRepository) DeleteByArticle(ctx context.Context, aid int64) error {
+	return c.dao.DeleteByArticle(ctx, aid)
+}
+
+func (c *GORMCollaboratorRepository) ListByArticle(ctx context.Context, aid int64) ([]domain.Collaborator, error) {
+	collabs, err := c.dao.ListByArticle(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Collaborator, 0, len(collabs))
+	for _, collab := range collabs {
+		item := c.toDomain(collab)
+		item.User.Name = c.nameOf(ctx, collab.Uid)
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+func (c *GORMCollaboratorRepository) ListPending(ctx context.Context, uid int64, offset int, limit int) ([]domain.Collaborator, error) {
+	collabs, err := c.dao.ListByUser(ctx, uid, domain.InvitationStatusPending.ToUint8(), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Collaborator, 0, len(collabs))
+	for _, collab := range collabs {
+		res = append(res, c.toDomain(collab))
+	}
+	return res, nil
+}
+
+// nameOf 拿不到名字不影响协作者列表
+func (c *GORMCollaboratorRepository) nameOf(ctx context.Context, uid int64) string {
+	u, err := c.userRepo.FindById(ctx, uid)
+	if err != nil {
+		c.l.Warn("find collaborator name error",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+		return ""
+	}
+	return u.NickName
+}
+
+func (c *GORMCollaboratorRepository) toEntity(collab domain.Collaborator) dao.ArticleCollaborator {
+	return dao.ArticleCollaborator{
+		ArticleId: collab.ArticleId,
+		Uid:       collab.User.Id,
+		Role:      collab.Role.ToUint8(),
+		Status:    collab.Status.ToUint8(),
+		Inviter:   collab.Inviter,
+	}
+}
+
+func (c *GORMCollaboratorRepository) toDomain(collab dao.ArticleCollaborator) domain.Collaborator {
+	return domain.Collaborator{
+		ArticleId: collab.ArticleId,
+		User: domain.Author{
+			Id: collab.Uid,
+		},
+		Role:    domain.CollaboratorRole(collab.Role),
+		Status:  domain.InvitationStatus(collab.Status),
+		Inviter: collab.Inviter,
+		Ctime:   time.UnixMilli(collab.Ctime),
+		Utime:   time.UnixMilli(collab.Utime),
+	}
+}
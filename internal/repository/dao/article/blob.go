@@ -0,0 +1,247 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+	"we_book/pkg/blobx"
+)
+
+// BlobDAO 元数据存在 MySQL 里面，线上库的内容存在 blob 里面
+// 制作库的内容还是放在 MySQL，作者编辑的时候不用访问 blob
+//
+// blob 的 key 带上版本号，线上库的 revision_id 指向哪个版本，读者就读哪个版本。
+// 发表的时候在事务提交之前写 blob，blob 写失败事务就回滚；
+// 事务提交失败留下的 blob 没有人引用，彻底删除文章的时候一起清理
+type BlobDAO struct {
+	GORMArticleDAO
+	blob blobx.Storage
+}
+
+func NewBlobDAO(db *gorm.DB, blob blobx.Storage) ArticleDAO {
+	return &BlobDAO{
+		blob: blob,
+		GORMArticleDAO: GORMArticleDAO{
+			db: db,
+		},
+	}
+}
+
+func (o *BlobDAO) withDB(db *gorm.DB) *BlobDAO {
+	return &BlobDAO{
+		blob: o.blob,
+		GORMArticleDAO: GORMArticleDAO{
+			db: db,
+		},
+	}
+}
+
+// Transaction 事务里面拿到的也是 BlobDAO，不然发表的内容会写进 MySQL
+func (o *BlobDAO) Transaction(ctx context.Context, bizFunc func(txDAO ArticleDAO) error) error {
+	return o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return bizFunc(o.withDB(tx))
+	})
+}
+
+func (o *BlobDAO) Sync(ctx context.Context, art Article) (int64, error) {
+	var (
+		id = art.Id
+	)
+	err := o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		// 制作库
+		txDAO := o.withDB(tx)
+		if id == 0 {
+			id, err = txDAO.Insert(ctx, art)
+		} else {
+			err = txDAO.UpdateById(ctx, art)
+		}
+		if err != nil {
+			return err
+		}
+		// 重新读一次制作库，拿到这次保存产生的版本
+		var draft Article
+		err = tx.Where("id = ?", id).First(&draft).Error
+		if err != nil {
+			return err
+		}
+		draft.Tags = art.Tags
+		err = txDAO.Upsert(ctx, draft)
+		if err != nil {
+			return err
+		}
+		// 线上库不存内容，事件里面的内容用这次发表的
+		pub := PublishedArticle(draft)
+		pub.Utime = time.Now().UnixMilli()
+		return addEvent(tx, pub)
+	})
+	return id, err
+}
+
+// Upsert 先按照版本号写 blob，再写线上库，线上库里面不存内容
+func (o *BlobDAO) Upsert(ctx context.Context, art Article) error {
+	err := o.blob.Put(ctx, blobKey(art.Id, art.RevisionId), []byte(art.Content))
+	if err != nil {
+		return err
+	}
+	art.Content = ""
+	return o.GORMArticleDAO.Upsert(ctx, art)
+}
+
+// SyncStatus 重新发表的时候用制作库的内容，线上库指向制作库当前的版本
+// 撤回的时候 blob 留着，GetPubById 按照状态判断
+func (o *BlobDAO) SyncStatus(ctx context.Context, id int64, author int64, u uint8) error {
+	return o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pub, err := o.syncStatus(ctx, tx, id, author, u)
+		if err != nil || pub.Id == 0 {
+			return err
+		}
+		if u == statusPublished {
+			var draft Article
+			err = tx.Where("id = ?", id).First(&draft).Error
+			if err != nil {
+				return err
+			}
+			err = o.blob.Put(ctx, blobKey(id, draft.RevisionId), []byte(draft.Content))
+			if err != nil {
+				return err
+			}
+			err = tx.Model(&PublishedArticle{}).Where("id = ?", id).
+				Update("revision_id", draft.RevisionId).Error
+			if err != nil {
+				return err
+			}
+			pub.RevisionId = draft.RevisionId
+			pub.Content = draft.Content
+		}
+		return addEvent(tx, pub)
+	})
+}
+
+// GetPubById 线上库不存内容，内容从 blob 里面读
+func (o *BlobDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
+	pub, err := o.GORMArticleDAO.GetPubById(ctx, id)
+	if err != nil {
+		return pub, err
+	}
+	if pub.Status != statusPublished {
+		// 撤回了，和线上库查不到一样处理
+		return PublishedArticle{}, ErrRecordNotFound
+	}
+	data, err := o.blob.Get(ctx, blobKey(id, pub.RevisionId))
+	if errors.Is(err, blobx.ErrNotFound) {
+		return PublishedArticle{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return pub, err
+	}
+	pub.Content = string(data)
+	return pub, nil
+}
+
+func (o *BlobDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]Article, error) {
+	arts, err := o.GORMArticleDAO.ListPub(ctx, start, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return arts, o.fillArticleContent(ctx, arts)
+}
+
+func (o *BlobDAO) ListPubCursor(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	arts, err := o.GORMArticleDAO.ListPubCursor(ctx, utime, id, limit)
+	if err != nil {
+		return nil, err
+	}
+	return arts, o.fillArticleContent(ctx, arts)
+}
+
+func (o *BlobDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	pubs, err := o.GORMArticleDAO.ListPubByTag(ctx, tag, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pubs, o.fillPubContent(ctx, pubs)
+}
+
+func (o *BlobDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	pubs, err := o.GORMArticleDAO.ListPubByCategory(ctx, category, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pubs, o.fillPubContent(ctx, pubs)
+}
+
+func (o *BlobDAO) ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]PublishedArticle, error) {
+	pubs, err := o.GORMArticleDAO.ListPubByAuthor(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pubs, o.fillPubContent(ctx, pubs)
+}
+
+func (o *BlobDAO) fillArticleContent(ctx context.Context, arts []Article) error {
+	return o.loadContents(ctx, len(arts), func(i int) (int64, int64) {
+		return arts[i].Id, arts[i].RevisionId
+	}, func(i int, content string) {
+		arts[i].Content = content
+	})
+}
+
+func (o *BlobDAO) fillPubContent(ctx context.Context, pubs []PublishedArticle) error {
+	return o.loadContents(ctx, len(pubs), func(i int) (int64, int64) {
+		return pubs[i].Id, pubs[i].RevisionId
+	}, func(i int, content string) {
+		pubs[i].Content = content
+	})
+}
+
+// loadContents 并发从 blob 读内容，列表的摘要、搜索都要用
+// 刚好被撤回的文章读不到内容，内容留空
+func (o *BlobDAO) loadContents(ctx context.Context, n int,
+	key func(i int) (int64, int64), set func(i int, content string)) error {
+	var eg errgroup.Group
+	eg.SetLimit(8)
+	for i := 0; i < n; i++ {
+		i := i
+		eg.Go(func() error {
+			data, err := o.blob.Get(ctx, blobKey(key(i)))
+			if errors.Is(err, blobx.ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			set(i, string(data))
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+func blobKey(id int64, revisionId int64) string {
+	return strconv.FormatInt(id, 10) + "/" + strconv.FormatInt(revisionId, 10)
+}
+
+// Purge 彻底删除之后每个版本的内容都从 blob 删掉
+func (o *BlobDAO) Purge(ctx context.Context, id int64) error {
+	var revs []int64
+	err := o.db.WithContext(ctx).Model(&ArticleRevision{}).
+		Where("article_id = ?", id).Pluck("id", &revs).Error
+	if err != nil {
+		return err
+	}
+	err = o.GORMArticleDAO.Purge(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, rev := range revs {
+		err = o.blob.Delete(ctx, blobKey(id, rev))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
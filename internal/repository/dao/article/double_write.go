@@ -0,0 +1,282 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/syncx/atomicx"
+	"time"
+	"we_book/pkg/logger"
+)
+
+// 双写的四个阶段，迁移的时候按照顺序切换，出问题了按照相反的顺序切回去
+const (
+	// PatternSrcOnly 只读写源库
+	PatternSrcOnly = "src_only"
+	// PatternSrcFirst 读源库，先写源库再写目标库
+	PatternSrcFirst = "src_first"
+	// PatternDstFirst 读目标库，先写目标库再写源库
+	PatternDstFirst = "dst_first"
+	// PatternDstOnly 只读写目标库
+	PatternDstOnly = "dst_only"
+)
+
+var ErrUnknownPattern = errors.New("unknown double write pattern")
+
+// DoubleWriteDAO 迁移文章的时候同时写两个库
+// 写第二个库的时候不重放业务操作，而是把第一个库里面的整篇文章复制过去，
+// 这样两边的 id、utime 和版本都是一样的，校验的时候才能比对
+// 第二个库写失败只记日志，交给校验修复
+type DoubleWriteDAO struct {
+	src     MigratableDAO
+	dst     MigratableDAO
+	pattern *atomicx.Value[string]
+	l       logger.V1
+}
+
+func NewDoubleWriteDAO(src MigratableDAO, dst MigratableDAO, l logger.V1) *DoubleWriteDAO {
+	return &DoubleWriteDAO{
+		src:     src,
+		dst:     dst,
+		pattern: atomicx.NewValueOf(PatternSrcOnly),
+		l:       l,
+	}
+}
+
+func (d *DoubleWriteDAO) Pattern() string {
+	return d.pattern.Load()
+}
+
+func (d *DoubleWriteDAO) UpdatePattern(pattern string) error {
+	err := CheckPattern(pattern)
+	if err != nil {
+		return err
+	}
+	d.pattern.Store(pattern)
+	return nil
+}
+
+// CheckPattern 不认识的阶段返回 ErrUnknownPattern
+func CheckPattern(pattern string) error {
+	switch pattern {
+	case PatternSrcOnly, PatternSrcFirst, PatternDstFirst, PatternDstOnly:
+		return nil
+	default:
+		return ErrUnknownPattern
+	}
+}
+
+// reader 源库切到目标库之前一直读源库
+func (d *DoubleWriteDAO) reader() MigratableDAO {
+	switch d.pattern.Load() {
+	case PatternDstFirst, PatternDstOnly:
+		return d.dst
+	default:
+		return d.src
+	}
+}
+
+// write fn 在主库上执行，返回被修改的文章 ID
+func (d *DoubleWriteDAO) write(ctx context.Context, fn func(dao ArticleDAO) (int64, error)) (int64, error) {
+	pattern := d.pattern.Load()
+	switch pattern {
+	case PatternSrcOnly:
+		return fn(d.src)
+	case PatternSrcFirst:
+		return d.doubleWrite(ctx, d.src, d.dst, pattern, fn)
+	case PatternDstFirst:
+		return d.doubleWrite(ctx, d.dst, d.src, pattern, fn)
+	case PatternDstOnly:
+		return fn(d.dst)
+	default:
+		return 0, ErrUnknownPattern
+	}
+}
+
+func (d *DoubleWriteDAO) doubleWrite(ctx context.Context, primary, secondary MigratableDAO,
+	pattern string, fn func(dao ArticleDAO) (int64, error)) (int64, error) {
+	id, err := fn(primary)
+	if err != nil || id == 0 {
+		return id, err
+	}
+	// 主库已经成功了，不能因为第二个库失败就告诉调用方失败
+	err = Copy(ctx, primary, secondary, id)
+	if err != nil {
+		d.l.Error("double write article error",
+			logger.Int64("id", id),
+			logger.String("pattern", pattern),
+			logger.Error(err))
+	}
+	return id, nil
+}
+
+func (d *DoubleWriteDAO) Insert(ctx context.Context, article Article) (int64, error) {
+	return d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return dao.Insert(ctx, article)
+	})
+}
+
+func (d *DoubleWriteDAO) UpdateById(ctx context.Context, article Article) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return article.Id, dao.UpdateById(ctx, article)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) Sync(ctx context.Context, article Article) (int64, error) {
+	return d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return dao.Sync(ctx, article)
+	})
+}
+
+func (d *DoubleWriteDAO) Upsert(ctx context.Context, article Article) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return article.Id, dao.Upsert(ctx, article)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) SyncStatus(ctx context.Context, id int64, author int64, u uint8) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return id, dao.SyncStatus(ctx, id, author, u)
+	})
+	return err
+}
+
+// Transaction 事务只开在主库上，第二个库在事务里面就写了
+// 事务回滚的话第二个库会多出数据，交给校验修复
+func (d *DoubleWriteDAO) Transaction(ctx context.Context, bizFunc func(txDAO ArticleDAO) error) error {
+	pattern := d.pattern.Load()
+	switch pattern {
+	case PatternSrcOnly:
+		return d.src.Transaction(ctx, bizFunc)
+	case PatternDstOnly:
+		return d.dst.Transaction(ctx, bizFunc)
+	case PatternSrcFirst:
+		return d.src.Transaction(ctx, func(txDAO ArticleDAO) error {
+			return bizFunc(d.withTx(txDAO.(MigratableDAO), d.dst, pattern))
+		})
+	case PatternDstFirst:
+		return d.dst.Transaction(ctx, func(txDAO ArticleDAO) error {
+			return bizFunc(d.withTx(d.src, txDAO.(MigratableDAO), pattern))
+		})
+	default:
+		return ErrUnknownPattern
+	}
+}
+
+// withTx 事务里面的 DAO 不跟着切换阶段
+func (d *DoubleWriteDAO) withTx(src, dst MigratableDAO, pattern string) *DoubleWriteDAO {
+	return &DoubleWriteDAO{
+		src:     src,
+		dst:     dst,
+		pattern: atomicx.NewValueOf(pattern),
+		l:       d.l,
+	}
+}
+
+func (d *DoubleWriteDAO) GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]Article, error) {
+	return d.reader().GetByAuthorOrderByLength(ctx, uid, asc, offset, limit)
+}
+
+func (d *DoubleWriteDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+	return d.reader().GetByAuthor(ctx, uid, offset, limit)
+}
+
+func (d *DoubleWriteDAO) GetByAuthorCursor(ctx context.Context, uid int64, utime int64, id int64, limit int) ([]Article, error) {
+	return d.reader().GetByAuthorCursor(ctx, uid, utime, id, limit)
+}
+
+func (d *DoubleWriteDAO) GetById(ctx context.Context, id int64) (Article, error) {
+	return d.reader().GetById(ctx, id)
+}
+
+func (d *DoubleWriteDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
+	return d.reader().GetPubById(ctx, id)
+}
+
+func (d *DoubleWriteDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]Article, error) {
+	return d.reader().ListPub(ctx, start, offset, limit)
+}
+
+func (d *DoubleWriteDAO) ListPubCursor(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	return d.reader().ListPubCursor(ctx, utime, id, limit)
+}
+
+func (d *DoubleWriteDAO) GetRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error) {
+	return d.reader().GetRevisions(ctx, aid, offset, limit)
+}
+
+func (d *DoubleWriteDAO) GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error) {
+	return d.reader().GetRevisionById(ctx, id)
+}
+
+func (d *DoubleWriteDAO) UpdateStatus(ctx context.Context, id int64, author int64, status uint8) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return id, dao.UpdateStatus(ctx, id, author, status)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) ScanById(ctx context.Context, startId int64, limit int) ([]Article, error) {
+	return d.reader().ScanById(ctx, startId, limit)
+}
+
+func (d *DoubleWriteDAO) UpdateStats(ctx context.Context, art Article) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return art.Id, dao.UpdateStats(ctx, art)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) UpdatePubStats(ctx context.Context, pub PublishedArticle) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return pub.Id, dao.UpdatePubStats(ctx, pub)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) GetByStatus(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]Article, error) {
+	return d.reader().GetByStatus(ctx, uid, status, offset, limit)
+}
+
+func (d *DoubleWriteDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	return d.reader().ListPubByTag(ctx, tag, offset, limit)
+}
+
+func (d *DoubleWriteDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	return d.reader().ListPubByCategory(ctx, category, offset, limit)
+}
+
+func (d *DoubleWriteDAO) ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]PublishedArticle, error) {
+	return d.reader().ListPubByAuthor(ctx, uid, offset, limit)
+}
+
+// GetPopularTags 标签的文章数是两个库各自维护的
+func (d *DoubleWriteDAO) GetPopularTags(ctx context.Context, limit int) ([]Tag, error) {
+	return d.reader().GetPopularTags(ctx, limit)
+}
+
+func (d *DoubleWriteDAO) Trash(ctx context.Context, id int64, author int64) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return id, dao.Trash(ctx, id, author)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) Restore(ctx context.Context, id int64, author int64) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return id, dao.Restore(ctx, id, author)
+	})
+	return err
+}
+
+func (d *DoubleWriteDAO) ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]Article, error) {
+	return d.reader().ListTrashedBefore(ctx, dtime, limit)
+}
+
+func (d *DoubleWriteDAO) Purge(ctx context.Context, id int64) error {
+	_, err := d.write(ctx, func(dao ArticleDAO) (int64, error) {
+		return id, dao.Purge(ctx, id)
+	})
+	return err
+}
@@ -7,11 +7,76 @@ type Article struct {
 	Title   string `gorm:"type=varchar(4096)" bson:"title,omitempty"`
 	Content string `gorm:"type=BLOB" bson:"content,omitempty"`
 	// 作者
-	AuthorId int64 `gorm:"index" bson:"author_id,omitempty"`
-	Status   uint8 `bson:"status,omitempty"`
-	Ctime    int64 `bson:"ctime,omitempty"`
-	Utime    int64 `bson:"utime,omitempty"`
+	AuthorId int64  `gorm:"index;index:author_utime,priority:1;index:author_words,priority:1" bson:"author_id,omitempty"`
+	Status   uint8  `bson:"status,omitempty"`
+	Category string `gorm:"type:varchar(64);index" bson:"category"`
+	// Tags 在 MySQL 里面单独存一张表
+	Tags []string `gorm:"-" bson:"tags"`
+	// EditorId 保存的人，只记在生成的版本上
+	EditorId int64 `gorm:"-" bson:"-"`
+	// 最近一次保存产生的版本
+	RevisionId int64 `bson:"revision_id,omitempty"`
+	Ctime      int64 `bson:"ctime,omitempty"`
+	// 作者列表按照 utime 翻页
+	Utime int64 `gorm:"index:author_utime,priority:2" bson:"utime,omitempty"`
+	// Dtime 移到回收站的时间，清理任务按照它找过期的文章
+	Dtime int64 `gorm:"index" bson:"dtime,omitempty"`
+	// 保存的时候统计，作者列表可以按照字数排序
+	WordCnt  int `gorm:"index:author_words,priority:2" bson:"word_cnt"`
+	CharCnt  int `bson:"char_cnt"`
+	ImageCnt int `bson:"image_cnt"`
+	// ReadingTime 预计阅读时间，单位是秒
+	ReadingTime int64 `bson:"reading_time"`
+	// Abstract 保存的时候生成，列表不用每次都渲染内容
+	Abstract string `gorm:"type:varchar(1024)" bson:"abstract"`
+}
+
+// editorId 没有指定保存的人就是作者自己
+func (a Article) editorId() int64 {
+	if a.EditorId > 0 {
+		return a.EditorId
+	}
+	return a.AuthorId
 }
 
 // PublishedArticle 衍生类型，偷个懒
 type PublishedArticle Article
+
+// ArticleRevision 文章的历史版本，只插入不修改
+type ArticleRevision struct {
+	Id int64 `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
+	// 同一篇文章的版本号不能重复
+	ArticleId int64  `gorm:"uniqueIndex:aid_version" bson:"article_id,omitempty"`
+	Version   int64  `gorm:"uniqueIndex:aid_version" bson:"version,omitempty"`
+	AuthorId  int64  `bson:"author_id,omitempty"`
+	Title     string `gorm:"type:varchar(4096)" bson:"title,omitempty"`
+	Content   string `gorm:"type:LONGBLOB" bson:"content,omitempty"`
+	Ctime     int64  `bson:"ctime,omitempty"`
+	// EditorId 保存出这个版本的人，协作者编辑的时候和 AuthorId 不同
+	EditorId int64 `bson:"editor_id,omitempty"`
+}
+
+// ArticleTag 制作库里文章和标签的关系
+type ArticleTag struct {
+	Id        int64  `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64  `gorm:"uniqueIndex:aid_tag"`
+	Tag       string `gorm:"type:varchar(64);uniqueIndex:aid_tag"`
+	Ctime     int64
+}
+
+// PublishedArticleTag 线上库里文章和标签的关系，按标签查文章走这张表
+type PublishedArticleTag struct {
+	Id        int64  `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64  `gorm:"uniqueIndex:aid_tag"`
+	Tag       string `gorm:"type:varchar(64);uniqueIndex:aid_tag;index"`
+	Ctime     int64
+}
+
+// Tag 标签以及带有这个标签的已发表文章数
+type Tag struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
+	Name  string `gorm:"type:varchar(64);unique" bson:"name,omitempty"`
+	Cnt   int64  `gorm:"index" bson:"cnt"`
+	Ctime int64  `bson:"ctime,omitempty"`
+	Utime int64  `bson:"utime,omitempty"`
+}
@@ -0,0 +1,53 @@
+package article
+
+import (
+	"gorm.io/gorm"
+	"strconv"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao/outbox"
+)
+
+// eventMessage 线上库变成 pub 之后要发出去的事件，发表和撤回分别是两种事件
+func eventMessage(pub PublishedArticle) (outbox.Message, error) {
+	key := strconv.FormatInt(pub.Id, 10)
+	if pub.Status != statusPublished {
+		return withdrawnMessage(pub.Id, pub.AuthorId, pub.Utime)
+	}
+	return outbox.NewMessage(domain.TopicArticleEvent, key, domain.ArticleEventPublished,
+		domain.ArticlePublished{
+			Aid:        pub.Id,
+			Uid:        pub.AuthorId,
+			Title:      pub.Title,
+			Content:    pub.Content,
+			Category:   pub.Category,
+			Tags:       pub.Tags,
+			RevisionId: pub.RevisionId,
+			Utime:      pub.Utime,
+		})
+}
+
+func withdrawnMessage(id int64, author int64, utime int64) (outbox.Message, error) {
+	return outbox.NewMessage(domain.TopicArticleEvent, strconv.FormatInt(id, 10),
+		domain.ArticleEventWithdrawn, domain.ArticleWithdrawn{
+			Aid:   id,
+			Uid:   author,
+			Utime: utime,
+		})
+}
+
+// addEvent 和线上库的修改在同一个事务里面写发件箱
+func addEvent(tx *gorm.DB, pub PublishedArticle) error {
+	msg, err := eventMessage(pub)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&msg).Error
+}
+
+func addWithdrawnEvent(tx *gorm.DB, id int64, author int64, utime int64) error {
+	msg, err := withdrawnMessage(id, author, utime)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&msg).Error
+}
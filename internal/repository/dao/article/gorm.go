@@ -2,10 +2,18 @@ package article
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/ecodeclub/ekit/slice"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
+	"we_book/internal/domain"
+)
+
+var (
+	statusPublished = uint8(domain.ArticleStatusPublished)
+	statusTrashed   = uint8(domain.ArticleStatusTrashed)
 )
 
 type GORMArticleDAO struct {
@@ -13,10 +21,21 @@ type GORMArticleDAO struct {
 }
 
 func (g *GORMArticleDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]Article, error) {
-	var res []Article
+	var pubs []PublishedArticle
 	milli := start.UnixMilli()
-	err := g.db.WithContext(ctx).Where("utime<?", milli).Order("utime desc").Offset(offset).Limit(limit).Find(&res).Error
-	return res, err
+	err := g.db.WithContext(ctx).
+		Where("utime < ? AND status = ?", milli, statusPublished).
+		Order("utime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&pubs).Error
+	if err != nil {
+		return nil, err
+	}
+	err = g.fillPubTags(ctx, pubs)
+	return slice.Map(pubs, func(idx int, src PublishedArticle) Article {
+		return Article(src)
+	}), err
 }
 
 func NewGORMArticleDAO(db *gorm.DB) ArticleDAO {
@@ -26,23 +45,89 @@ func NewGORMArticleDAO(db *gorm.DB) ArticleDAO {
 func (g *GORMArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
 	var pub PublishedArticle
 	err := g.db.WithContext(ctx).Where("id = ?", id).First(&pub).Error
+	if err != nil {
+		return pub, err
+	}
+	tags, err := g.loadTags(ctx, &PublishedArticleTag{}, []int64{id})
+	pub.Tags = tags[id]
 	return pub, err
 }
 
 func (g *GORMArticleDAO) GetById(ctx context.Context, id int64) (Article, error) {
 	var article Article
-	err := g.db.WithContext(ctx).Where("id = ?", id).First(&article).First(&article).Error
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&article).Error
+	if err != nil {
+		return article, err
+	}
+	tags, err := g.loadTags(ctx, &ArticleTag{}, []int64{id})
+	article.Tags = tags[id]
 	return article, err
 }
 
 func (g *GORMArticleDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
 	var articles []Article
-	err := g.db.WithContext(ctx).Where("author_id = ?", uid).
+	// 回收站里的文章只在回收站列表里面出现
+	err := g.db.WithContext(ctx).Where("author_id = ? AND status <> ?", uid, statusTrashed).
 		Offset(offset).
 		Limit(limit).
 		Order("ctime desc").
 		Find(&articles).Error
-	return articles, err
+	if err != nil {
+		return nil, err
+	}
+	return articles, g.fillTags(ctx, articles)
+}
+
+func (g *GORMArticleDAO) GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]Article, error) {
+	order := "word_cnt desc, id desc"
+	if asc {
+		order = "word_cnt asc, id desc"
+	}
+	var articles []Article
+	err := g.db.WithContext(ctx).Where("author_id = ? AND status <> ?", uid, statusTrashed).
+		Offset(offset).
+		Limit(limit).
+		Order(order).
+		Find(&articles).Error
+	if err != nil {
+		return nil, err
+	}
+	return articles, g.fillTags(ctx, articles)
+}
+
+func (g *GORMArticleDAO) GetByAuthorCursor(ctx context.Context, uid int64, utime int64, id int64, limit int) ([]Article, error) {
+	var articles []Article
+	err := afterCursor(g.db.WithContext(ctx).Where("author_id = ? AND status <> ?", uid, statusTrashed), utime, id).
+		Order("utime desc, id desc").
+		Limit(limit).
+		Find(&articles).Error
+	if err != nil {
+		return nil, err
+	}
+	return articles, g.fillTags(ctx, articles)
+}
+
+func (g *GORMArticleDAO) ListPubCursor(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	var pubs []PublishedArticle
+	err := afterCursor(g.db.WithContext(ctx).Where("status = ?", statusPublished), utime, id).
+		Order("utime desc, id desc").
+		Limit(limit).
+		Find(&pubs).Error
+	if err != nil {
+		return nil, err
+	}
+	err = g.fillPubTags(ctx, pubs)
+	return slice.Map(pubs, func(idx int, src PublishedArticle) Article {
+		return Article(src)
+	}), err
+}
+
+// afterCursor 游标是 (utime, id)，utime 相同的时候用 id 区分
+func afterCursor(db *gorm.DB, utime int64, id int64) *gorm.DB {
+	if utime == 0 && id == 0 {
+		return db
+	}
+	return db.Where("utime < ? OR (utime = ? AND id < ?)", utime, utime, id)
 }
 
 func (g *GORMArticleDAO) Transaction(ctx context.Context, bizFunc func(txDAO ArticleDAO) error) error {
@@ -53,33 +138,61 @@ func (g *GORMArticleDAO) Transaction(ctx context.Context, bizFunc func(txDAO Art
 }
 
 func (g *GORMArticleDAO) SyncStatus(ctx context.Context, id int64, author int64, u uint8) error {
-	now := time.Now().UnixMilli()
 	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		res := tx.Model(&Article{}).
-			Where("id = ? AND author_id = ?", id, author).
-			Updates(map[string]any{
-				"status": u,
-				"utime":  now,
-			})
-		if res.Error != nil {
-			return res.Error
-		}
-		if res.RowsAffected != 1 {
-			return fmt.Errorf("update article status failed")
+		pub, err := g.syncStatus(ctx, tx, id, author, u)
+		if err != nil || pub.Id == 0 {
+			return err
 		}
-		return tx.Model(&Article{}).
-			Where("id = ?", id).
-			Updates(map[string]any{
-				"status": u,
-				"utime":  now,
-			}).Error
+		return addEvent(tx, pub)
 	})
 }
 
+// syncStatus 返回修改之后的线上库，从来没有发表过的文章返回零值
+func (g *GORMArticleDAO) syncStatus(ctx context.Context, tx *gorm.DB,
+	id int64, author int64, u uint8) (PublishedArticle, error) {
+	now := time.Now().UnixMilli()
+	res := tx.Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status <> ?", id, author, statusTrashed).
+		Updates(map[string]any{
+			"status": u,
+			"utime":  now,
+		})
+	if res.Error != nil {
+		return PublishedArticle{}, res.Error
+	}
+	if res.RowsAffected != 1 {
+		return PublishedArticle{}, fmt.Errorf("update article status failed")
+	}
+	res = tx.Model(&PublishedArticle{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status": u,
+			"utime":  now,
+		})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return PublishedArticle{}, res.Error
+	}
+	var pub PublishedArticle
+	err := tx.Where("id = ?", id).First(&pub).Error
+	if err != nil {
+		return PublishedArticle{}, err
+	}
+	if u == statusPublished {
+		// 撤回的时候线上库的标签已经删掉了，重新发表用制作库的补回来
+		tags, err := (&GORMArticleDAO{db: tx}).loadTags(ctx, &ArticleTag{}, []int64{id})
+		if err != nil {
+			return PublishedArticle{}, err
+		}
+		pub.Tags = tags[id]
+	}
+	// 撤回之后读者按标签就查不到了，标签的文章数也要跟着减
+	return pub, g.syncPubTags(tx, id, pub.Tags, now)
+}
+
 func (g *GORMArticleDAO) Sync(ctx context.Context, article Article) (int64, error) {
 	var id = article.Id
 	var err error
-	err = g.db.Transaction(func(tx *gorm.DB) error {
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txDAO := NewGORMArticleDAO(tx)
 		if article.Id > 0 {
 			err = txDAO.UpdateById(ctx, article)
@@ -89,7 +202,20 @@ func (g *GORMArticleDAO) Sync(ctx context.Context, article Article) (int64, erro
 		if err != nil {
 			return err
 		}
-		return txDAO.Upsert(ctx, article)
+		// 重新读一次制作库，拿到这次保存产生的版本
+		var draft Article
+		err = tx.Where("id = ?", id).First(&draft).Error
+		if err != nil {
+			return err
+		}
+		draft.Tags = article.Tags
+		err = txDAO.Upsert(ctx, draft)
+		if err != nil {
+			return err
+		}
+		pub := PublishedArticle(draft)
+		pub.Utime = time.Now().UnixMilli()
+		return addEvent(tx, pub)
 	})
 	return id, err
 }
@@ -98,23 +224,50 @@ func (g *GORMArticleDAO) Upsert(ctx context.Context, article Article) error {
 	now := time.Now().UnixMilli()
 	article.Ctime = now
 	article.Utime = now
-
-	return g.db.Clauses(clause.OnConflict{
-		DoUpdates: clause.Assignments(
-			map[string]interface{}{
-				"title":   article.Title,
-				"status":  article.Status,
-				"content": article.Content,
-				"utime":   article.Utime,
-			}),
-	}).Create(&article).Error
+	pub := PublishedArticle(article)
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(
+				map[string]interface{}{
+					"title":        pub.Title,
+					"status":       pub.Status,
+					"content":      pub.Content,
+					"category":     pub.Category,
+					"revision_id":  pub.RevisionId,
+					"word_cnt":     pub.WordCnt,
+					"char_cnt":     pub.CharCnt,
+					"image_cnt":    pub.ImageCnt,
+					"reading_time": pub.ReadingTime,
+					"abstract":     pub.Abstract,
+					"utime":        pub.Utime,
+				}),
+		}).Create(&pub).Error
+		if err != nil {
+			return err
+		}
+		tags := pub.Tags
+		if pub.Status != statusPublished {
+			tags = nil
+		}
+		return g.syncPubTags(tx, pub.Id, tags, now)
+	})
 }
 
 func (g *GORMArticleDAO) Insert(ctx context.Context, article Article) (int64, error) {
 	now := time.Now().UnixMilli()
 	article.Ctime = now
 	article.Utime = now
-	err := g.db.WithContext(ctx).Create(&article).Error
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&article).Error
+		if err != nil {
+			return err
+		}
+		err = g.replaceTags(tx, article.Id, article.Tags, now)
+		if err != nil {
+			return err
+		}
+		return g.insertRevision(tx, article, now)
+	})
 	return article.Id, err
 }
 
@@ -122,19 +275,467 @@ func (g *GORMArticleDAO) UpdateById(ctx context.Context, article Article) error
 	now := time.Now().UnixMilli()
 	article.Utime = now
 
-	res := g.db.WithContext(ctx).Model(&article).
-		Where("id = ? and author_id = ?", article.Id, article.AuthorId).
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 回收站里的文章要先恢复才能编辑
+		res := tx.Model(&article).
+			Where("id = ? and author_id = ? and status <> ?", article.Id, article.AuthorId, statusTrashed).
+			Updates(map[string]any{
+				"title":        article.Title,
+				"content":      article.Content,
+				"status":       article.Status,
+				"category":     article.Category,
+				"word_cnt":     article.WordCnt,
+				"char_cnt":     article.CharCnt,
+				"image_cnt":    article.ImageCnt,
+				"reading_time": article.ReadingTime,
+				"abstract":     article.Abstract,
+				"utime":        article.Utime,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("update article failed")
+		}
+		err := g.replaceTags(tx, article.Id, article.Tags, now)
+		if err != nil {
+			return err
+		}
+		return g.insertRevision(tx, article, now)
+	})
+}
+
+// insertRevision 为刚保存的文章生成一个新版本，并且让制作库指向它
+// 必须在事务里面调用，(article_id, version) 上的唯一索引兜底并发保存
+func (g *GORMArticleDAO) insertRevision(tx *gorm.DB, article Article, now int64) error {
+	var version int64
+	err := tx.Model(&ArticleRevision{}).
+		Where("article_id = ?", article.Id).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	if err != nil {
+		return err
+	}
+	rev := ArticleRevision{
+		ArticleId: article.Id,
+		Version:   version + 1,
+		AuthorId:  article.AuthorId,
+		EditorId:  article.editorId(),
+		Title:     article.Title,
+		Content:   article.Content,
+		Ctime:     now,
+	}
+	err = tx.Create(&rev).Error
+	if err != nil {
+		return err
+	}
+	return tx.Model(&Article{}).
+		Where("id = ?", article.Id).
+		Update("revision_id", rev.Id).Error
+}
+
+func (g *GORMArticleDAO) GetRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error) {
+	var res []ArticleRevision
+	err := g.db.WithContext(ctx).Where("article_id = ?", aid).
+		Order("version desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) UpdateStatus(ctx context.Context, id int64, author int64, status uint8) error {
+	res := g.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ?", id, author).
 		Updates(map[string]any{
-			"title":   article.Title,
-			"content": article.Content,
-			"status":  article.Status,
-			"utime":   article.Utime,
+			"status": status,
+			"utime":  time.Now().UnixMilli(),
 		})
 	if res.Error != nil {
 		return res.Error
 	}
 	if res.RowsAffected == 0 {
-		return fmt.Errorf("update article failed")
+		return fmt.Errorf("update article status failed")
+	}
+	return nil
+}
+
+func (g *GORMArticleDAO) GetByStatus(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]Article, error) {
+	var articles []Article
+	err := g.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", uid, status).
+		Offset(offset).
+		Limit(limit).
+		Order("utime desc").
+		Find(&articles).Error
+	if err != nil {
+		return nil, err
 	}
-	return res.Error
+	return articles, g.fillTags(ctx, articles)
+}
+
+func (g *GORMArticleDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	// 撤回的时候已经删掉了标签关系，这里不需要再判断状态
+	err := g.db.WithContext(ctx).
+		Joins("JOIN published_article_tags t ON t.article_id = published_articles.id").
+		Where("t.tag = ?", tag).
+		Order("published_articles.utime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, g.fillPubTags(ctx, res)
+}
+
+func (g *GORMArticleDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := g.db.WithContext(ctx).
+		Where("category = ? AND status = ?", category, statusPublished).
+		Order("utime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, g.fillPubTags(ctx, res)
+}
+
+func (g *GORMArticleDAO) ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := g.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", uid, statusPublished).
+		Order("utime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, g.fillPubTags(ctx, res)
+}
+
+func (g *GORMArticleDAO) GetPopularTags(ctx context.Context, limit int) ([]Tag, error) {
+	var res []Tag
+	err := g.db.WithContext(ctx).
+		Where("cnt > 0").
+		Order("cnt desc").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) Trash(ctx context.Context, id int64, author int64) error {
+	now := time.Now().UnixMilli()
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status <> ?", id, author, statusTrashed).
+			Updates(map[string]any{
+				"status": statusTrashed,
+				"dtime":  now,
+				"utime":  now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("trash article failed")
+		}
+		err := g.deletePub(tx, id, now)
+		if err != nil {
+			return err
+		}
+		return addWithdrawnEvent(tx, id, author, now)
+	})
+}
+
+func (g *GORMArticleDAO) Restore(ctx context.Context, id int64, author int64) error {
+	res := g.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ?", id, author, statusTrashed).
+		Updates(map[string]any{
+			"status": uint8(domain.ArticleStatusUnpublished),
+			"dtime":  0,
+			"utime":  time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("restore article failed")
+	}
+	return nil
+}
+
+func (g *GORMArticleDAO) ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]Article, error) {
+	var res []Article
+	err := g.db.WithContext(ctx).
+		Where("status = ? AND dtime < ?", statusTrashed, dtime).
+		Order("dtime").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// Purge 只删除还在回收站里的文章，已经恢复了的返回 ErrRecordNotFound
+func (g *GORMArticleDAO) Purge(ctx context.Context, id int64) error {
+	now := time.Now().UnixMilli()
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND status = ?", id, statusTrashed).Delete(&Article{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+		err := tx.Where("article_id = ?", id).Delete(&ArticleRevision{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id = ?", id).Delete(&ArticleTag{}).Error
+		if err != nil {
+			return err
+		}
+		// 移到回收站的时候已经删过线上库了，这里兜底
+		return g.deletePub(tx, id, now)
+	})
+}
+
+// deletePub 从线上库删掉文章，标签的文章数也跟着减
+// 必须在事务里面调用
+func (g *GORMArticleDAO) deletePub(tx *gorm.DB, id int64, now int64) error {
+	err := tx.Where("id = ?", id).Delete(&PublishedArticle{}).Error
+	if err != nil {
+		return err
+	}
+	return g.syncPubTags(tx, id, nil, now)
+}
+
+// replaceTags 用 tags 整体覆盖制作库里文章的标签
+func (g *GORMArticleDAO) replaceTags(tx *gorm.DB, aid int64, tags []string, now int64) error {
+	err := tx.Where("article_id = ?", aid).Delete(&ArticleTag{}).Error
+	if err != nil || len(tags) == 0 {
+		return err
+	}
+	rows := slice.Map(tags, func(idx int, src string) ArticleTag {
+		return ArticleTag{ArticleId: aid, Tag: src, Ctime: now}
+	})
+	return tx.Create(&rows).Error
+}
+
+// syncPubTags 让线上库里文章的标签和 tags 保持一致，同时维护每个标签的文章数
+// 必须在事务里面调用
+func (g *GORMArticleDAO) syncPubTags(tx *gorm.DB, aid int64, tags []string, now int64) error {
+	var old []string
+	err := tx.Model(&PublishedArticleTag{}).
+		Where("article_id = ?", aid).
+		Pluck("tag", &old).Error
+	if err != nil {
+		return err
+	}
+	removed := slice.DiffSet(old, tags)
+	added := slice.DiffSet(tags, old)
+	if len(removed) > 0 {
+		err = tx.Where("article_id = ? AND tag IN ?", aid, removed).
+			Delete(&PublishedArticleTag{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&Tag{}).
+			Where("name IN ?", removed).
+			Updates(map[string]any{
+				"cnt":   gorm.Expr("cnt - 1"),
+				"utime": now,
+			}).Error
+		if err != nil {
+			return err
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	rows := slice.Map(added, func(idx int, src string) PublishedArticleTag {
+		return PublishedArticleTag{ArticleId: aid, Tag: src, Ctime: now}
+	})
+	err = tx.Create(&rows).Error
+	if err != nil {
+		return err
+	}
+	cnts := slice.Map(added, func(idx int, src string) Tag {
+		return Tag{Name: src, Cnt: 1, Ctime: now, Utime: now}
+	})
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"cnt":   gorm.Expr("cnt + 1"),
+			"utime": now,
+		}),
+	}).Create(&cnts).Error
+}
+
+// loadTags 批量查询文章的标签，model 决定查制作库还是线上库
+func (g *GORMArticleDAO) loadTags(ctx context.Context, model any, aids []int64) (map[int64][]string, error) {
+	var rows []ArticleTag
+	err := g.db.WithContext(ctx).Model(model).
+		Where("article_id IN ?", aids).
+		Order("id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]string, len(aids))
+	for _, r := range rows {
+		res[r.ArticleId] = append(res[r.ArticleId], r.Tag)
+	}
+	return res, nil
+}
+
+func (g *GORMArticleDAO) fillTags(ctx context.Context, arts []Article) error {
+	if len(arts) == 0 {
+		return nil
+	}
+	tags, err := g.loadTags(ctx, &ArticleTag{}, slice.Map(arts, func(idx int, src Article) int64 {
+		return src.Id
+	}))
+	if err != nil {
+		return err
+	}
+	for i := range arts {
+		arts[i].Tags = tags[arts[i].Id]
+	}
+	return nil
+}
+
+func (g *GORMArticleDAO) fillPubTags(ctx context.Context, pubs []PublishedArticle) error {
+	if len(pubs) == 0 {
+		return nil
+	}
+	tags, err := g.loadTags(ctx, &PublishedArticleTag{}, slice.Map(pubs, func(idx int, src PublishedArticle) int64 {
+		return src.Id
+	}))
+	if err != nil {
+		return err
+	}
+	for i := range pubs {
+		pubs[i].Tags = tags[pubs[i].Id]
+	}
+	return nil
+}
+
+func (g *GORMArticleDAO) ScanById(ctx context.Context, startId int64, limit int) ([]Article, error) {
+	var res []Article
+	err := g.db.WithContext(ctx).Where("id > ?", startId).
+		Order("id").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) UpdateStats(ctx context.Context, art Article) error {
+	return g.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ?", art.Id).
+		Updates(statsColumns(art)).Error
+}
+
+func (g *GORMArticleDAO) UpdatePubStats(ctx context.Context, pub PublishedArticle) error {
+	return g.db.WithContext(ctx).Model(&PublishedArticle{}).
+		Where("id = ?", pub.Id).
+		Updates(statsColumns(Article(pub))).Error
+}
+
+func statsColumns(art Article) map[string]any {
+	return map[string]any{
+		"word_cnt":     art.WordCnt,
+		"char_cnt":     art.CharCnt,
+		"image_cnt":    art.ImageCnt,
+		"reading_time": art.ReadingTime,
+		"abstract":     art.Abstract,
+	}
+}
+
+func (g *GORMArticleDAO) ScanByUtime(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	var res []Article
+	err := g.db.WithContext(ctx).
+		Where("utime > ? OR (utime = ? AND id > ?)", utime, utime, id).
+		Order("utime, id").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) FindByIds(ctx context.Context, ids []int64) ([]Article, error) {
+	var res []Article
+	err := g.db.WithContext(ctx).Where("id IN ?", ids).Find(&res).Error
+	return res, err
+}
+
+func (g *GORMArticleDAO) Snapshot(ctx context.Context, id int64) (Snapshot, error) {
+	s := Snapshot{Id: id}
+	art, err := g.GetById(ctx, id)
+	switch {
+	case err == nil:
+		s.Article = &art
+	case !errors.Is(err, ErrRecordNotFound):
+		return Snapshot{}, err
+	}
+	pub, err := g.GetPubById(ctx, id)
+	switch {
+	case err == nil:
+		s.Pub = &pub
+	case !errors.Is(err, ErrRecordNotFound):
+		return Snapshot{}, err
+	}
+	err = g.db.WithContext(ctx).Where("article_id = ?", id).
+		Order("version").
+		Find(&s.Revisions).Error
+	return s, err
+}
+
+// Overwrite 主键都用 Snapshot 里面的，所以两个库的 id 是一样的
+func (g *GORMArticleDAO) Overwrite(ctx context.Context, s Snapshot) error {
+	now := time.Now().UnixMilli()
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if s.Article == nil {
+			err = tx.Where("id = ?", s.Id).Delete(&Article{}).Error
+			if err == nil {
+				err = g.replaceTags(tx, s.Id, nil, now)
+			}
+		} else {
+			err = tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(s.Article).Error
+			if err == nil {
+				err = g.replaceTags(tx, s.Id, s.Article.Tags, now)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if s.Pub == nil {
+			err = g.deletePub(tx, s.Id, now)
+		} else {
+			err = tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(s.Pub).Error
+			if err == nil {
+				var tags []string
+				if s.Pub.Status == statusPublished {
+					tags = s.Pub.Tags
+				}
+				err = g.syncPubTags(tx, s.Id, tags, now)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Where("article_id = ?", s.Id).Delete(&ArticleRevision{}).Error
+		if err != nil || len(s.Revisions) == 0 {
+			return err
+		}
+		return tx.Create(&s.Revisions).Error
+	})
 }
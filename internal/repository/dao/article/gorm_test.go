@@ -0,0 +1,92 @@
+package article
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// 历史版本存的是全文，超过 BLOB 的 64KB 也要完整存下来
+func TestGORMArticleDAO_InsertLargeRevision(t *testing.T) {
+	s, err := schema.Parse(&ArticleRevision{}, &sync.Map{}, schema.NamingStrategy{})
+	require.NoError(t, err)
+	assert.Equal(t, schema.DataType("LONGBLOB"), s.LookUpField("Content").DataType)
+
+	content := strings.Repeat("a", 64*1024+1)
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `articles` .*").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM `article_tags` .*").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT COALESCE\\(MAX\\(version\\), 0\\) FROM `article_revisions` .*").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(0))
+	mock.ExpectExec("INSERT INTO `article_revisions` .*").
+		WithArgs(int64(1), int64(1), int64(123), "标题", content, sqlmock.AnyArg(), int64(123)).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("UPDATE `articles` SET `revision_id`=.*").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	id, err := NewGORMArticleDAO(db).Insert(context.Background(), Article{
+		Title:    "标题",
+		Content:  content,
+		AuthorId: 123,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 协作者保存的时候文章还是按照所有者过滤，版本上记下协作者
+func TestGORMArticleDAO_UpdateByIdRevisionEditor(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `articles` SET .* WHERE \\(id = \\? and author_id = \\? .*").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `article_tags` .*").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT COALESCE\\(MAX\\(version\\), 0\\) FROM `article_revisions` .*").
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec("INSERT INTO `article_revisions` .*").
+		WithArgs(int64(1), int64(4), int64(123), "标题", "内容", sqlmock.AnyArg(), int64(456)).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("UPDATE `articles` SET `revision_id`=.*").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	err = NewGORMArticleDAO(db).UpdateById(context.Background(), Article{
+		Id:       1,
+		Title:    "标题",
+		Content:  "内容",
+		AuthorId: 123,
+		EditorId: 456,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
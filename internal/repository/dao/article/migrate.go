@@ -0,0 +1,37 @@
+package article
+
+import "context"
+
+// MigratableDAO 在 MySQL 和 MongoDB 之间迁移文章需要的方法
+// GORMArticleDAO 和 MongoDBDAO 实现了，BlobDAO 的内容不在 MySQL 里面，不支持迁移
+type MigratableDAO interface {
+	ArticleDAO
+	// ScanByUtime 按照 (utime, id) 升序返回排在游标后面的文章，增量校验用
+	ScanByUtime(ctx context.Context, utime int64, id int64, limit int) ([]Article, error)
+	// FindByIds 批量查询制作库，不带标签，查不到的直接忽略
+	FindByIds(ctx context.Context, ids []int64) ([]Article, error)
+	// Snapshot 读出一篇文章的所有数据
+	Snapshot(ctx context.Context, id int64) (Snapshot, error)
+	// Overwrite 用 Snapshot 整体覆盖同一篇文章，不产生新版本也不产生事件
+	Overwrite(ctx context.Context, s Snapshot) error
+}
+
+// Snapshot 一篇文章在一个库里面的全部数据
+type Snapshot struct {
+	Id int64
+	// Article 制作库，nil 说明文章不存在
+	Article *Article
+	// Pub 线上库，nil 说明没有发表过或者已经移到了回收站
+	Pub *PublishedArticle
+	// Revisions 按照版本号升序
+	Revisions []ArticleRevision
+}
+
+// Copy 用 src 里面的文章覆盖 dst，src 里面已经没有了就从 dst 删掉
+func Copy(ctx context.Context, src, dst MigratableDAO, id int64) error {
+	s, err := src.Snapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+	return dst.Overwrite(ctx, s)
+}
@@ -0,0 +1,452 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/dao/article/types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/dao/article/types.go -package=daomocks -destination=internal/repository/dao/article/mocks/article.mock.go
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	article "we_book/internal/repository/dao/article"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleDAO is a mock of ArticleDAO interface.
+type MockArticleDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleDAOMockRecorder
+}
+
+// MockArticleDAOMockRecorder is the mock recorder for MockArticleDAO.
+type MockArticleDAOMockRecorder struct {
+	mock *MockArticleDAO
+}
+
+// NewMockArticleDAO creates a new mock instance.
+func NewMockArticleDAO(ctrl *gomock.Controller) *MockArticleDAO {
+	mock := &MockArticleDAO{ctrl: ctrl}
+	mock.recorder = &MockArticleDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleDAO) EXPECT() *MockArticleDAOMockRecorder {
+	return m.recorder
+}
+
+// GetByAuthor mocks base method.
+func (m *MockArticleDAO) GetByAuthor(ctx context.Context, uid int64, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthor", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthor indicates an expected call of GetByAuthor.
+func (mr *MockArticleDAOMockRecorder) GetByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthor), ctx, uid, offset, limit)
+}
+
+// GetByAuthorCursor mocks base method.
+func (m *MockArticleDAO) GetByAuthorCursor(ctx context.Context, uid, utime, id int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthorCursor", ctx, uid, utime, id, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthorCursor indicates an expected call of GetByAuthorCursor.
+func (mr *MockArticleDAOMockRecorder) GetByAuthorCursor(ctx, uid, utime, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorCursor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthorCursor), ctx, uid, utime, id, limit)
+}
+
+// GetByAuthorOrderByLength mocks base method.
+func (m *MockArticleDAO) GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthorOrderByLength", ctx, uid, asc, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthorOrderByLength indicates an expected call of GetByAuthorOrderByLength.
+func (mr *MockArticleDAOMockRecorder) GetByAuthorOrderByLength(ctx, uid, asc, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorOrderByLength", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthorOrderByLength), ctx, uid, asc, offset, limit)
+}
+
+// GetById mocks base method.
+func (m *MockArticleDAO) GetById(ctx context.Context, id int64) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockArticleDAOMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockArticleDAO)(nil).GetById), ctx, id)
+}
+
+// GetByStatus mocks base method.
+func (m *MockArticleDAO) GetByStatus(ctx context.Context, uid int64, status uint8, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByStatus", ctx, uid, status, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByStatus indicates an expected call of GetByStatus.
+func (mr *MockArticleDAOMockRecorder) GetByStatus(ctx, uid, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatus", reflect.TypeOf((*MockArticleDAO)(nil).GetByStatus), ctx, uid, status, offset, limit)
+}
+
+// GetPopularTags mocks base method.
+func (m *MockArticleDAO) GetPopularTags(ctx context.Context, limit int) ([]article.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularTags", ctx, limit)
+	ret0, _ := ret[0].([]article.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularTags indicates an expected call of GetPopularTags.
+func (mr *MockArticleDAOMockRecorder) GetPopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularTags", reflect.TypeOf((*MockArticleDAO)(nil).GetPopularTags), ctx, limit)
+}
+
+// GetPubById mocks base method.
+func (m *MockArticleDAO) GetPubById(ctx context.Context, id int64) (article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubById", ctx, id)
+	ret0, _ := ret[0].(article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubById indicates an expected call of GetPubById.
+func (mr *MockArticleDAOMockRecorder) GetPubById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubById", reflect.TypeOf((*MockArticleDAO)(nil).GetPubById), ctx, id)
+}
+
+// GetRevisionById mocks base method.
+func (m *MockArticleDAO) GetRevisionById(ctx context.Context, id int64) (article.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionById", ctx, id)
+	ret0, _ := ret[0].(article.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionById indicates an expected call of GetRevisionById.
+func (mr *MockArticleDAOMockRecorder) GetRevisionById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionById", reflect.TypeOf((*MockArticleDAO)(nil).GetRevisionById), ctx, id)
+}
+
+// GetRevisions mocks base method.
+func (m *MockArticleDAO) GetRevisions(ctx context.Context, aid int64, offset, limit int) ([]article.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, aid, offset, limit)
+	ret0, _ := ret[0].([]article.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockArticleDAOMockRecorder) GetRevisions(ctx, aid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockArticleDAO)(nil).GetRevisions), ctx, aid, offset, limit)
+}
+
+// Insert mocks base method.
+func (m *MockArticleDAO) Insert(ctx context.Context, article article.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, article)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockArticleDAOMockRecorder) Insert(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockArticleDAO)(nil).Insert), ctx, article)
+}
+
+// ListPub mocks base method.
+func (m *MockArticleDAO) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPub", ctx, start, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPub indicates an expected call of ListPub.
+func (mr *MockArticleDAOMockRecorder) ListPub(ctx, start, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleDAO)(nil).ListPub), ctx, start, offset, limit)
+}
+
+// ListPubByAuthor mocks base method.
+func (m *MockArticleDAO) ListPubByAuthor(ctx context.Context, uid int64, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByAuthor", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByAuthor indicates an expected call of ListPubByAuthor.
+func (mr *MockArticleDAOMockRecorder) ListPubByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).ListPubByAuthor), ctx, uid, offset, limit)
+}
+
+// ListPubByCategory mocks base method.
+func (m *MockArticleDAO) ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCategory", ctx, category, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCategory indicates an expected call of ListPubByCategory.
+func (mr *MockArticleDAOMockRecorder) ListPubByCategory(ctx, category, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCategory", reflect.TypeOf((*MockArticleDAO)(nil).ListPubByCategory), ctx, category, offset, limit)
+}
+
+// ListPubByTag mocks base method.
+func (m *MockArticleDAO) ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByTag", ctx, tag, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByTag indicates an expected call of ListPubByTag.
+func (mr *MockArticleDAOMockRecorder) ListPubByTag(ctx, tag, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByTag", reflect.TypeOf((*MockArticleDAO)(nil).ListPubByTag), ctx, tag, offset, limit)
+}
+
+// ListPubCursor mocks base method.
+func (m *MockArticleDAO) ListPubCursor(ctx context.Context, utime, id int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubCursor", ctx, utime, id, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubCursor indicates an expected call of ListPubCursor.
+func (mr *MockArticleDAOMockRecorder) ListPubCursor(ctx, utime, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubCursor", reflect.TypeOf((*MockArticleDAO)(nil).ListPubCursor), ctx, utime, id, limit)
+}
+
+// ListTrashedBefore mocks base method.
+func (m *MockArticleDAO) ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedBefore", ctx, dtime, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedBefore indicates an expected call of ListTrashedBefore.
+func (mr *MockArticleDAOMockRecorder) ListTrashedBefore(ctx, dtime, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedBefore", reflect.TypeOf((*MockArticleDAO)(nil).ListTrashedBefore), ctx, dtime, limit)
+}
+
+// Purge mocks base method.
+func (m *MockArticleDAO) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockArticleDAOMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockArticleDAO)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockArticleDAO) Restore(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleDAOMockRecorder) Restore(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticleDAO)(nil).Restore), ctx, id, author)
+}
+
+// ScanById mocks base method.
+func (m *MockArticleDAO) ScanById(ctx context.Context, startId int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanById", ctx, startId, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanById indicates an expected call of ScanById.
+func (mr *MockArticleDAOMockRecorder) ScanById(ctx, startId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanById", reflect.TypeOf((*MockArticleDAO)(nil).ScanById), ctx, startId, limit)
+}
+
+// Sync mocks base method.
+func (m *MockArticleDAO) Sync(ctx context.Context, article article.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, article)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockArticleDAOMockRecorder) Sync(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockArticleDAO)(nil).Sync), ctx, article)
+}
+
+// SyncStatus mocks base method.
+func (m *MockArticleDAO) SyncStatus(ctx context.Context, id, author int64, u uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", ctx, id, author, u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockArticleDAOMockRecorder) SyncStatus(ctx, id, author, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockArticleDAO)(nil).SyncStatus), ctx, id, author, u)
+}
+
+// Transaction mocks base method.
+func (m *MockArticleDAO) Transaction(ctx context.Context, bizFunc func(article.ArticleDAO) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, bizFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockArticleDAOMockRecorder) Transaction(ctx, bizFunc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockArticleDAO)(nil).Transaction), ctx, bizFunc)
+}
+
+// Trash mocks base method.
+func (m *MockArticleDAO) Trash(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockArticleDAOMockRecorder) Trash(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockArticleDAO)(nil).Trash), ctx, id, author)
+}
+
+// UpdateById mocks base method.
+func (m *MockArticleDAO) UpdateById(ctx context.Context, article article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateById", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateById indicates an expected call of UpdateById.
+func (mr *MockArticleDAOMockRecorder) UpdateById(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateById", reflect.TypeOf((*MockArticleDAO)(nil).UpdateById), ctx, article)
+}
+
+// UpdatePubStats mocks base method.
+func (m *MockArticleDAO) UpdatePubStats(ctx context.Context, pub article.PublishedArticle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePubStats", ctx, pub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePubStats indicates an expected call of UpdatePubStats.
+func (mr *MockArticleDAOMockRecorder) UpdatePubStats(ctx, pub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePubStats", reflect.TypeOf((*MockArticleDAO)(nil).UpdatePubStats), ctx, pub)
+}
+
+// UpdateStats mocks base method.
+func (m *MockArticleDAO) UpdateStats(ctx context.Context, art article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStats", ctx, art)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStats indicates an expected call of UpdateStats.
+func (mr *MockArticleDAOMockRecorder) UpdateStats(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStats", reflect.TypeOf((*MockArticleDAO)(nil).UpdateStats), ctx, art)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockArticleDAO) UpdateStatus(ctx context.Context, id, author int64, status uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, author, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockArticleDAOMockRecorder) UpdateStatus(ctx, id, author, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockArticleDAO)(nil).UpdateStatus), ctx, id, author, status)
+}
+
+// Upsert mocks base method.
+func (m *MockArticleDAO) Upsert(ctx context.Context, article article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockArticleDAOMockRecorder) Upsert(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockArticleDAO)(nil).Upsert), ctx, article)
+}
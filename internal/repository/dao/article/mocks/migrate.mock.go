@@ -0,0 +1,511 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/dao/article/migrate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/dao/article/migrate.go -package=daomocks -destination=internal/repository/dao/article/mocks/migrate.mock.go -aux_files=we_book/internal/repository/dao/article=internal/repository/dao/article/types.go
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	article "we_book/internal/repository/dao/article"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMigratableDAO is a mock of MigratableDAO interface.
+type MockMigratableDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigratableDAOMockRecorder
+}
+
+// MockMigratableDAOMockRecorder is the mock recorder for MockMigratableDAO.
+type MockMigratableDAOMockRecorder struct {
+	mock *MockMigratableDAO
+}
+
+// NewMockMigratableDAO creates a new mock instance.
+func NewMockMigratableDAO(ctrl *gomock.Controller) *MockMigratableDAO {
+	mock := &MockMigratableDAO{ctrl: ctrl}
+	mock.recorder = &MockMigratableDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigratableDAO) EXPECT() *MockMigratableDAOMockRecorder {
+	return m.recorder
+}
+
+// FindByIds mocks base method.
+func (m *MockMigratableDAO) FindByIds(ctx context.Context, ids []int64) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIds", ctx, ids)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIds indicates an expected call of FindByIds.
+func (mr *MockMigratableDAOMockRecorder) FindByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIds", reflect.TypeOf((*MockMigratableDAO)(nil).FindByIds), ctx, ids)
+}
+
+// GetByAuthor mocks base method.
+func (m *MockMigratableDAO) GetByAuthor(ctx context.Context, uid int64, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthor", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthor indicates an expected call of GetByAuthor.
+func (mr *MockMigratableDAOMockRecorder) GetByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockMigratableDAO)(nil).GetByAuthor), ctx, uid, offset, limit)
+}
+
+// GetByAuthorCursor mocks base method.
+func (m *MockMigratableDAO) GetByAuthorCursor(ctx context.Context, uid, utime, id int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthorCursor", ctx, uid, utime, id, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthorCursor indicates an expected call of GetByAuthorCursor.
+func (mr *MockMigratableDAOMockRecorder) GetByAuthorCursor(ctx, uid, utime, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorCursor", reflect.TypeOf((*MockMigratableDAO)(nil).GetByAuthorCursor), ctx, uid, utime, id, limit)
+}
+
+// GetByAuthorOrderByLength mocks base method.
+func (m *MockMigratableDAO) GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAuthorOrderByLength", ctx, uid, asc, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAuthorOrderByLength indicates an expected call of GetByAuthorOrderByLength.
+func (mr *MockMigratableDAOMockRecorder) GetByAuthorOrderByLength(ctx, uid, asc, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthorOrderByLength", reflect.TypeOf((*MockMigratableDAO)(nil).GetByAuthorOrderByLength), ctx, uid, asc, offset, limit)
+}
+
+// GetById mocks base method.
+func (m *MockMigratableDAO) GetById(ctx context.Context, id int64) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockMigratableDAOMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockMigratableDAO)(nil).GetById), ctx, id)
+}
+
+// GetByStatus mocks base method.
+func (m *MockMigratableDAO) GetByStatus(ctx context.Context, uid int64, status uint8, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByStatus", ctx, uid, status, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByStatus indicates an expected call of GetByStatus.
+func (mr *MockMigratableDAOMockRecorder) GetByStatus(ctx, uid, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatus", reflect.TypeOf((*MockMigratableDAO)(nil).GetByStatus), ctx, uid, status, offset, limit)
+}
+
+// GetPopularTags mocks base method.
+func (m *MockMigratableDAO) GetPopularTags(ctx context.Context, limit int) ([]article.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularTags", ctx, limit)
+	ret0, _ := ret[0].([]article.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularTags indicates an expected call of GetPopularTags.
+func (mr *MockMigratableDAOMockRecorder) GetPopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularTags", reflect.TypeOf((*MockMigratableDAO)(nil).GetPopularTags), ctx, limit)
+}
+
+// GetPubById mocks base method.
+func (m *MockMigratableDAO) GetPubById(ctx context.Context, id int64) (article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubById", ctx, id)
+	ret0, _ := ret[0].(article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubById indicates an expected call of GetPubById.
+func (mr *MockMigratableDAOMockRecorder) GetPubById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubById", reflect.TypeOf((*MockMigratableDAO)(nil).GetPubById), ctx, id)
+}
+
+// GetRevisionById mocks base method.
+func (m *MockMigratableDAO) GetRevisionById(ctx context.Context, id int64) (article.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionById", ctx, id)
+	ret0, _ := ret[0].(article.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionById indicates an expected call of GetRevisionById.
+func (mr *MockMigratableDAOMockRecorder) GetRevisionById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionById", reflect.TypeOf((*MockMigratableDAO)(nil).GetRevisionById), ctx, id)
+}
+
+// GetRevisions mocks base method.
+func (m *MockMigratableDAO) GetRevisions(ctx context.Context, aid int64, offset, limit int) ([]article.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, aid, offset, limit)
+	ret0, _ := ret[0].([]article.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockMigratableDAOMockRecorder) GetRevisions(ctx, aid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockMigratableDAO)(nil).GetRevisions), ctx, aid, offset, limit)
+}
+
+// Insert mocks base method.
+func (m *MockMigratableDAO) Insert(ctx context.Context, article article.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, article)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMigratableDAOMockRecorder) Insert(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMigratableDAO)(nil).Insert), ctx, article)
+}
+
+// ListPub mocks base method.
+func (m *MockMigratableDAO) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPub", ctx, start, offset, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPub indicates an expected call of ListPub.
+func (mr *MockMigratableDAOMockRecorder) ListPub(ctx, start, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockMigratableDAO)(nil).ListPub), ctx, start, offset, limit)
+}
+
+// ListPubByAuthor mocks base method.
+func (m *MockMigratableDAO) ListPubByAuthor(ctx context.Context, uid int64, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByAuthor", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByAuthor indicates an expected call of ListPubByAuthor.
+func (mr *MockMigratableDAOMockRecorder) ListPubByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByAuthor", reflect.TypeOf((*MockMigratableDAO)(nil).ListPubByAuthor), ctx, uid, offset, limit)
+}
+
+// ListPubByCategory mocks base method.
+func (m *MockMigratableDAO) ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCategory", ctx, category, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCategory indicates an expected call of ListPubByCategory.
+func (mr *MockMigratableDAOMockRecorder) ListPubByCategory(ctx, category, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCategory", reflect.TypeOf((*MockMigratableDAO)(nil).ListPubByCategory), ctx, category, offset, limit)
+}
+
+// ListPubByTag mocks base method.
+func (m *MockMigratableDAO) ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]article.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByTag", ctx, tag, offset, limit)
+	ret0, _ := ret[0].([]article.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByTag indicates an expected call of ListPubByTag.
+func (mr *MockMigratableDAOMockRecorder) ListPubByTag(ctx, tag, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByTag", reflect.TypeOf((*MockMigratableDAO)(nil).ListPubByTag), ctx, tag, offset, limit)
+}
+
+// ListPubCursor mocks base method.
+func (m *MockMigratableDAO) ListPubCursor(ctx context.Context, utime, id int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubCursor", ctx, utime, id, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubCursor indicates an expected call of ListPubCursor.
+func (mr *MockMigratableDAOMockRecorder) ListPubCursor(ctx, utime, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubCursor", reflect.TypeOf((*MockMigratableDAO)(nil).ListPubCursor), ctx, utime, id, limit)
+}
+
+// ListTrashedBefore mocks base method.
+func (m *MockMigratableDAO) ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedBefore", ctx, dtime, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedBefore indicates an expected call of ListTrashedBefore.
+func (mr *MockMigratableDAOMockRecorder) ListTrashedBefore(ctx, dtime, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedBefore", reflect.TypeOf((*MockMigratableDAO)(nil).ListTrashedBefore), ctx, dtime, limit)
+}
+
+// Overwrite mocks base method.
+func (m *MockMigratableDAO) Overwrite(ctx context.Context, s article.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overwrite", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Overwrite indicates an expected call of Overwrite.
+func (mr *MockMigratableDAOMockRecorder) Overwrite(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overwrite", reflect.TypeOf((*MockMigratableDAO)(nil).Overwrite), ctx, s)
+}
+
+// Purge mocks base method.
+func (m *MockMigratableDAO) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockMigratableDAOMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockMigratableDAO)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockMigratableDAO) Restore(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMigratableDAOMockRecorder) Restore(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMigratableDAO)(nil).Restore), ctx, id, author)
+}
+
+// ScanById mocks base method.
+func (m *MockMigratableDAO) ScanById(ctx context.Context, startId int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanById", ctx, startId, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanById indicates an expected call of ScanById.
+func (mr *MockMigratableDAOMockRecorder) ScanById(ctx, startId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanById", reflect.TypeOf((*MockMigratableDAO)(nil).ScanById), ctx, startId, limit)
+}
+
+// ScanByUtime mocks base method.
+func (m *MockMigratableDAO) ScanByUtime(ctx context.Context, utime, id int64, limit int) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanByUtime", ctx, utime, id, limit)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanByUtime indicates an expected call of ScanByUtime.
+func (mr *MockMigratableDAOMockRecorder) ScanByUtime(ctx, utime, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanByUtime", reflect.TypeOf((*MockMigratableDAO)(nil).ScanByUtime), ctx, utime, id, limit)
+}
+
+// Snapshot mocks base method.
+func (m *MockMigratableDAO) Snapshot(ctx context.Context, id int64) (article.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", ctx, id)
+	ret0, _ := ret[0].(article.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockMigratableDAOMockRecorder) Snapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockMigratableDAO)(nil).Snapshot), ctx, id)
+}
+
+// Sync mocks base method.
+func (m *MockMigratableDAO) Sync(ctx context.Context, article article.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, article)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockMigratableDAOMockRecorder) Sync(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockMigratableDAO)(nil).Sync), ctx, article)
+}
+
+// SyncStatus mocks base method.
+func (m *MockMigratableDAO) SyncStatus(ctx context.Context, id, author int64, u uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", ctx, id, author, u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockMigratableDAOMockRecorder) SyncStatus(ctx, id, author, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockMigratableDAO)(nil).SyncStatus), ctx, id, author, u)
+}
+
+// Transaction mocks base method.
+func (m *MockMigratableDAO) Transaction(ctx context.Context, bizFunc func(article.ArticleDAO) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, bizFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockMigratableDAOMockRecorder) Transaction(ctx, bizFunc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockMigratableDAO)(nil).Transaction), ctx, bizFunc)
+}
+
+// Trash mocks base method.
+func (m *MockMigratableDAO) Trash(ctx context.Context, id, author int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, author)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockMigratableDAOMockRecorder) Trash(ctx, id, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockMigratableDAO)(nil).Trash), ctx, id, author)
+}
+
+// UpdateById mocks base method.
+func (m *MockMigratableDAO) UpdateById(ctx context.Context, article article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateById", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateById indicates an expected call of UpdateById.
+func (mr *MockMigratableDAOMockRecorder) UpdateById(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateById", reflect.TypeOf((*MockMigratableDAO)(nil).UpdateById), ctx, article)
+}
+
+// UpdatePubStats mocks base method.
+func (m *MockMigratableDAO) UpdatePubStats(ctx context.Context, pub article.PublishedArticle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePubStats", ctx, pub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePubStats indicates an expected call of UpdatePubStats.
+func (mr *MockMigratableDAOMockRecorder) UpdatePubStats(ctx, pub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePubStats", reflect.TypeOf((*MockMigratableDAO)(nil).UpdatePubStats), ctx, pub)
+}
+
+// UpdateStats mocks base method.
+func (m *MockMigratableDAO) UpdateStats(ctx context.Context, art article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStats", ctx, art)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStats indicates an expected call of UpdateStats.
+func (mr *MockMigratableDAOMockRecorder) UpdateStats(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStats", reflect.TypeOf((*MockMigratableDAO)(nil).UpdateStats), ctx, art)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockMigratableDAO) UpdateStatus(ctx context.Context, id, author int64, status uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, author, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockMigratableDAOMockRecorder) UpdateStatus(ctx, id, author, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockMigratableDAO)(nil).UpdateStatus), ctx, id, author, status)
+}
+
+// Upsert mocks base method.
+func (m *MockMigratableDAO) Upsert(ctx context.Context, article article.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, article)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockMigratableDAOMockRecorder) Upsert(ctx, article any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockMigratableDAO)(nil).Upsert), ctx, article)
+}
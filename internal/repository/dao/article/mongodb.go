@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"github.com/bwmarrin/snowflake"
+	"github.com/ecodeclub/ekit/slice"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao/outbox"
 )
 
 type MongoDBDAO struct {
@@ -15,49 +18,231 @@ type MongoDBDAO struct {
 	col *mongo.Collection
 	// 线上库
 	liveCol *mongo.Collection
-	node    *snowflake.Node
-	idGen   IDGenerator
+	// 历史版本
+	revCol *mongo.Collection
+	// 标签的文章数
+	tagCol *mongo.Collection
+	// 发件箱，和文章的修改在同一个事务里面提交，所以 MongoDB 要部署成副本集
+	outboxCol *mongo.Collection
+	node      *snowflake.Node
+	idGen     IDGenerator
+	// sess 不为 nil 说明是 Transaction 里面的 DAO
+	sess mongo.Session
 }
 
 func (m *MongoDBDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]Article, error) {
-	//TODO implement me
-	panic("implement me")
+	pubs, err := m.listPub(ctx, bson.M{
+		"utime":  bson.M{"$lt": start.UnixMilli()},
+		"status": statusPublished,
+	}, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(pubs, func(idx int, src PublishedArticle) Article {
+		return Article(src)
+	}), nil
 }
 
 func (m *MongoDBDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
-	//TODO implement me
-	panic("implement me")
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "ctime", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	// 回收站里的文章只在回收站列表里面出现
+	cursor, err := m.col.Find(ctx, bson.M{
+		"author_id": uid,
+		"status":    bson.M{"$ne": statusTrashed},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	order := -1
+	if asc {
+		order = 1
+	}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "word_cnt", Value: order}, bson.E{Key: "id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.col.Find(ctx, bson.M{
+		"author_id": uid,
+		"status":    bson.M{"$ne": statusTrashed},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+// cursorSort 和 afterCursor 配合使用
+var cursorSort = bson.D{bson.E{Key: "utime", Value: -1}, bson.E{Key: "id", Value: -1}}
+
+func (m *MongoDBDAO) GetByAuthorCursor(ctx context.Context, uid int64, utime int64, id int64, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	filter := afterCursorFilter(bson.M{
+		"author_id": uid,
+		"status":    bson.M{"$ne": statusTrashed},
+	}, utime, id)
+	cursor, err := m.col.Find(ctx, filter,
+		options.Find().SetSort(cursorSort).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) ListPubCursor(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	filter := afterCursorFilter(bson.M{"status": statusPublished}, utime, id)
+	cursor, err := m.liveCol.Find(ctx, filter,
+		options.Find().SetSort(cursorSort).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func afterCursorFilter(filter bson.M, utime int64, id int64) bson.M {
+	if utime == 0 && id == 0 {
+		return filter
+	}
+	filter["$or"] = bson.A{
+		bson.M{"utime": bson.M{"$lt": utime}},
+		bson.M{"utime": utime, "id": bson.M{"$lt": id}},
+	}
+	return filter
 }
 
 func (m *MongoDBDAO) GetById(ctx context.Context, id int64) (Article, error) {
-	//TODO implement me
-	panic("implement me")
+	var res Article
+	err := m.col.FindOne(m.sessCtx(ctx), bson.M{"id": id}).Decode(&res)
+	return res, err
 }
 
 func (m *MongoDBDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
-	//TODO implement me
-	panic("implement me")
+	var res PublishedArticle
+	err := m.liveCol.FindOne(m.sessCtx(ctx), bson.M{"id": id}).Decode(&res)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return res, ErrRecordNotFound
+	}
+	return res, err
 }
 
 func (m *MongoDBDAO) UpdateById(ctx context.Context, art Article) error {
-	filter := bson.M{"id": art.Id, "author_id": art.AuthorId}
-	updates := bson.D{bson.E{"$set", bson.M{
-		"title":   art.Title,
-		"content": art.Content,
-		"status":  art.Status,
-		"utime":   time.Now().UnixMilli(),
+	ctx = m.sessCtx(ctx)
+	// 回收站里的文章要先恢复才能编辑
+	filter := bson.M{
+		"id":        art.Id,
+		"author_id": art.AuthorId,
+		"status":    bson.M{"$ne": statusTrashed},
+	}
+	updates := bson.D{bson.E{Key: "$set", Value: bson.M{
+		"title":        art.Title,
+		"content":      art.Content,
+		"status":       art.Status,
+		"category":     art.Category,
+		"tags":         art.Tags,
+		"word_cnt":     art.WordCnt,
+		"char_cnt":     art.CharCnt,
+		"image_cnt":    art.ImageCnt,
+		"reading_time": art.ReadingTime,
+		"abstract":     art.Abstract,
+		"utime":        time.Now().UnixMilli(),
 	}}}
 	res, err := m.col.UpdateOne(ctx, filter, updates)
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	// 别人的文章或者文章不存在
+	if res.MatchedCount == 0 {
 		return errors.New("update fail")
 	}
-	return nil
+	return m.insertRevision(ctx, art)
+}
+
+// insertRevision 为刚保存的文章生成一个新版本，并且让制作库指向它
+func (m *MongoDBDAO) insertRevision(ctx context.Context, art Article) error {
+	ctx = m.sessCtx(ctx)
+	var latest ArticleRevision
+	version := int64(1)
+	err := m.revCol.FindOne(ctx, bson.M{"article_id": art.Id},
+		options.FindOne().SetSort(bson.D{bson.E{Key: "version", Value: -1}})).
+		Decode(&latest)
+	switch {
+	case err == nil:
+		version = latest.Version + 1
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return err
+	}
+	rev := ArticleRevision{
+		Id:        m.node.Generate().Int64(),
+		ArticleId: art.Id,
+		Version:   version,
+		AuthorId:  art.AuthorId,
+		EditorId:  art.editorId(),
+		Title:     art.Title,
+		Content:   art.Content,
+		Ctime:     time.Now().UnixMilli(),
+	}
+	_, err = m.revCol.InsertOne(ctx, rev)
+	if err != nil {
+		return err
+	}
+	_, err = m.col.UpdateOne(ctx, bson.M{"id": art.Id},
+		bson.M{"$set": bson.M{"revision_id": rev.Id}})
+	return err
+}
+
+func (m *MongoDBDAO) GetRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error) {
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "version", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.revCol.Find(ctx, bson.M{"article_id": aid}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []ArticleRevision
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := m.revCol.FindOne(m.sessCtx(ctx), bson.M{"id": id}).Decode(&res)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return res, ErrRecordNotFound
+	}
+	return res, err
 }
 
+// Sync 先保存制作库，再覆盖线上库，和发件箱一起在一个事务里面提交
 func (m *MongoDBDAO) Sync(ctx context.Context, art Article) (int64, error) {
+	var id int64
+	err := m.withTx(ctx, func(tx *MongoDBDAO) error {
+		var err error
+		id, err = tx.sync(ctx, art)
+		return err
+	})
+	return id, err
+}
+
+func (m *MongoDBDAO) sync(ctx context.Context, art Article) (int64, error) {
 	// 第一步，保存到制作库
 	var (
 		id  = art.Id
@@ -71,17 +256,117 @@ func (m *MongoDBDAO) Sync(ctx context.Context, art Article) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
-	art.Id = id
+	// 重新读一次制作库，拿到这次保存产生的版本
+	var draft Article
+	err = m.col.FindOne(m.sessCtx(ctx), bson.M{"id": id}).Decode(&draft)
+	if err != nil {
+		return 0, err
+	}
 	// 操作线上库
+	err = m.Upsert(ctx, draft)
+	if err != nil {
+		return 0, err
+	}
+	pub := PublishedArticle(draft)
+	pub.Utime = time.Now().UnixMilli()
+	return id, m.addEvent(ctx, pub)
+}
+
+// Upsert 覆盖线上库，同时维护标签的文章数
+func (m *MongoDBDAO) Upsert(ctx context.Context, art Article) error {
+	ctx = m.sessCtx(ctx)
 	now := time.Now().UnixMilli()
+	art.Ctime = 0
 	art.Utime = now
 	update := bson.M{
 		"$set":         PublishedArticle(art),
 		"$setOnInsert": bson.M{"ctime": now},
 	}
 	filter := bson.M{"id": art.Id}
-	_, err = m.liveCol.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
-	return id, err
+	var old PublishedArticle
+	// 拿到更新前的标签，用来维护标签的文章数
+	err := m.liveCol.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetUpsert(true)).Decode(&old)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	if old.Status != statusPublished {
+		old.Tags = nil
+	}
+	tags := art.Tags
+	if art.Status != statusPublished {
+		tags = nil
+	}
+	return m.incrTags(ctx, old.Tags, tags, now)
+}
+
+// incrTags 按照线上文章标签的变化调整每个标签的文章数
+func (m *MongoDBDAO) incrTags(ctx context.Context, old, tags []string, now int64) error {
+	removed := slice.DiffSet(old, tags)
+	added := slice.DiffSet(tags, old)
+	if len(removed) > 0 {
+		_, err := m.tagCol.UpdateMany(ctx, bson.M{"name": bson.M{"$in": removed}},
+			bson.M{
+				"$inc": bson.M{"cnt": -1},
+				"$set": bson.M{"utime": now},
+			})
+		if err != nil {
+			return err
+		}
+	}
+	for _, tag := range added {
+		_, err := m.tagCol.UpdateOne(ctx, bson.M{"name": tag},
+			bson.M{
+				"$inc":         bson.M{"cnt": 1},
+				"$set":         bson.M{"utime": now},
+				"$setOnInsert": bson.M{"id": m.node.Generate().Int64(), "ctime": now},
+			}, options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MongoDBDAO) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error) {
+	return m.listPub(ctx, bson.M{"tags": tag, "status": statusPublished}, offset, limit)
+}
+
+func (m *MongoDBDAO) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error) {
+	return m.listPub(ctx, bson.M{"category": category, "status": statusPublished}, offset, limit)
+}
+
+func (m *MongoDBDAO) ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]PublishedArticle, error) {
+	return m.listPub(ctx, bson.M{"author_id": uid, "status": statusPublished}, offset, limit)
+}
+
+func (m *MongoDBDAO) listPub(ctx context.Context, filter bson.M, offset int, limit int) ([]PublishedArticle, error) {
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.liveCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []PublishedArticle
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) GetPopularTags(ctx context.Context, limit int) ([]Tag, error) {
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "cnt", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := m.tagCol.Find(ctx, bson.M{"cnt": bson.M{"$gt": 0}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Tag
+	err = cursor.All(ctx, &res)
+	return res, err
 }
 
 func InitCollection(db *mongo.Database) error {
@@ -94,45 +379,337 @@ func InitCollection(db *mongo.Database) error {
 		},
 		{
 			Keys: bson.D{bson.E{Key: "author_id", Value: 1},
-				bson.E{Key: "ctime", Value: 1},
+				bson.E{Key: "utime", Value: -1},
+				bson.E{Key: "id", Value: -1},
 			},
 			Options: options.Index(),
 		},
 	}
-	_, err := db.Collection("article").Indexes().CreateMany(ctx, index)
+	_, err := db.Collection("articles").Indexes().CreateMany(ctx, index)
+	if err != nil {
+		return err
+	}
+	// 作者列表按照字数排序
+	_, err = db.Collection("articles").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{bson.E{Key: "author_id", Value: 1},
+			bson.E{Key: "word_cnt", Value: -1},
+			bson.E{Key: "id", Value: -1},
+		},
+		Options: options.Index(),
+	})
 	if err != nil {
 		return err
 	}
 	_, err = db.Collection("live_articles").Indexes().CreateMany(ctx, index)
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("live_articles").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{bson.E{Key: "tags", Value: 1},
+				bson.E{Key: "utime", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{bson.E{Key: "category", Value: 1},
+				bson.E{Key: "utime", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("tags").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{bson.E{Key: "name", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{bson.E{Key: "cnt", Value: -1}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection(outbox.CollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{bson.E{Key: "id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("article_revisions").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{bson.E{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{bson.E{Key: "article_id", Value: 1},
+				bson.E{Key: "version", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
 	return err
 }
 
-func (m *MongoDBDAO) Upsert(ctx context.Context, article Article) error {
-	//TODO implement me
-	panic("implement me")
+// SyncStatus 同时修改制作库和线上库的状态，撤回的文章不再计入标签的文章数
+func (m *MongoDBDAO) SyncStatus(ctx context.Context, id int64, author int64, u uint8) error {
+	return m.withTx(ctx, func(tx *MongoDBDAO) error {
+		return tx.syncStatus(ctx, id, author, u)
+	})
 }
 
-func (m *MongoDBDAO) SyncStatus(ctx context.Context, id int64, author int64, u uint8) error {
-	//TODO implement me
-	panic("implement me")
+func (m *MongoDBDAO) syncStatus(ctx context.Context, id int64, author int64, u uint8) error {
+	ctx = m.sessCtx(ctx)
+	now := time.Now().UnixMilli()
+	res, err := m.col.UpdateOne(ctx, bson.M{
+		"id":        id,
+		"author_id": author,
+		"status":    bson.M{"$ne": statusTrashed},
+	}, bson.M{"$set": bson.M{
+		"status": u,
+		"utime":  now,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("update article status failed")
+	}
+	var old PublishedArticle
+	err = m.liveCol.FindOneAndUpdate(ctx, bson.M{"id": id},
+		bson.M{"$set": bson.M{
+			"status": u,
+			"utime":  now,
+		}}).Decode(&old)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// 从来没有发表过
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var oldTags, tags []string
+	if old.Status == statusPublished {
+		oldTags = old.Tags
+	}
+	if u == statusPublished {
+		tags = old.Tags
+	}
+	err = m.incrTags(ctx, oldTags, tags, now)
+	if err != nil {
+		return err
+	}
+	pub := old
+	pub.Status = u
+	pub.Utime = now
+	return m.addEvent(ctx, pub)
 }
 
+// Transaction 需要 MongoDB 部署成副本集，单机不支持事务
+// WithTransaction 遇到临时错误会整个重试 bizFunc，所以 bizFunc 要能重复执行
 func (m *MongoDBDAO) Transaction(ctx context.Context, bizFunc func(txDAO ArticleDAO) error) error {
-	//TODO implement me
-	panic("implement me")
+	sess, err := m.col.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+	_, err = sess.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		txDAO := *m
+		txDAO.sess = sess
+		return nil, bizFunc(&txDAO)
+	})
+	return err
+}
+
+// withTx 已经在 Transaction 里面就直接执行，不然开一个事务
+// 要写发件箱的操作都通过它执行，保证事件和文章的修改一起提交
+func (m *MongoDBDAO) withTx(ctx context.Context, fn func(tx *MongoDBDAO) error) error {
+	if m.sess != nil {
+		return fn(m)
+	}
+	return m.Transaction(ctx, func(txDAO ArticleDAO) error {
+		return fn(txDAO.(*MongoDBDAO))
+	})
+}
+
+// sessCtx 在 Transaction 里面把 session 带到每一个操作上
+func (m *MongoDBDAO) sessCtx(ctx context.Context) context.Context {
+	if m.sess == nil {
+		return ctx
+	}
+	return mongo.NewSessionContext(ctx, m.sess)
+}
+
+func (m *MongoDBDAO) UpdateStatus(ctx context.Context, id int64, author int64, status uint8) error {
+	res, err := m.col.UpdateOne(m.sessCtx(ctx), bson.M{"id": id, "author_id": author},
+		bson.M{"$set": bson.M{
+			"status": status,
+			"utime":  time.Now().UnixMilli(),
+		}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("update article status failed")
+	}
+	return nil
+}
+
+func (m *MongoDBDAO) GetByStatus(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.col.Find(ctx, bson.M{"author_id": uid, "status": status}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) Trash(ctx context.Context, id int64, author int64) error {
+	return m.withTx(ctx, func(tx *MongoDBDAO) error {
+		return tx.trash(ctx, id, author)
+	})
+}
+
+func (m *MongoDBDAO) trash(ctx context.Context, id int64, author int64) error {
+	ctx = m.sessCtx(ctx)
+	now := time.Now().UnixMilli()
+	res, err := m.col.UpdateOne(ctx, bson.M{
+		"id":        id,
+		"author_id": author,
+		"status":    bson.M{"$ne": statusTrashed},
+	}, bson.M{"$set": bson.M{
+		"status": statusTrashed,
+		"dtime":  now,
+		"utime":  now,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("trash article failed")
+	}
+	err = m.deleteLive(ctx, id, now)
+	if err != nil {
+		return err
+	}
+	return m.addWithdrawnEvent(ctx, id, author, now)
+}
+
+func (m *MongoDBDAO) addEvent(ctx context.Context, pub PublishedArticle) error {
+	msg, err := eventMessage(pub)
+	if err != nil {
+		return err
+	}
+	return m.insertMessage(ctx, msg)
+}
+
+func (m *MongoDBDAO) addWithdrawnEvent(ctx context.Context, id int64, author int64, utime int64) error {
+	msg, err := withdrawnMessage(id, author, utime)
+	if err != nil {
+		return err
+	}
+	return m.insertMessage(ctx, msg)
+}
+
+// insertMessage 发件箱的 id 也用 snowflake 生成，按照 id 排序就是写入顺序
+func (m *MongoDBDAO) insertMessage(ctx context.Context, msg outbox.Message) error {
+	msg.Id = m.node.Generate().Int64()
+	_, err := m.outboxCol.InsertOne(m.sessCtx(ctx), msg)
+	return err
+}
+
+func (m *MongoDBDAO) Restore(ctx context.Context, id int64, author int64) error {
+	res, err := m.col.UpdateOne(m.sessCtx(ctx), bson.M{
+		"id":        id,
+		"author_id": author,
+		"status":    statusTrashed,
+	}, bson.M{
+		"$set": bson.M{
+			"status": uint8(domain.ArticleStatusUnpublished),
+			"utime":  time.Now().UnixMilli(),
+		},
+		"$unset": bson.M{"dtime": ""},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("restore article failed")
+	}
+	return nil
+}
+
+func (m *MongoDBDAO) ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "dtime", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := m.col.Find(ctx, bson.M{
+		"status": statusTrashed,
+		"dtime":  bson.M{"$lt": dtime},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+// Purge 只删除还在回收站里的文章，已经恢复了的返回 ErrRecordNotFound
+func (m *MongoDBDAO) Purge(ctx context.Context, id int64) error {
+	ctx = m.sessCtx(ctx)
+	res, err := m.col.DeleteOne(ctx, bson.M{"id": id, "status": statusTrashed})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrRecordNotFound
+	}
+	_, err = m.revCol.DeleteMany(ctx, bson.M{"article_id": id})
+	if err != nil {
+		return err
+	}
+	// 移到回收站的时候已经删过线上库了，这里兜底
+	return m.deleteLive(ctx, id, time.Now().UnixMilli())
+}
+
+// deleteLive 从线上库删掉文章，已发表的文章要减掉标签的文章数
+func (m *MongoDBDAO) deleteLive(ctx context.Context, id int64, now int64) error {
+	var old PublishedArticle
+	err := m.liveCol.FindOneAndDelete(ctx, bson.M{"id": id}).Decode(&old)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil || old.Status != statusPublished {
+		return err
+	}
+	return m.incrTags(ctx, old.Tags, nil, now)
 }
 
 type IDGenerator int64
 
 func NewMongoDBDAO(db *mongo.Database, node *snowflake.Node) ArticleDAO {
 	return &MongoDBDAO{
-		col:     db.Collection("articles"),
-		liveCol: db.Collection("live_articles"),
-		node:    node,
+		col:       db.Collection("articles"),
+		liveCol:   db.Collection("live_articles"),
+		revCol:    db.Collection("article_revisions"),
+		tagCol:    db.Collection("tags"),
+		outboxCol: db.Collection(outbox.CollectionName),
+		node:      node,
 	}
 }
 
 func (m *MongoDBDAO) Insert(ctx context.Context, art Article) (int64, error) {
+	ctx = m.sessCtx(ctx)
 	now := time.Now().UnixMilli()
 	art.Ctime = now
 	art.Utime = now
@@ -140,5 +717,151 @@ func (m *MongoDBDAO) Insert(ctx context.Context, art Article) (int64, error) {
 	id := m.node.Generate().Int64()
 	art.Id = id
 	_, err := m.col.InsertOne(ctx, art)
-	return id, err
+	if err != nil {
+		return 0, err
+	}
+	return id, m.insertRevision(ctx, art)
+}
+
+func (m *MongoDBDAO) UpdateStats(ctx context.Context, art Article) error {
+	_, err := m.col.UpdateOne(m.sessCtx(ctx), bson.M{"id": art.Id},
+		bson.M{"$set": statsFields(art)})
+	return err
+}
+
+func (m *MongoDBDAO) UpdatePubStats(ctx context.Context, pub PublishedArticle) error {
+	_, err := m.liveCol.UpdateOne(m.sessCtx(ctx), bson.M{"id": pub.Id},
+		bson.M{"$set": statsFields(Article(pub))})
+	return err
+}
+
+func statsFields(art Article) bson.M {
+	return bson.M{
+		"word_cnt":     art.WordCnt,
+		"char_cnt":     art.CharCnt,
+		"image_cnt":    art.ImageCnt,
+		"reading_time": art.ReadingTime,
+		"abstract":     art.Abstract,
+	}
+}
+
+func (m *MongoDBDAO) ScanById(ctx context.Context, startId int64, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	cursor, err := m.col.Find(ctx, bson.M{"id": bson.M{"$gt": startId}},
+		options.Find().
+			SetSort(bson.D{bson.E{Key: "id", Value: 1}}).
+			SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) ScanByUtime(ctx context.Context, utime int64, id int64, limit int) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	filter := bson.M{"$or": bson.A{
+		bson.M{"utime": bson.M{"$gt": utime}},
+		bson.M{"utime": utime, "id": bson.M{"$gt": id}},
+	}}
+	cursor, err := m.col.Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{bson.E{Key: "utime", Value: 1}, bson.E{Key: "id", Value: 1}}).
+			SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) FindByIds(ctx context.Context, ids []int64) ([]Article, error) {
+	ctx = m.sessCtx(ctx)
+	cursor, err := m.col.Find(ctx, bson.M{"id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) Snapshot(ctx context.Context, id int64) (Snapshot, error) {
+	ctx = m.sessCtx(ctx)
+	s := Snapshot{Id: id}
+	var art Article
+	err := m.col.FindOne(ctx, bson.M{"id": id}).Decode(&art)
+	switch {
+	case err == nil:
+		s.Article = &art
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return Snapshot{}, err
+	}
+	var pub PublishedArticle
+	err = m.liveCol.FindOne(ctx, bson.M{"id": id}).Decode(&pub)
+	switch {
+	case err == nil:
+		s.Pub = &pub
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return Snapshot{}, err
+	}
+	cursor, err := m.revCol.Find(ctx, bson.M{"article_id": id},
+		options.Find().SetSort(bson.D{bson.E{Key: "version", Value: 1}}))
+	if err != nil {
+		return Snapshot{}, err
+	}
+	err = cursor.All(ctx, &s.Revisions)
+	return s, err
+}
+
+// Overwrite 不在事务里面的话，中途失败会留下一部分，下一次校验还会再修复
+func (m *MongoDBDAO) Overwrite(ctx context.Context, s Snapshot) error {
+	ctx = m.sessCtx(ctx)
+	now := time.Now().UnixMilli()
+	filter := bson.M{"id": s.Id}
+	var err error
+	if s.Article == nil {
+		_, err = m.col.DeleteOne(ctx, filter)
+	} else {
+		_, err = m.col.ReplaceOne(ctx, filter, s.Article, options.Replace().SetUpsert(true))
+	}
+	if err != nil {
+		return err
+	}
+	if s.Pub == nil {
+		err = m.deleteLive(ctx, s.Id, now)
+	} else {
+		err = m.replaceLive(ctx, *s.Pub, now)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = m.revCol.DeleteMany(ctx, bson.M{"article_id": s.Id})
+	if err != nil || len(s.Revisions) == 0 {
+		return err
+	}
+	_, err = m.revCol.InsertMany(ctx, slice.Map(s.Revisions, func(idx int, src ArticleRevision) any {
+		return src
+	}))
+	return err
+}
+
+// replaceLive 整体替换线上库，标签的文章数按照替换前后的状态调整
+func (m *MongoDBDAO) replaceLive(ctx context.Context, pub PublishedArticle, now int64) error {
+	var old PublishedArticle
+	err := m.liveCol.FindOneAndReplace(ctx, bson.M{"id": pub.Id}, pub,
+		options.FindOneAndReplace().SetUpsert(true)).Decode(&old)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	var oldTags, tags []string
+	if old.Status == statusPublished {
+		oldTags = old.Tags
+	}
+	if pub.Status == statusPublished {
+		tags = pub.Tags
+	}
+	return m.incrTags(ctx, oldTags, tags, now)
 }
@@ -1,83 +0,0 @@
-package article
-
-import (
-	"bytes"
-	"context"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/ecodeclub/ekit"
-	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
-	"strconv"
-	"time"
-	"we_book/internal/domain"
-)
-
-var statusPrivate = uint(domain.ArticleStatusPrivate)
-
-type S3DAO struct {
-	oss *s3.S3
-
-	GORMArticleDAO
-	bucket *string
-}
-
-func NewOssDAO(oss *s3.S3, db *gorm.DB) *S3DAO {
-	return &S3DAO{
-		oss:    oss,
-		bucket: ekit.ToPtr[string]("webook-1314583317"),
-		GORMArticleDAO: GORMArticleDAO{
-			db: db,
-		},
-	}
-}
-
-func (o *S3DAO) Sync(ctx context.Context, art Article) (int64, error) {
-	var (
-		id = art.Id
-	)
-	err := o.db.Transaction(func(tx *gorm.DB) error {
-		var err error
-		now := time.Now().UnixMilli()
-		// 制作库
-		txDAO := NewGORMArticleDAO(tx)
-		if id == 0 {
-			id, err = txDAO.Insert(ctx, art)
-		} else {
-			err = txDAO.UpdateById(ctx, art)
-		}
-		if err != nil {
-			return err
-		}
-		art.Id = id
-		published := PublishedArticle{
-			Id:       art.Id,
-			Title:    art.Title,
-			AuthorId: art.AuthorId,
-			Status:   art.Status,
-			Ctime:    now,
-			Utime:    now,
-		}
-		return tx.Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"title":  art.Title,
-				"utime":  now,
-				"status": art.Status,
-			}),
-		}).Create(&published).Error
-	})
-	if err != nil {
-		return 0, err
-	}
-	_, err = o.oss.PutObjectWithContext(ctx, &s3.PutObjectInput{
-		Bucket:      o.bucket,
-		Key:         ekit.ToPtr[string](strconv.FormatInt(art.Id, 10)),
-		Body:        bytes.NewReader([]byte(art.Content)),
-		ContentType: ekit.ToPtr[string]("text/plain;charset=utf-8"),
-	})
-	return id, err
-}
-
-func (o *S3DAO) SyncStatus(ctx context.Context, art Article) (int64, error) {
-	panic("implement me")
-}
@@ -2,9 +2,13 @@ package article
 
 import (
 	"context"
+	"gorm.io/gorm"
 	"time"
 )
 
+// ErrRecordNotFound 不管用哪种存储，查不到都返回这个
+var ErrRecordNotFound = gorm.ErrRecordNotFound
+
 type ArticleDAO interface {
 	Insert(ctx context.Context, article Article) (int64, error)
 	UpdateById(ctx context.Context, article Article) error
@@ -12,8 +16,42 @@ type ArticleDAO interface {
 	Upsert(ctx context.Context, article Article) error
 	SyncStatus(ctx context.Context, id int64, author int64, u uint8) error
 	Transaction(ctx context.Context, bizFunc func(txDAO ArticleDAO) error) error
+	// GetByAuthor 按照创建时间倒序，和 GetByAuthorCursor 的顺序不一样
 	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error)
+	// GetByAuthorCursor 返回 (utime, id) 排在游标后面的文章，utime 和 id 都为 0 就是第一页
+	GetByAuthorCursor(ctx context.Context, uid int64, utime int64, id int64, limit int) ([]Article, error)
+	// GetByAuthorOrderByLength 按照字数排序，字数相同的按照 id 倒序
+	GetByAuthorOrderByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]Article, error)
 	GetById(ctx context.Context, id int64) (Article, error)
 	GetPubById(ctx context.Context, id int64) (PublishedArticle, error)
 	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]Article, error)
+	// ListPubCursor 和 GetByAuthorCursor 一样，只返回已发表的文章
+	ListPubCursor(ctx context.Context, utime int64, id int64, limit int) ([]Article, error)
+	// GetRevisions 按照版本号倒序返回文章的历史版本
+	GetRevisions(ctx context.Context, aid int64, offset int, limit int) ([]ArticleRevision, error)
+	GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error)
+	// UpdateStatus 只修改制作库的状态，不产生新版本
+	UpdateStatus(ctx context.Context, id int64, author int64, status uint8) error
+	GetByStatus(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]Article, error)
+	// ListPubByTag 按照更新时间倒序返回带有该标签的已发表文章
+	ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]PublishedArticle, error)
+	ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]PublishedArticle, error)
+	// ListPubByAuthor 按照更新时间倒序返回作者已发表的文章
+	ListPubByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]PublishedArticle, error)
+	// GetPopularTags 按照已发表文章数倒序返回标签
+	GetPopularTags(ctx context.Context, limit int) ([]Tag, error)
+	// Trash 把文章移到回收站，同时从线上库删掉
+	Trash(ctx context.Context, id int64, author int64) error
+	// Restore 把回收站里的文章恢复成未发表
+	Restore(ctx context.Context, id int64, author int64) error
+	// ListTrashedBefore 返回 dtime 早于指定时间的回收站文章
+	ListTrashedBefore(ctx context.Context, dtime int64, limit int) ([]Article, error)
+	// Purge 彻底删除文章，包括历史版本和标签
+	Purge(ctx context.Context, id int64) error
+	// ScanById 按照 id 升序返回 id 大于 startId 的文章，包括回收站里面的，不带标签
+	ScanById(ctx context.Context, startId int64, limit int) ([]Article, error)
+	// UpdateStats 只修改制作库的字数统计和摘要，不修改 utime，也不产生新版本
+	UpdateStats(ctx context.Context, art Article) error
+	// UpdatePubStats 和 UpdateStats 一样，修改的是线上库
+	UpdatePubStats(ctx context.Context, pub PublishedArticle) error
 }
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var ErrCollaboratorNotFound = gorm.ErrRecordNotFound
+
+type CollaboratorDAO interface {
+	// Upsert 邀请，已经邀请过的话重新变成等待中，角色以这次为准
+	Upsert(ctx context.Context, c ArticleCollaborator) error
+	Get(ctx context.Context, aid, uid int64) (ArticleCollaborator, error)
+	// UpdateStatus 只有状态是 from 的才会修改，没有修改返回 ErrCollaboratorNotFound
+	UpdateStatus(ctx context.Context, aid, uid int64, from, to uint8) error
+	Delete(ctx context.Context, aid, uid int64) error
+	// DeleteByArticle 删掉文章所有的协作者，彻底删除文章的时候用
+	DeleteByArticle(ctx context.Context, aid int64) error
+	// ListByArticle 按照邀请时间返回文章所有的协作者
+	ListByArticle(ctx context.Context, aid int64) ([]ArticleCollaborator, error)
+	// ListByUser 按照邀请时间倒序返回用户收到的邀请
+	ListByUser(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]ArticleCollaborator, error)
+}
+
+type GORMCollaboratorDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollaboratorDAO(db *gorm.DB) CollaboratorDAO {
+	return &GORMCollaboratorDAO{db: db}
+}
+
+func (g *GORMCollaboratorDAO) Upsert(ctx context.Context, c ArticleCollaborator) error {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"role":    c.Role,
+			"status":  c.Status,
+			"inviter": c.Inviter,
+			"ctime":   now,
+			"utime":   now,
+		}),
+	}).Create(&c).Error
+}
+
+func (g *GORMCollaboratorDAO) Get(ctx context.Context, aid, uid int64) (ArticleCollaborator, error) {
+	var res ArticleCollaborator
+	err := g.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", aid, uid).
+		First(&res).Error
+	return res, err
+}
+
+func (g *GORMCollaboratorDAO) UpdateStatus(ctx context.Context, aid, uid int64, from, to uint8) error {
+	res := g.db.WithContext(ctx).Model(&ArticleCollaborator{}).
+		Where("article_id = ? AND uid = ? AND status = ?", aid, uid, from).
+		Updates(map[string]any{
+			"status": to,
+			"utime":  time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+func (g *GORMCollaboratorDAO) Delete(ctx context.Context, aid, uid int64) error {
+	return g.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", aid, uid).
+		Delete(&ArticleCollaborator{}).Error
+}
+
+func (g *GORMCollaboratorDAO) DeleteByArticle(ctx context.Context, aid int64) error {
+	return g.db.WithContext(ctx).
+		Where("article_id = ?", aid).
+		Delete(&ArticleCollaborator{}).Error
+}
+
+func (g *GORMCollaboratorDAO) ListByArticle(ctx context.Context, aid int64) ([]ArticleCollaborator, error) {
+	var res []ArticleCollaborator
+	err := g.db.WithContext(ctx).
+		Where("article_id = ?", aid).
+		Order("ctime").
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMCollaboratorDAO) ListByUser(ctx context.Context, uid int64, status uint8, offset int, limit int) ([]ArticleCollaborator, error) {
+	var res []ArticleCollaborator
+	err := g.db.WithContext(ctx).
+		Where("uid = ? AND status = ?", uid, status).
+		Order("ctime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// ArticleCollaborator 文章的协作者，所有者不在这张表里面，以文章的 author_id 为准
+type ArticleCollaborator struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:aid_uid"`
+	Uid       int64 `gorm:"uniqueIndex:aid_uid;index:uid_status,priority:1"`
+	Role      uint8
+	Status    uint8 `gorm:"index:uid_status,priority:2"`
+	Inviter   int64
+	Ctime     int64
+	Utime     int64
+}
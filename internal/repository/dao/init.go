@@ -3,8 +3,27 @@ package dao
 import (
 	"gorm.io/gorm"
 	"we_book/internal/repository/dao/article"
+	"we_book/internal/repository/dao/outbox"
 )
 
 func InitTable(db *gorm.DB) error {
-	return db.AutoMigrate(&User{}, &article.Article{}, &article.PublishArticleDAO{})
+	return db.AutoMigrate(&User{},
+		&article.Article{},
+		&article.PublishArticleDAO{},
+		&article.PublishedArticle{},
+		&article.ArticleRevision{},
+		&article.ArticleTag{},
+		&article.PublishedArticleTag{},
+		&article.Tag{},
+		&Job{},
+		&TransferTask{},
+		&ArticleCollaborator{},
+		&Series{},
+		&SeriesArticle{},
+		&ArticleReview{},
+		&Upload{},
+		&UploadRef{},
+		&outbox.Message{},
+		&MigratorPattern{},
+	)
 }
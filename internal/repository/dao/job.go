@@ -2,16 +2,30 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
+// ErrJobNotWaiting 任务已经开始执行或者不存在
+var ErrJobNotWaiting = errors.New("job is not waiting")
+
 type JobDAO interface {
 	Preempt(ctx context.Context) (Job, error)
 	UpdateUtime(ctx context.Context, id int64) error
 	Release(ctx context.Context, id int64) error
 	Stop(ctx context.Context, id int64) error
 	UpdateNextUtime(ctx context.Context, id int64, next time.Time) error
+	// Retry 记下失败次数，next 之后再执行
+	Retry(ctx context.Context, id int64, attempts int, next time.Time) error
+	// Upsert 按照 name 插入或者覆盖任务，覆盖之后任务重新进入等待状态
+	Upsert(ctx context.Context, j Job) error
+	GetByNames(ctx context.Context, names []string) ([]Job, error)
+	// RescheduleWaiting 只修改还在等待中的任务
+	RescheduleWaiting(ctx context.Context, name string, next time.Time) error
+	// DeleteWaiting 只删除还在等待中的任务
+	DeleteWaiting(ctx context.Context, name string) error
 }
 
 type GORMJobDAO struct {
@@ -21,9 +35,10 @@ type GORMJobDAO struct {
 func (G *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
 	db := G.db.WithContext(ctx)
 	for {
-		now := time.Now()
+		now := time.Now().UnixMilli()
 		var j Job
-		err := db.WithContext(ctx).Where("status = ? AND next_time <= ?", jobStatusWaiting, now).Error
+		err := db.Where("status = ? AND next_time <= ?", jobStatusWaiting, now).
+			First(&j).Error
 		if err != nil {
 			return Job{}, err
 		}
@@ -35,7 +50,7 @@ func (G *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
 				"version": j.Version + 1,
 			})
 		if res.Error != nil {
-			return Job{}, err
+			return Job{}, res.Error
 		}
 		if res.RowsAffected == 0 {
 			continue
@@ -45,44 +60,111 @@ func (G *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
 }
 
 func (G *GORMJobDAO) UpdateUtime(ctx context.Context, id int64) error {
-	return G.db.WithContext(ctx).Where("id = ?", id).Updates(map[string]any{
+	return G.db.WithContext(ctx).Model(&Job{}).Where("id = ?", id).Updates(map[string]any{
 		"utime": time.Now().UnixMilli(),
 	}).Error
 
 }
 
 func (G *GORMJobDAO) Release(ctx context.Context, id int64) error {
-	return G.db.WithContext(ctx).Where("id = ?", id).Updates(map[string]any{
-		"status": jobStatusWaiting,
-		"utime":  time.Now().UnixMilli(),
-	}).Error
+	return G.db.WithContext(ctx).Model(&Job{}).
+		Where("id = ? AND status = ?", id, jobStatusRunning).
+		Updates(map[string]any{
+			"status": jobStatusWaiting,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
 }
 
 func (G *GORMJobDAO) Stop(ctx context.Context, id int64) error {
-	return G.db.WithContext(ctx).Where("id = ?", id).Updates(map[string]any{
+	return G.db.WithContext(ctx).Model(&Job{}).Where("id = ?", id).Updates(map[string]any{
 		"status": jobStatusPaused,
 		"utime":  time.Now().UnixMilli(),
 	}).Error
 }
 
 func (G *GORMJobDAO) UpdateNextUtime(ctx context.Context, id int64, next time.Time) error {
-	return G.db.WithContext(ctx).Where("id = ?", id).Updates(map[string]any{
+	return G.db.WithContext(ctx).Model(&Job{}).Where("id = ?", id).Updates(map[string]any{
 		"next_time": next.UnixMilli(),
 	}).Error
 }
 
-//func NewGORMJobDAO(db *gorm.DB) JobDAO {
-//	return &*GORMJobDAO{db: db}
-//}
+func (G *GORMJobDAO) Retry(ctx context.Context, id int64, attempts int, next time.Time) error {
+	return G.db.WithContext(ctx).Model(&Job{}).Where("id = ?", id).Updates(map[string]any{
+		"attempts":  attempts,
+		"next_time": next.UnixMilli(),
+		"utime":     time.Now().UnixMilli(),
+	}).Error
+}
+
+func (G *GORMJobDAO) Upsert(ctx context.Context, j Job) error {
+	now := time.Now().UnixMilli()
+	j.Status = jobStatusWaiting
+	j.Ctime = now
+	j.Utime = now
+	return G.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"cfg":       j.Cfg,
+			"executor":  j.Executor,
+			"cron":      j.Cron,
+			"next_time": j.NextTime,
+			"status":    jobStatusWaiting,
+			"attempts":  0,
+			"version":   gorm.Expr("version + 1"),
+			"utime":     now,
+		}),
+	}).Create(&j).Error
+}
+
+func (G *GORMJobDAO) GetByNames(ctx context.Context, names []string) ([]Job, error) {
+	var res []Job
+	err := G.db.WithContext(ctx).Where("name IN ?", names).Find(&res).Error
+	return res, err
+}
+
+func (G *GORMJobDAO) RescheduleWaiting(ctx context.Context, name string, next time.Time) error {
+	res := G.db.WithContext(ctx).Model(&Job{}).
+		Where("name = ? AND status = ?", name, jobStatusWaiting).
+		Updates(map[string]any{
+			"next_time": next.UnixMilli(),
+			"version":   gorm.Expr("version + 1"),
+			"utime":     time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrJobNotWaiting
+	}
+	return nil
+}
+
+func (G *GORMJobDAO) DeleteWaiting(ctx context.Context, name string) error {
+	res := G.db.WithContext(ctx).
+		Where("name = ? AND status = ?", name, jobStatusWaiting).
+		Delete(&Job{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrJobNotWaiting
+	}
+	return nil
+}
+
+func NewGORMJobDAO(db *gorm.DB) JobDAO {
+	return &GORMJobDAO{db: db}
+}
 
 type Job struct {
 	Id       int64 `gorm:"primary_key,autoIncrement"`
 	Cfg      string
 	Executor string
-	Name     string `gorm:"unique"`
+	Name     string `gorm:"unique;type:varchar(256)"`
 	Status   int
 	NextTime int64 `gorm:"index"`
 	Cron     string
+	// Attempts 连续执行失败的次数
+	Attempts int
 	Version  int
 	Ctime    int64
 	Utime    int64
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// MigratorPatternDAO 保存迁移的双写阶段，所有实例都以这里为准
+type MigratorPatternDAO interface {
+	// Get 还没有设置过的时候返回空字符串
+	Get(ctx context.Context, name string) (string, error)
+	Set(ctx context.Context, name string, pattern string) error
+}
+
+type GORMMigratorPatternDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMMigratorPatternDAO(db *gorm.DB) MigratorPatternDAO {
+	return &GORMMigratorPatternDAO{db: db}
+}
+
+func (g *GORMMigratorPatternDAO) Get(ctx context.Context, name string) (string, error) {
+	var res MigratorPattern
+	err := g.db.WithContext(ctx).Where("name = ?", name).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	return res.Pattern, err
+}
+
+func (g *GORMMigratorPatternDAO) Set(ctx context.Context, name string, pattern string) error {
+	now := time.Now().UnixMilli()
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"pattern": pattern,
+			"utime":   now,
+		}),
+	}).Create(&MigratorPattern{
+		Name:    name,
+		Pattern: pattern,
+		Ctime:   now,
+		Utime:   now,
+	}).Error
+}
+
+// MigratorPattern 一次迁移一行，Name 是迁移的名字，比如 article
+type MigratorPattern struct {
+	Id      int64  `gorm:"primaryKey,autoIncrement"`
+	Name    string `gorm:"type:varchar(64);uniqueIndex"`
+	Pattern string `gorm:"type:varchar(32)"`
+	Ctime   int64
+	Utime   int64
+}
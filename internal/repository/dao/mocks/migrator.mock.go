@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/dao/migrator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/dao/migrator.go -package=svcmocks -destination=internal/repository/dao/mocks/migrator.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMigratorPatternDAO is a mock of MigratorPatternDAO interface.
+type MockMigratorPatternDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigratorPatternDAOMockRecorder
+}
+
+// MockMigratorPatternDAOMockRecorder is the mock recorder for MockMigratorPatternDAO.
+type MockMigratorPatternDAOMockRecorder struct {
+	mock *MockMigratorPatternDAO
+}
+
+// NewMockMigratorPatternDAO creates a new mock instance.
+func NewMockMigratorPatternDAO(ctrl *gomock.Controller) *MockMigratorPatternDAO {
+	mock := &MockMigratorPatternDAO{ctrl: ctrl}
+	mock.recorder = &MockMigratorPatternDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigratorPatternDAO) EXPECT() *MockMigratorPatternDAOMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockMigratorPatternDAO) Get(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMigratorPatternDAOMockRecorder) Get(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMigratorPatternDAO)(nil).Get), ctx, name)
+}
+
+// Set mocks base method.
+func (m *MockMigratorPatternDAO) Set(ctx context.Context, name, pattern string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, name, pattern)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockMigratorPatternDAOMockRecorder) Set(ctx, name, pattern any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockMigratorPatternDAO)(nil).Set), ctx, name, pattern)
+}
@@ -0,0 +1,51 @@
+package outbox
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type GORMOutboxDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMOutboxDAO(db *gorm.DB) OutboxDAO {
+	return &GORMOutboxDAO{db: db}
+}
+
+func (g *GORMOutboxDAO) ListPending(ctx context.Context, limit int) ([]Message, error) {
+	var res []Message
+	now := time.Now().UnixMilli()
+	// 同一个 Key 前面到了投递时间的消息排在同一批的前面，只需要排除前面还在退避的
+	err := g.db.WithContext(ctx).
+		Where("status = ? AND next_time <= ?", StatusPending, now).
+		Where("NOT EXISTS (?)", g.db.Table(CollectionName+" AS prev").Select("1").
+			Where("prev.`key` = outbox_messages.`key` AND prev.id < outbox_messages.id").
+			Where("prev.status = ? AND prev.next_time > ?", StatusPending, now)).
+		Order("id").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (g *GORMOutboxDAO) Delete(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return g.db.WithContext(ctx).Where("id IN ?", ids).Delete(&Message{}).Error
+}
+
+func (g *GORMOutboxDAO) Retry(ctx context.Context, id int64, attempts int, nextTime int64) error {
+	return g.db.WithContext(ctx).Model(&Message{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":  attempts,
+			"next_time": nextTime,
+		}).Error
+}
+
+func (g *GORMOutboxDAO) Dead(ctx context.Context, id int64, attempts int) error {
+	return g.db.WithContext(ctx).Model(&Message{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts": attempts,
+			"status":   StatusDead,
+		}).Error
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/dao/outbox/types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/dao/outbox/types.go -package=outboxmocks -destination=internal/repository/dao/outbox/mocks/outbox.mock.go
+//
+
+// Package outboxmocks is a generated GoMock package.
+package outboxmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	outbox "we_book/internal/repository/dao/outbox"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxDAO is a mock of OutboxDAO interface.
+type MockOutboxDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxDAOMockRecorder
+}
+
+// MockOutboxDAOMockRecorder is the mock recorder for MockOutboxDAO.
+type MockOutboxDAOMockRecorder struct {
+	mock *MockOutboxDAO
+}
+
+// NewMockOutboxDAO creates a new mock instance.
+func NewMockOutboxDAO(ctrl *gomock.Controller) *MockOutboxDAO {
+	mock := &MockOutboxDAO{ctrl: ctrl}
+	mock.recorder = &MockOutboxDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxDAO) EXPECT() *MockOutboxDAOMockRecorder {
+	return m.recorder
+}
+
+// Dead mocks base method.
+func (m *MockOutboxDAO) Dead(ctx context.Context, id int64, attempts int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dead", ctx, id, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Dead indicates an expected call of Dead.
+func (mr *MockOutboxDAOMockRecorder) Dead(ctx, id, attempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dead", reflect.TypeOf((*MockOutboxDAO)(nil).Dead), ctx, id, attempts)
+}
+
+// Delete mocks base method.
+func (m *MockOutboxDAO) Delete(ctx context.Context, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOutboxDAOMockRecorder) Delete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOutboxDAO)(nil).Delete), ctx, ids)
+}
+
+// ListPending mocks base method.
+func (m *MockOutboxDAO) ListPending(ctx context.Context, limit int) ([]outbox.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, limit)
+	ret0, _ := ret[0].([]outbox.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockOutboxDAOMockRecorder) ListPending(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockOutboxDAO)(nil).ListPending), ctx, limit)
+}
+
+// Retry mocks base method.
+func (m *MockOutboxDAO) Retry(ctx context.Context, id int64, attempts int, nextTime int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, id, attempts, nextTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockOutboxDAOMockRecorder) Retry(ctx, id, attempts, nextTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockOutboxDAO)(nil).Retry), ctx, id, attempts, nextTime)
+}
@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// MongoDBOutboxDAO id 是 snowflake 生成的，按照 id 排序就是写入顺序
+type MongoDBOutboxDAO struct {
+	col *mongo.Collection
+}
+
+func NewMongoDBOutboxDAO(db *mongo.Database) OutboxDAO {
+	return &MongoDBOutboxDAO{col: db.Collection(CollectionName)}
+}
+
+// ListPending 还在退避的消息也要查出来，用来挡住同一个 Key 后面的消息
+func (m *MongoDBOutboxDAO) ListPending(ctx context.Context, limit int) ([]Message, error) {
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "id", Value: 1}})
+	// 没有失败过的消息没有 status 这个字段
+	cursor, err := m.col.Find(ctx, bson.M{
+		"status": bson.M{"$ne": StatusDead},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	now := time.Now().UnixMilli()
+	blocked := make(map[string]struct{})
+	res := make([]Message, 0, limit)
+	for len(res) < limit && cursor.Next(ctx) {
+		var msg Message
+		err = cursor.Decode(&msg)
+		if err != nil {
+			return nil, err
+		}
+		res = appendPending(res, blocked, msg, now)
+	}
+	return res, cursor.Err()
+}
+
+// appendPending 到了投递时间并且同一个 Key 前面没有消息在退避的才放进 res
+func appendPending(res []Message, blocked map[string]struct{}, msg Message, now int64) []Message {
+	if _, ok := blocked[msg.Key]; ok {
+		return res
+	}
+	if msg.NextTime > now {
+		blocked[msg.Key] = struct{}{}
+		return res
+	}
+	return append(res, msg)
+}
+
+func (m *MongoDBOutboxDAO) Delete(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := m.col.DeleteMany(ctx, bson.M{"id": bson.M{"$in": ids}})
+	return err
+}
+
+func (m *MongoDBOutboxDAO) Retry(ctx context.Context, id int64, attempts int, nextTime int64) error {
+	_, err := m.col.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{
+		"attempts":  attempts,
+		"next_time": nextTime,
+	}})
+	return err
+}
+
+func (m *MongoDBOutboxDAO) Dead(ctx context.Context, id int64, attempts int) error {
+	_, err := m.col.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{
+		"attempts": attempts,
+		"status":   StatusDead,
+	}})
+	return err
+}
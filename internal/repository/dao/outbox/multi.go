@@ -0,0 +1,55 @@
+package outbox
+
+import "context"
+
+// MultiOutboxDAO 迁移文章的时候两个库都会写发件箱，依次投递
+// 前一个投递完了才投递后一个，切换双写阶段的时候同一篇文章的事件可能乱序，
+// 消费者按照事件里面的 Utime 去重
+type MultiOutboxDAO struct {
+	daos []OutboxDAO
+}
+
+func NewMultiOutboxDAO(daos ...OutboxDAO) OutboxDAO {
+	return &MultiOutboxDAO{daos: daos}
+}
+
+func (m *MultiOutboxDAO) ListPending(ctx context.Context, limit int) ([]Message, error) {
+	for _, dao := range m.daos {
+		msgs, err := dao.ListPending(ctx, limit)
+		if err != nil || len(msgs) > 0 {
+			return msgs, err
+		}
+	}
+	return nil, nil
+}
+
+// Delete MySQL 的自增 ID 和 MongoDB 的 snowflake ID 不会重复，直接两边都删
+func (m *MultiOutboxDAO) Delete(ctx context.Context, ids []int64) error {
+	for _, dao := range m.daos {
+		err := dao.Delete(ctx, ids)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MultiOutboxDAO) Retry(ctx context.Context, id int64, attempts int, nextTime int64) error {
+	for _, dao := range m.daos {
+		err := dao.Retry(ctx, id, attempts, nextTime)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MultiOutboxDAO) Dead(ctx context.Context, id int64, attempts int) error {
+	for _, dao := range m.daos {
+		err := dao.Dead(ctx, id, attempts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
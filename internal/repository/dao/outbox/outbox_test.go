@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func TestGORMOutboxDAO_ListPending(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// 前面还在退避的消息挡住同一个 Key 后面的消息
+	mock.ExpectQuery("SELECT \\* FROM `outbox_messages` WHERE \\(status = \\? AND next_time <= \\?\\) "+
+		"AND NOT EXISTS \\(SELECT 1 FROM outbox_messages AS prev "+
+		"WHERE \\(prev.`key` = outbox_messages.`key` AND prev.id < outbox_messages.id\\) "+
+		"AND \\(prev.status = \\? AND prev.next_time > \\?\\)\\) ORDER BY id LIMIT \\?").
+		WithArgs(StatusPending, sqlmock.AnyArg(), StatusPending, sqlmock.AnyArg(), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key"}).AddRow(3, "2"))
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	msgs, err := NewGORMOutboxDAO(db).ListPending(context.Background(), 100)
+	require.NoError(t, err)
+	assert.Equal(t, []Message{{Id: 3, Key: "2"}}, msgs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_appendPending(t *testing.T) {
+	now := time.Now().UnixMilli()
+	testCases := []struct {
+		name    string
+		rows    []Message
+		wantIds []int64
+	}{
+		{
+			name: "都到了投递时间",
+			rows: []Message{
+				{Id: 1, Key: "1"},
+				{Id: 2, Key: "1"},
+				{Id: 3, Key: "2"},
+			},
+			wantIds: []int64{1, 2, 3},
+		},
+		{
+			// 上一批里面 1 失败了在退避，这一批 2 也不能投递
+			name: "前面的消息在退避，同一个 Key 后面的都不投递",
+			rows: []Message{
+				{Id: 1, Key: "1", Attempts: 1, NextTime: now + 1000},
+				{Id: 2, Key: "1"},
+				{Id: 3, Key: "2"},
+				{Id: 4, Key: "1"},
+			},
+			wantIds: []int64{3},
+		},
+		{
+			name: "退避结束之后按照顺序投递",
+			rows: []Message{
+				{Id: 1, Key: "1", Attempts: 1, NextTime: now},
+				{Id: 2, Key: "1"},
+			},
+			wantIds: []int64{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocked := make(map[string]struct{})
+			var res []Message
+			for _, msg := range tc.rows {
+				res = appendPending(res, blocked, msg, now)
+			}
+			var ids []int64
+			for _, msg := range res {
+				ids = append(ids, msg.Id)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package outbox 事务性发件箱
+//
+// 业务数据和要发出去的消息在同一个事务里面写入，
+// 再由 relay 把消息投递到 Kafka，投递成功之后删掉，保证至少投递一次。
+// 投递失败的消息退避之后重试，次数用完了就标记成 StatusDead 留在表里，等人工处理。
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// CollectionName MongoDB 里面发件箱的集合名
+const CollectionName = "outbox_messages"
+
+const (
+	// StatusPending 等待投递，包括失败了等着重试的
+	StatusPending uint8 = iota
+	// StatusDead 不再投递
+	StatusDead
+)
+
+type OutboxDAO interface {
+	// ListPending 按照写入顺序返回到了投递时间、还没有投递的消息
+	// 同一个 Key 前面还有消息在退避的话，后面的消息都不返回，保证跨批次也按照顺序投递
+	ListPending(ctx context.Context, limit int) ([]Message, error)
+	// Delete 删除已经投递成功的消息
+	Delete(ctx context.Context, ids []int64) error
+	// Retry 记下失败次数，nextTime 之后再投递
+	Retry(ctx context.Context, id int64, attempts int, nextTime int64) error
+	// Dead 记下失败次数，不再投递
+	Dead(ctx context.Context, id int64, attempts int) error
+}
+
+// Message 一条待投递的消息
+type Message struct {
+	Id int64 `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
+	// Topic Kafka 的 topic
+	Topic string `gorm:"type:varchar(128)" bson:"topic,omitempty"`
+	// Key 决定分区，同一个 Key 的消息按照写入顺序投递
+	Key string `gorm:"type:varchar(128);index" bson:"key,omitempty"`
+	// Type 事件类型，投递的时候放在消息头里面
+	Type string `gorm:"type:varchar(64)" bson:"type,omitempty"`
+	// Payload 发表事件带着全文，BLOB 只有 64KB，所以用 LONGBLOB
+	Payload []byte `gorm:"type:LONGBLOB" bson:"payload,omitempty"`
+	Status  uint8  `gorm:"index:idx_status_next_time,priority:1" bson:"status,omitempty"`
+	// Attempts 已经失败的次数
+	Attempts int `bson:"attempts,omitempty"`
+	// NextTime 失败之后下一次投递的时间，毫秒
+	NextTime int64 `gorm:"index:idx_status_next_time,priority:2" bson:"next_time,omitempty"`
+	Ctime    int64 `bson:"ctime,omitempty"`
+}
+
+func (Message) TableName() string {
+	return CollectionName
+}
+
+// NewMessage 把事件序列化成 JSON
+func NewMessage(topic, key, typ string, evt any) (Message, error) {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Topic:   topic,
+		Key:     key,
+		Type:    typ,
+		Payload: payload,
+		Ctime:   time.Now().UnixMilli(),
+	}, nil
+}
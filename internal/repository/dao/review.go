@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var ErrReviewNotFound = gorm.ErrRecordNotFound
+
+type ReviewDAO interface {
+	// Upsert 提交复审，之前审核过的话重新变成等待中，命中的词和原因以这次为准
+	Upsert(ctx context.Context, r ArticleReview) error
+	Get(ctx context.Context, aid int64) (ArticleReview, error)
+	// ListByStatus 按照提交时间返回，先提交的先审
+	ListByStatus(ctx context.Context, status uint8, offset int, limit int) ([]ArticleReview, error)
+	// Finish 只有状态是 from 的才会修改，没有修改返回 ErrReviewNotFound
+	Finish(ctx context.Context, aid int64, from, to uint8, reviewer int64, reason string) error
+}
+
+type GORMReviewDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMReviewDAO(db *gorm.DB) ReviewDAO {
+	return &GORMReviewDAO{db: db}
+}
+
+func (g *GORMReviewDAO) Upsert(ctx context.Context, r ArticleReview) error {
+	now := time.Now().UnixMilli()
+	r.Ctime = now
+	r.Utime = now
+	return g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"author_id": r.AuthorId,
+			"status":    r.Status,
+			"hits":      r.Hits,
+			"reason":    r.Reason,
+			"reviewer":  0,
+			"ctime":     now,
+			"utime":     now,
+		}),
+	}).Create(&r).Error
+}
+
+func (g *GORMReviewDAO) Get(ctx context.Context, aid int64) (ArticleReview, error) {
+	var res ArticleReview
+	err := g.db.WithContext(ctx).
+		Where("article_id = ?", aid).
+		First(&res).Error
+	return res, err
+}
+
+func (g *GORMReviewDAO) ListByStatus(ctx context.Context, status uint8, offset int, limit int) ([]ArticleReview, error) {
+	var res []ArticleReview
+	err := g.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("ctime").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMReviewDAO) Finish(ctx context.Context, aid int64, from, to uint8, reviewer int64, reason string) error {
+	res := g.db.WithContext(ctx).Model(&ArticleReview{}).
+		Where("article_id = ? AND status = ?", aid, from).
+		Updates(map[string]any{
+			"status":   to,
+			"reviewer": reviewer,
+			"reason":   reason,
+			"utime":    time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrReviewNotFound
+	}
+	return nil
+}
+
+// ArticleReview 文章的人工复审，一篇文章只有一行，重新提交的时候覆盖
+type ArticleReview struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex"`
+	AuthorId  int64
+	Status    uint8 `gorm:"index:status_ctime,priority:1"`
+	// Hits 命中的敏感词，JSON 数组
+	Hits     string `gorm:"type:text"`
+	Reason   string `gorm:"type:varchar(1024)"`
+	Reviewer int64
+	Ctime    int64 `gorm:"index:status_ctime,priority:2"`
+	Utime    int64
+}
@@ -0,0 +1,184 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrSeriesNotFound = gorm.ErrRecordNotFound
+	// ErrSeriesArticleConflict 文章已经在别的系列里面了
+	ErrSeriesArticleConflict = errors.New("article already in another series")
+)
+
+type SeriesDAO interface {
+	Insert(ctx context.Context, s Series, aids []int64) (int64, error)
+	// Update 只修改标题和简介
+	Update(ctx context.Context, s Series) error
+	// SetArticles 整体替换章节，调整顺序也是用这个
+	SetArticles(ctx context.Context, id, authorId int64, aids []int64) error
+	// Delete 只删除系列，文章不受影响
+	Delete(ctx context.Context, id, authorId int64) error
+	GetById(ctx context.Context, id int64) (Series, error)
+	// ListArticleIds 按照章节的顺序返回
+	ListArticleIds(ctx context.Context, id int64) ([]int64, error)
+	// FindByArticle 文章所在的系列，不在系列里面返回 ErrSeriesNotFound
+	FindByArticle(ctx context.Context, aid int64) (Series, error)
+	// ListByAuthor 和 ListPub 一样，utime 早于 start 的按照 utime 倒序分页
+	ListByAuthor(ctx context.Context, authorId int64, start int64, offset int, limit int) ([]Series, error)
+}
+
+type GORMSeriesDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMSeriesDAO(db *gorm.DB) SeriesDAO {
+	return &GORMSeriesDAO{db: db}
+}
+
+func (g *GORMSeriesDAO) Insert(ctx context.Context, s Series, aids []int64) (int64, error) {
+	now := time.Now().UnixMilli()
+	s.Ctime = now
+	s.Utime = now
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&s).Error; err != nil {
+			return err
+		}
+		return g.insertArticles(tx, s.Id, aids, now)
+	})
+	return s.Id, err
+}
+
+func (g *GORMSeriesDAO) Update(ctx context.Context, s Series) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := g.checkAuthor(tx, s.Id, s.AuthorId); err != nil {
+			return err
+		}
+		return tx.Model(&Series{}).Where("id = ?", s.Id).
+			Updates(map[string]any{
+				"title":       s.Title,
+				"description": s.Description,
+				"utime":       time.Now().UnixMilli(),
+			}).Error
+	})
+}
+
+func (g *GORMSeriesDAO) SetArticles(ctx context.Context, id, authorId int64, aids []int64) error {
+	now := time.Now().UnixMilli()
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := g.checkAuthor(tx, id, authorId); err != nil {
+			return err
+		}
+		err := tx.Model(&Series{}).Where("id = ?", id).
+			Update("utime", now).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("series_id = ?", id).Delete(&SeriesArticle{}).Error
+		if err != nil {
+			return err
+		}
+		return g.insertArticles(tx, id, aids, now)
+	})
+}
+
+func (g *GORMSeriesDAO) Delete(ctx context.Context, id, authorId int64) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := g.checkAuthor(tx, id, authorId); err != nil {
+			return err
+		}
+		err := tx.Where("series_id = ?", id).Delete(&SeriesArticle{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&Series{}).Error
+	})
+}
+
+func (g *GORMSeriesDAO) GetById(ctx context.Context, id int64) (Series, error) {
+	var res Series
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (g *GORMSeriesDAO) ListArticleIds(ctx context.Context, id int64) ([]int64, error) {
+	var res []int64
+	err := g.db.WithContext(ctx).Model(&SeriesArticle{}).
+		Where("series_id = ?", id).
+		Order("position").
+		Pluck("article_id", &res).Error
+	return res, err
+}
+
+func (g *GORMSeriesDAO) FindByArticle(ctx context.Context, aid int64) (Series, error) {
+	var sa SeriesArticle
+	err := g.db.WithContext(ctx).Where("article_id = ?", aid).First(&sa).Error
+	if err != nil {
+		return Series{}, err
+	}
+	return g.GetById(ctx, sa.SeriesId)
+}
+
+func (g *GORMSeriesDAO) ListByAuthor(ctx context.Context, authorId int64, start int64, offset int, limit int) ([]Series, error) {
+	var res []Series
+	err := g.db.WithContext(ctx).
+		Where("author_id = ? AND utime < ?", authorId, start).
+		Order("utime desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// checkAuthor 不是作者的系列当作不存在
+func (g *GORMSeriesDAO) checkAuthor(tx *gorm.DB, id, authorId int64) error {
+	var s Series
+	return tx.Select("id").
+		Where("id = ? AND author_id = ?", id, authorId).
+		First(&s).Error
+}
+
+func (g *GORMSeriesDAO) insertArticles(tx *gorm.DB, id int64, aids []int64, now int64) error {
+	if len(aids) == 0 {
+		return nil
+	}
+	sas := slice.Map(aids, func(idx int, src int64) SeriesArticle {
+		return SeriesArticle{
+			SeriesId:  id,
+			ArticleId: src,
+			Position:  idx,
+			Ctime:     now,
+		}
+	})
+	err := tx.Create(&sas).Error
+	// 文章上面有唯一索引，一篇文章只能属于一个系列
+	if sqlError, ok := err.(*mysql.MySQLError); ok {
+		const uniqueIndexErrNo = 1062
+		if sqlError.Number == uniqueIndexErrNo {
+			return ErrSeriesArticleConflict
+		}
+	}
+	return err
+}
+
+type Series struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	Title       string `gorm:"type:varchar(256)"`
+	Description string `gorm:"type:varchar(1024)"`
+	AuthorId    int64  `gorm:"index:author_utime,priority:1"`
+	Ctime       int64
+	Utime       int64 `gorm:"index:author_utime,priority:2"`
+}
+
+// SeriesArticle 系列里面的章节，Position 从 0 开始
+type SeriesArticle struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	SeriesId  int64 `gorm:"index:sid_position,priority:1"`
+	ArticleId int64 `gorm:"uniqueIndex"`
+	Position  int   `gorm:"index:sid_position,priority:2"`
+	Ctime     int64
+}
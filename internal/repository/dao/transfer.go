@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+var ErrTransferTaskNotFound = gorm.ErrRecordNotFound
+
+type TransferTaskDAO interface {
+	Insert(ctx context.Context, t TransferTask) (int64, error)
+	GetById(ctx context.Context, id int64) (TransferTask, error)
+	// Start 只有还在等待中的任务能开始，返回 false 说明已经有人执行过了
+	Start(ctx context.Context, id int64) (bool, error)
+	UpdateProgress(ctx context.Context, id int64, total, done, failed int, errs string) error
+	Finish(ctx context.Context, id int64, status uint8, msg string) error
+}
+
+type GORMTransferTaskDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMTransferTaskDAO(db *gorm.DB) TransferTaskDAO {
+	return &GORMTransferTaskDAO{db: db}
+}
+
+func (g *GORMTransferTaskDAO) Insert(ctx context.Context, t TransferTask) (int64, error) {
+	now := time.Now().UnixMilli()
+	t.Ctime = now
+	t.Utime = now
+	err := g.db.WithContext(ctx).Create(&t).Error
+	return t.Id, err
+}
+
+func (g *GORMTransferTaskDAO) GetById(ctx context.Context, id int64) (TransferTask, error) {
+	var t TransferTask
+	err := g.db.WithContext(ctx).Where("id = ?", id).First(&t).Error
+	return t, err
+}
+
+func (g *GORMTransferTaskDAO) Start(ctx context.Context, id int64) (bool, error) {
+	res := g.db.WithContext(ctx).Model(&TransferTask{}).
+		Where("id = ? AND status = ?", id, transferStatusPending).
+		Updates(map[string]any{
+			"status": transferStatusRunning,
+			"utime":  time.Now().UnixMilli(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (g *GORMTransferTaskDAO) UpdateProgress(ctx context.Context, id int64, total, done, failed int, errs string) error {
+	return g.db.WithContext(ctx).Model(&TransferTask{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"total":  total,
+			"done":   done,
+			"failed": failed,
+			"errors": errs,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+func (g *GORMTransferTaskDAO) Finish(ctx context.Context, id int64, status uint8, msg string) error {
+	return g.db.WithContext(ctx).Model(&TransferTask{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status": status,
+			"msg":    msg,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+// TransferTask 文章导入导出任务
+type TransferTask struct {
+	Id     int64 `gorm:"primaryKey,autoIncrement"`
+	Uid    int64 `gorm:"index"`
+	Type   uint8
+	Format uint8
+	Status uint8
+	Total  int
+	Done   int
+	Failed int
+	// Errors JSON 数组
+	Errors string `gorm:"type:text"`
+	Msg    string `gorm:"type:varchar(1024)"`
+	Ctime  int64
+	Utime  int64
+}
+
+// 和 domain.TransferStatus 保持一致
+const (
+	transferStatusPending uint8 = iota + 1
+	transferStatusRunning
+)
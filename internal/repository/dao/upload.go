@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+var ErrUploadNotFound = gorm.ErrRecordNotFound
+
+type UploadDAO interface {
+	Insert(ctx context.Context, u Upload) (int64, error)
+	GetByName(ctx context.Context, name string) (Upload, error)
+	// SetRefs 用 names 替换文章 typ 类型的引用，没有上传记录的 name 会被忽略
+	SetRefs(ctx context.Context, aid int64, typ uint8, names []string) error
+	// DeleteRefs 删除文章所有的引用
+	DeleteRefs(ctx context.Context, aid int64) error
+	// ListOrphans 没有任何文章引用、并且在 before 之前上传的图片
+	ListOrphans(ctx context.Context, before int64, limit int) ([]Upload, error)
+	// DeleteOrphan 还是没有被引用才删除，返回有没有删掉
+	DeleteOrphan(ctx context.Context, id int64) (bool, error)
+}
+
+type GORMUploadDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMUploadDAO(db *gorm.DB) UploadDAO {
+	return &GORMUploadDAO{db: db}
+}
+
+func (g *GORMUploadDAO) Insert(ctx context.Context, u Upload) (int64, error) {
+	now := time.Now().UnixMilli()
+	u.Ctime = now
+	u.Utime = now
+	err := g.db.WithContext(ctx).Create(&u).Error
+	return u.Id, err
+}
+
+func (g *GORMUploadDAO) GetByName(ctx context.Context, name string) (Upload, error) {
+	var res Upload
+	err := g.db.WithContext(ctx).
+		Where("name = ?", name).
+		First(&res).Error
+	return res, err
+}
+
+func (g *GORMUploadDAO) SetRefs(ctx context.Context, aid int64, typ uint8, names []string) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("article_id = ? AND type = ?", aid, typ).
+			Delete(&UploadRef{}).Error
+		if err != nil || len(names) == 0 {
+			return err
+		}
+		var ids []int64
+		err = tx.Model(&Upload{}).
+			Where("name IN ?", names).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		refs := make([]UploadRef, 0, len(ids))
+		for _, id := range ids {
+			refs = append(refs, UploadRef{
+				ArticleId: aid,
+				UploadId:  id,
+				Type:      typ,
+				Ctime:     now,
+			})
+		}
+		return tx.Create(&refs).Error
+	})
+}
+
+func (g *GORMUploadDAO) DeleteRefs(ctx context.Context, aid int64) error {
+	return g.db.WithContext(ctx).
+		Where("article_id = ?", aid).
+		Delete(&UploadRef{}).Error
+}
+
+func (g *GORMUploadDAO) ListOrphans(ctx context.Context, before int64, limit int) ([]Upload, error) {
+	var res []Upload
+	err := g.db.WithContext(ctx).
+		Where("ctime < ?", before).
+		Where("NOT EXISTS (?)", g.db.Model(&UploadRef{}).
+			Select("1").
+			Where("upload_refs.upload_id = uploads.id")).
+		Order("id").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMUploadDAO) DeleteOrphan(ctx context.Context, id int64) (bool, error) {
+	// MySQL 不允许在子查询里面引用正在删除的表，所以这里不用 uploads.id
+	res := g.db.WithContext(ctx).
+		Where("id = ?", id).
+		Where("NOT EXISTS (?)", g.db.Model(&UploadRef{}).
+			Select("1").
+			Where("upload_id = ?", id)).
+		Delete(&Upload{})
+	return res.RowsAffected > 0, res.Error
+}
+
+// Upload 上传的图片，文件本身在对象存储里面
+type Upload struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	Name        string `gorm:"type:varchar(32);uniqueIndex"`
+	Key         string `gorm:"type:varchar(256)"`
+	ThumbKey    string `gorm:"type:varchar(256)"`
+	Uploader    int64  `gorm:"index"`
+	ContentType string `gorm:"type:varchar(64)"`
+	Size        int64
+	Width       int
+	Height      int
+	Ctime       int64 `gorm:"index"`
+	Utime       int64
+}
+
+// UploadRef 文章引用了哪些图片，制作库和线上库分开记
+type UploadRef struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:aid_type_upload"`
+	Type      uint8 `gorm:"uniqueIndex:aid_type_upload"`
+	UploadId  int64 `gorm:"uniqueIndex:aid_type_upload;index"`
+	Ctime     int64
+}
@@ -2,17 +2,27 @@ package repository
 
 import (
 	"context"
+	"github.com/ecodeclub/ekit/slice"
 	"time"
 	"we_book/internal/domain"
 	"we_book/internal/repository/dao"
 )
 
+var ErrJobNotWaiting = dao.ErrJobNotWaiting
+
 type JobRepository interface {
 	Preempt(ctx context.Context) (domain.Job, error)
 	UpdateUtime(ctx context.Context, id int64) error
 	Release(ctx context.Context, id int64) error
 	Stop(ctx context.Context, id int64) error
 	UpdateNextUtime(ctx context.Context, id int64, next time.Time) error
+	// Retry 记下失败次数，next 之后再执行
+	Retry(ctx context.Context, id int64, attempts int, next time.Time) error
+	// Upsert 新建或者覆盖同名的任务
+	Upsert(ctx context.Context, j domain.Job) error
+	GetByNames(ctx context.Context, names []string) ([]domain.Job, error)
+	RescheduleWaiting(ctx context.Context, name string, next time.Time) error
+	DeleteWaiting(ctx context.Context, name string) error
 }
 
 type PreemptCronJobRepository struct {
@@ -24,13 +34,7 @@ func (p *PreemptCronJobRepository) Preempt(ctx context.Context) (domain.Job, err
 	if err != nil {
 		return domain.Job{}, err
 	}
-	return domain.Job{
-		Id:       job.Id,
-		Name:     job.Name,
-		Executor: job.Executor,
-
-		Cfg: job.Cfg,
-	}, nil
+	return p.toDomain(job), nil
 }
 
 func (p *PreemptCronJobRepository) UpdateUtime(ctx context.Context, id int64) error {
@@ -49,6 +53,50 @@ func (p *PreemptCronJobRepository) UpdateNextUtime(ctx context.Context, id int64
 	return p.dao.UpdateNextUtime(ctx, id, next)
 }
 
-//func NewPreemptionCronJobRepository(dao dao.JobDAO) JobRepository {
-//	return &PreemptCronJobRepository{dao: dao}
-//}
+func (p *PreemptCronJobRepository) Retry(ctx context.Context, id int64, attempts int, next time.Time) error {
+	return p.dao.Retry(ctx, id, attempts, next)
+}
+
+func (p *PreemptCronJobRepository) Upsert(ctx context.Context, j domain.Job) error {
+	return p.dao.Upsert(ctx, dao.Job{
+		Name:     j.Name,
+		Executor: j.Executor,
+		Cfg:      j.Cfg,
+		Cron:     j.Cron,
+		NextTime: j.NextExecTime.UnixMilli(),
+	})
+}
+
+func (p *PreemptCronJobRepository) GetByNames(ctx context.Context, names []string) ([]domain.Job, error) {
+	jobs, err := p.dao.GetByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(jobs, func(idx int, src dao.Job) domain.Job {
+		return p.toDomain(src)
+	}), nil
+}
+
+func (p *PreemptCronJobRepository) RescheduleWaiting(ctx context.Context, name string, next time.Time) error {
+	return p.dao.RescheduleWaiting(ctx, name, next)
+}
+
+func (p *PreemptCronJobRepository) DeleteWaiting(ctx context.Context, name string) error {
+	return p.dao.DeleteWaiting(ctx, name)
+}
+
+func (p *PreemptCronJobRepository) toDomain(job dao.Job) domain.Job {
+	return domain.Job{
+		Id:           job.Id,
+		Name:         job.Name,
+		Executor:     job.Executor,
+		Cron:         job.Cron,
+		Cfg:          job.Cfg,
+		Attempts:     job.Attempts,
+		NextExecTime: time.UnixMilli(job.NextTime),
+	}
+}
+
+func NewPreemptCronJobRepository(dao dao.JobDAO) JobRepository {
+	return &PreemptCronJobRepository{dao: dao}
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/collaborator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/collaborator.go -package=svcmocks -destination=internal/repository/mocks/collaborator.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCollaboratorRepository is a mock of CollaboratorRepository interface.
+type MockCollaboratorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollaboratorRepositoryMockRecorder
+}
+
+// MockCollaboratorRepositoryMockRecorder is the mock recorder for MockCollaboratorRepository.
+type MockCollaboratorRepositoryMockRecorder struct {
+	mock *MockCollaboratorRepository
+}
+
+// NewMockCollaboratorRepository creates a new mock instance.
+func NewMockCollaboratorRepository(ctrl *gomock.Controller) *MockCollaboratorRepository {
+	mock := &MockCollaboratorRepository{ctrl: ctrl}
+	mock.recorder = &MockCollaboratorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollaboratorRepository) EXPECT() *MockCollaboratorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCollaboratorRepository) Delete(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCollaboratorRepositoryMockRecorder) Delete(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollaboratorRepository)(nil).Delete), ctx, aid, uid)
+}
+
+// DeleteByArticle mocks base method.
+func (m *MockCollaboratorRepository) DeleteByArticle(ctx context.Context, aid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByArticle", ctx, aid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByArticle indicates an expected call of DeleteByArticle.
+func (mr *MockCollaboratorRepositoryMockRecorder) DeleteByArticle(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByArticle", reflect.TypeOf((*MockCollaboratorRepository)(nil).DeleteByArticle), ctx, aid)
+}
+
+// Get mocks base method.
+func (m *MockCollaboratorRepository) Get(ctx context.Context, aid, uid int64) (domain.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, aid, uid)
+	ret0, _ := ret[0].(domain.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCollaboratorRepositoryMockRecorder) Get(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollaboratorRepository)(nil).Get), ctx, aid, uid)
+}
+
+// Invite mocks base method.
+func (m *MockCollaboratorRepository) Invite(ctx context.Context, c domain.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockCollaboratorRepositoryMockRecorder) Invite(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockCollaboratorRepository)(nil).Invite), ctx, c)
+}
+
+// ListByArticle mocks base method.
+func (m *MockCollaboratorRepository) ListByArticle(ctx context.Context, aid int64) ([]domain.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArticle", ctx, aid)
+	ret0, _ := ret[0].([]domain.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArticle indicates an expected call of ListByArticle.
+func (mr *MockCollaboratorRepositoryMockRecorder) ListByArticle(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArticle", reflect.TypeOf((*MockCollaboratorRepository)(nil).ListByArticle), ctx, aid)
+}
+
+// ListPending mocks base method.
+func (m *MockCollaboratorRepository) ListPending(ctx context.Context, uid int64, offset, limit int) ([]domain.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockCollaboratorRepositoryMockRecorder) ListPending(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockCollaboratorRepository)(nil).ListPending), ctx, uid, offset, limit)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockCollaboratorRepository) UpdateStatus(ctx context.Context, aid, uid int64, from, to domain.InvitationStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, aid, uid, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockCollaboratorRepositoryMockRecorder) UpdateStatus(ctx, aid, uid, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockCollaboratorRepository)(nil).UpdateStatus), ctx, aid, uid, from, to)
+}
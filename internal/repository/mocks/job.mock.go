@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/job.go -package=svcmocks -destination=internal/repository/mocks/job.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteWaiting mocks base method.
+func (m *MockJobRepository) DeleteWaiting(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWaiting", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWaiting indicates an expected call of DeleteWaiting.
+func (mr *MockJobRepositoryMockRecorder) DeleteWaiting(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWaiting", reflect.TypeOf((*MockJobRepository)(nil).DeleteWaiting), ctx, name)
+}
+
+// GetByNames mocks base method.
+func (m *MockJobRepository) GetByNames(ctx context.Context, names []string) ([]domain.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNames", ctx, names)
+	ret0, _ := ret[0].([]domain.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNames indicates an expected call of GetByNames.
+func (mr *MockJobRepositoryMockRecorder) GetByNames(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNames", reflect.TypeOf((*MockJobRepository)(nil).GetByNames), ctx, names)
+}
+
+// Preempt mocks base method.
+func (m *MockJobRepository) Preempt(ctx context.Context) (domain.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preempt", ctx)
+	ret0, _ := ret[0].(domain.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preempt indicates an expected call of Preempt.
+func (mr *MockJobRepositoryMockRecorder) Preempt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockJobRepository)(nil).Preempt), ctx)
+}
+
+// Release mocks base method.
+func (m *MockJobRepository) Release(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockJobRepositoryMockRecorder) Release(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockJobRepository)(nil).Release), ctx, id)
+}
+
+// RescheduleWaiting mocks base method.
+func (m *MockJobRepository) RescheduleWaiting(ctx context.Context, name string, next time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleWaiting", ctx, name, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleWaiting indicates an expected call of RescheduleWaiting.
+func (mr *MockJobRepositoryMockRecorder) RescheduleWaiting(ctx, name, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleWaiting", reflect.TypeOf((*MockJobRepository)(nil).RescheduleWaiting), ctx, name, next)
+}
+
+// Retry mocks base method.
+func (m *MockJobRepository) Retry(ctx context.Context, id int64, attempts int, next time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, id, attempts, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockJobRepositoryMockRecorder) Retry(ctx, id, attempts, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockJobRepository)(nil).Retry), ctx, id, attempts, next)
+}
+
+// Stop mocks base method.
+func (m *MockJobRepository) Stop(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockJobRepositoryMockRecorder) Stop(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockJobRepository)(nil).Stop), ctx, id)
+}
+
+// UpdateNextUtime mocks base method.
+func (m *MockJobRepository) UpdateNextUtime(ctx context.Context, id int64, next time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNextUtime", ctx, id, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNextUtime indicates an expected call of UpdateNextUtime.
+func (mr *MockJobRepositoryMockRecorder) UpdateNextUtime(ctx, id, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNextUtime", reflect.TypeOf((*MockJobRepository)(nil).UpdateNextUtime), ctx, id, next)
+}
+
+// UpdateUtime mocks base method.
+func (m *MockJobRepository) UpdateUtime(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUtime", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUtime indicates an expected call of UpdateUtime.
+func (mr *MockJobRepositoryMockRecorder) UpdateUtime(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUtime", reflect.TypeOf((*MockJobRepository)(nil).UpdateUtime), ctx, id)
+}
+
+// Upsert mocks base method.
+func (m *MockJobRepository) Upsert(ctx context.Context, j domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockJobRepositoryMockRecorder) Upsert(ctx, j any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockJobRepository)(nil).Upsert), ctx, j)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/review.go -package=svcmocks -destination=internal/repository/mocks/review.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Finish mocks base method.
+func (m *MockReviewRepository) Finish(ctx context.Context, aid int64, from, to domain.ReviewStatus, reviewer int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, aid, from, to, reviewer, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockReviewRepositoryMockRecorder) Finish(ctx, aid, from, to, reviewer, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockReviewRepository)(nil).Finish), ctx, aid, from, to, reviewer, reason)
+}
+
+// Get mocks base method.
+func (m *MockReviewRepository) Get(ctx context.Context, aid int64) (domain.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, aid)
+	ret0, _ := ret[0].(domain.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReviewRepositoryMockRecorder) Get(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReviewRepository)(nil).Get), ctx, aid)
+}
+
+// ListByStatus mocks base method.
+func (m *MockReviewRepository) ListByStatus(ctx context.Context, status domain.ReviewStatus, offset, limit int) ([]domain.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, status, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStatus indicates an expected call of ListByStatus.
+func (mr *MockReviewRepositoryMockRecorder) ListByStatus(ctx, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MockReviewRepository)(nil).ListByStatus), ctx, status, offset, limit)
+}
+
+// Submit mocks base method.
+func (m *MockReviewRepository) Submit(ctx context.Context, r domain.ArticleReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockReviewRepositoryMockRecorder) Submit(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockReviewRepository)(nil).Submit), ctx, r)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/series.go -package=svcmocks -destination=internal/repository/mocks/series.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesRepository is a mock of SeriesRepository interface.
+type MockSeriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesRepositoryMockRecorder
+}
+
+// MockSeriesRepositoryMockRecorder is the mock recorder for MockSeriesRepository.
+type MockSeriesRepositoryMockRecorder struct {
+	mock *MockSeriesRepository
+}
+
+// NewMockSeriesRepository creates a new mock instance.
+func NewMockSeriesRepository(ctrl *gomock.Controller) *MockSeriesRepository {
+	mock := &MockSeriesRepository{ctrl: ctrl}
+	mock.recorder = &MockSeriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesRepository) EXPECT() *MockSeriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeriesRepository) Create(ctx context.Context, s domain.Series) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeriesRepositoryMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeriesRepository)(nil).Create), ctx, s)
+}
+
+// Delete mocks base method.
+func (m *MockSeriesRepository) Delete(ctx context.Context, id, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeriesRepositoryMockRecorder) Delete(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesRepository)(nil).Delete), ctx, id, uid)
+}
+
+// FindByArticle mocks base method.
+func (m *MockSeriesRepository) FindByArticle(ctx context.Context, aid int64) (domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, aid)
+	ret0, _ := ret[0].(domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockSeriesRepositoryMockRecorder) FindByArticle(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockSeriesRepository)(nil).FindByArticle), ctx, aid)
+}
+
+// GetById mocks base method.
+func (m *MockSeriesRepository) GetById(ctx context.Context, id int64) (domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockSeriesRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockSeriesRepository)(nil).GetById), ctx, id)
+}
+
+// ListByAuthor mocks base method.
+func (m *MockSeriesRepository) ListByAuthor(ctx context.Context, uid int64, start time.Time, offset, limit int) ([]domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAuthor", ctx, uid, start, offset, limit)
+	ret0, _ := ret[0].([]domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAuthor indicates an expected call of ListByAuthor.
+func (mr *MockSeriesRepositoryMockRecorder) ListByAuthor(ctx, uid, start, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAuthor", reflect.TypeOf((*MockSeriesRepository)(nil).ListByAuthor), ctx, uid, start, offset, limit)
+}
+
+// SetArticles mocks base method.
+func (m *MockSeriesRepository) SetArticles(ctx context.Context, id, uid int64, aids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArticles", ctx, id, uid, aids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArticles indicates an expected call of SetArticles.
+func (mr *MockSeriesRepositoryMockRecorder) SetArticles(ctx, id, uid, aids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticles", reflect.TypeOf((*MockSeriesRepository)(nil).SetArticles), ctx, id, uid, aids)
+}
+
+// Update mocks base method.
+func (m *MockSeriesRepository) Update(ctx context.Context, s domain.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeriesRepositoryMockRecorder) Update(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeriesRepository)(nil).Update), ctx, s)
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/transfer.go -package=svcmocks -destination=internal/repository/mocks/transfer.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransferTaskRepository is a mock of TransferTaskRepository interface.
+type MockTransferTaskRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferTaskRepositoryMockRecorder
+}
+
+// MockTransferTaskRepositoryMockRecorder is the mock recorder for MockTransferTaskRepository.
+type MockTransferTaskRepositoryMockRecorder struct {
+	mock *MockTransferTaskRepository
+}
+
+// NewMockTransferTaskRepository creates a new mock instance.
+func NewMockTransferTaskRepository(ctrl *gomock.Controller) *MockTransferTaskRepository {
+	mock := &MockTransferTaskRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferTaskRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferTaskRepository) EXPECT() *MockTransferTaskRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransferTaskRepository) Create(ctx context.Context, t domain.TransferTask) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferTaskRepositoryMockRecorder) Create(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferTaskRepository)(nil).Create), ctx, t)
+}
+
+// Finish mocks base method.
+func (m *MockTransferTaskRepository) Finish(ctx context.Context, id int64, status domain.TransferStatus, msg string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, id, status, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockTransferTaskRepositoryMockRecorder) Finish(ctx, id, status, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockTransferTaskRepository)(nil).Finish), ctx, id, status, msg)
+}
+
+// GetById mocks base method.
+func (m *MockTransferTaskRepository) GetById(ctx context.Context, id int64) (domain.TransferTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.TransferTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockTransferTaskRepositoryMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTransferTaskRepository)(nil).GetById), ctx, id)
+}
+
+// Start mocks base method.
+func (m *MockTransferTaskRepository) Start(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTransferTaskRepositoryMockRecorder) Start(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTransferTaskRepository)(nil).Start), ctx, id)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockTransferTaskRepository) UpdateProgress(ctx context.Context, t domain.TransferTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockTransferTaskRepositoryMockRecorder) UpdateProgress(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockTransferTaskRepository)(nil).UpdateProgress), ctx, t)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/upload.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/upload.go -package=svcmocks -destination=internal/repository/mocks/upload.mock.go
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUploadRepository is a mock of UploadRepository interface.
+type MockUploadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadRepositoryMockRecorder
+}
+
+// MockUploadRepositoryMockRecorder is the mock recorder for MockUploadRepository.
+type MockUploadRepositoryMockRecorder struct {
+	mock *MockUploadRepository
+}
+
+// NewMockUploadRepository creates a new mock instance.
+func NewMockUploadRepository(ctrl *gomock.Controller) *MockUploadRepository {
+	mock := &MockUploadRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadRepository) EXPECT() *MockUploadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUploadRepository) Create(ctx context.Context, u domain.Upload) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, u)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadRepositoryMockRecorder) Create(ctx, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadRepository)(nil).Create), ctx, u)
+}
+
+// DeleteOrphan mocks base method.
+func (m *MockUploadRepository) DeleteOrphan(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphan", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphan indicates an expected call of DeleteOrphan.
+func (mr *MockUploadRepositoryMockRecorder) DeleteOrphan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphan", reflect.TypeOf((*MockUploadRepository)(nil).DeleteOrphan), ctx, id)
+}
+
+// DeleteRefs mocks base method.
+func (m *MockUploadRepository) DeleteRefs(ctx context.Context, aid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRefs", ctx, aid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRefs indicates an expected call of DeleteRefs.
+func (mr *MockUploadRepositoryMockRecorder) DeleteRefs(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefs", reflect.TypeOf((*MockUploadRepository)(nil).DeleteRefs), ctx, aid)
+}
+
+// GetByName mocks base method.
+func (m *MockUploadRepository) GetByName(ctx context.Context, name string) (domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockUploadRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockUploadRepository)(nil).GetByName), ctx, name)
+}
+
+// ListOrphans mocks base method.
+func (m *MockUploadRepository) ListOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrphans", ctx, before, limit)
+	ret0, _ := ret[0].([]domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrphans indicates an expected call of ListOrphans.
+func (mr *MockUploadRepositoryMockRecorder) ListOrphans(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrphans", reflect.TypeOf((*MockUploadRepository)(nil).ListOrphans), ctx, before, limit)
+}
+
+// SetRefs mocks base method.
+func (m *MockUploadRepository) SetRefs(ctx context.Context, aid int64, typ domain.UploadRefType, content string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRefs", ctx, aid, typ, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRefs indicates an expected call of SetRefs.
+func (mr *MockUploadRepositoryMockRecorder) SetRefs(ctx, aid, typ, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRefs", reflect.TypeOf((*MockUploadRepository)(nil).SetRefs), ctx, aid, typ, content)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao"
+)
+
+var ErrReviewNotFound = dao.ErrReviewNotFound
+
+//go:generate mockgen -source=review.go -destination=mocks/review.mock.go -package=svcmocks
+type ReviewRepository interface {
+	// Submit 提交复审，覆盖这篇文章之前的复审
+	Submit(ctx context.Context, r domain.ArticleReview) error
+	Get(ctx context.Context, aid int64) (domain.ArticleReview, error)
+	ListByStatus(ctx context.Context, status domain.ReviewStatus, offset int, limit int) ([]domain.ArticleReview, error)
+	// Finish 只处理状态是 from 的复审
+	Finish(ctx context.Context, aid int64, from, to domain.ReviewStatus, reviewer int64, reason string) error
+}
+
+type GORMReviewRepository struct {
+	dao dao.ReviewDAO
+}
+
+func NewReviewRepository(dao dao.ReviewDAO) ReviewRepository {
+	return &GORMReviewRepository{dao: dao}
+}
+
+func (r *GORMReviewRepository) Submit(ctx context.Context, review domain.ArticleReview) error {
+	return r.dao.Upsert(ctx, r.toEntity(review))
+}
+
+func (r *GORMReviewRepository) Get(ctx context.Context, aid int64) (domain.ArticleReview, error) {
+	res, err := r.dao.Get(ctx, aid)
+	if err != nil {
+		return domain.ArticleReview{}, err
+	}
+	return r.toDomain(res), nil
+}
+
+func (r *GORMReviewRepository) ListByStatus(ctx context.Context, status domain.ReviewStatus, offset int, limit int) ([]domain.ArticleReview, error) {
+	reviews, err := r.dao.ListByStatus(ctx, status.ToUint8(), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.ArticleReview, 0, len(reviews))
+	for _, review := range reviews {
+		res = append(res, r.toDomain(review))
+	}
+	return res, nil
+}
+
+func (r *GORMReviewRepository) Finish(ctx context.Context, aid int64, from, to domain.ReviewStatus, reviewer int64, reason string) error {
+	return r.dao.Finish(ctx, aid, from.ToUint8(), to.ToUint8(), reviewer, reason)
+}
+
+func (r *GORMReviewRepository) toEntity(review domain.ArticleReview) dao.ArticleReview {
+	return dao.ArticleReview{
+		ArticleId: review.ArticleId,
+		AuthorId:  review.Author.Id,
+		Status:    review.Status.ToUint8(),
+		Hits:      r.encodeHits(review.Hits),
+		Reason:    review.Reason,
+		Reviewer:  review.Reviewer,
+	}
+}
+
+func (r *GORMReviewRepository) encodeHits(hits []string) string {
+	if len(hits) == 0 {
+		return ""
+	}
+	val, _ := json.Marshal(hits)
+	return string(val)
+}
+
+// decodeHits 早期的数据是用逗号拼起来的
+func (r *GORMReviewRepository) decodeHits(hits string) []string {
+	if hits == "" {
+		return nil
+	}
+	if !strings.HasPrefix(hits, "[") {
+		return strings.Split(hits, ",")
+	}
+	var res []string
+	// 解析失败就当没有，不影响审核
+	_ = json.Unmarshal([]byte(hits), &res)
+	return res
+}
+
+func (r *GORMReviewRepository) toDomain(review dao.ArticleReview) domain.ArticleReview {
+	hits := r.decodeHits(review.Hits)
+	return domain.ArticleReview{
+		ArticleId: review.ArticleId,
+		Author: domain.Author{
+			Id: review.AuthorId,
+		},
+		Status:   domain.ReviewStatus(review.Status),
+		Hits:     hits,
+		Reason:   review.Reason,
+		Reviewer: review.Reviewer,
+		Ctime:    time.UnixMilli(review.Ctime),
+		Utime:    time.UnixMilli(review.Utime),
+	}
+}
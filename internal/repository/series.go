@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao"
+)
+
+var (
+	ErrSeriesNotFound        = dao.ErrSeriesNotFound
+	ErrSeriesArticleConflict = dao.ErrSeriesArticleConflict
+)
+
+//go:generate mockgen -source=series.go -destination=mocks/series.mock.go -package=svcmocks
+type SeriesRepository interface {
+	Create(ctx context.Context, s domain.Series) (int64, error)
+	Update(ctx context.Context, s domain.Series) error
+	SetArticles(ctx context.Context, id, uid int64, aids []int64) error
+	Delete(ctx context.Context, id, uid int64) error
+	// GetById 带上按照顺序排好的章节
+	GetById(ctx context.Context, id int64) (domain.Series, error)
+	// FindByArticle 文章所在的系列，带上按照顺序排好的章节
+	FindByArticle(ctx context.Context, aid int64) (domain.Series, error)
+	// ListByAuthor 不带章节
+	ListByAuthor(ctx context.Context, uid int64, start time.Time, offset int, limit int) ([]domain.Series, error)
+}
+
+type GORMSeriesRepository struct {
+	dao dao.SeriesDAO
+}
+
+func NewSeriesRepository(dao dao.SeriesDAO) SeriesRepository {
+	return &GORMSeriesRepository{dao: dao}
+}
+
+func (g *GORMSeriesRepository) Create(ctx context.Context, s domain.Series) (int64, error) {
+	return g.dao.Insert(ctx, g.toEntity(s), s.ArticleIds)
+}
+
+func (g *GORMSeriesRepository) Update(ctx context.Context, s domain.Series) error {
+	return g.dao.Update(ctx, g.toEntity(s))
+}
+
+func (g *GORMSeriesRepository) SetArticles(ctx context.Context, id, uid int64, aids []int64) error {
+	return g.dao.SetArticles(ctx, id, uid, aids)
+}
+
+func (g *GORMSeriesRepository) Delete(ctx context.Context, id, uid int64) error {
+	return g.dao.Delete(ctx, id, uid)
+}
+
+func (g *GORMSeriesRepository) GetById(ctx context.Context, id int64) (domain.Series, error) {
+	s, err := g.dao.GetById(ctx, id)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return g.withArticles(ctx, s)
+}
+
+func (g *GORMSeriesRepository) FindByArticle(ctx context.Context, aid int64) (domain.Series, error) {
+	s, err := g.dao.FindByArticle(ctx, aid)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	return g.withArticles(ctx, s)
+}
+
+func (g *GORMSeriesRepository) ListByAuthor(ctx context.Context, uid int64, start time.Time, offset int, limit int) ([]domain.Series, error) {
+	res, err := g.dao.ListByAuthor(ctx, uid, start.UnixMilli(), offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(res, func(idx int, src dao.Series) domain.Series {
+		return g.toDomain(src)
+	}), nil
+}
+
+func (g *GORMSeriesRepository) withArticles(ctx context.Context, s dao.Series) (domain.Series, error) {
+	aids, err := g.dao.ListArticleIds(ctx, s.Id)
+	if err != nil {
+		return domain.Series{}, err
+	}
+	res := g.toDomain(s)
+	res.ArticleIds = aids
+	return res, nil
+}
+
+func (g *GORMSeriesRepository) toEntity(s domain.Series) dao.Series {
+	return dao.Series{
+		Id:          s.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		AuthorId:    s.Author.Id,
+	}
+}
+
+func (g *GORMSeriesRepository) toDomain(s dao.Series) domain.Series {
+	return domain.Series{
+		Id:          s.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		Author: domain.Author{
+			Id: s.AuthorId,
+		},
+		Ctime: time.UnixMilli(s.Ctime),
+		Utime: time.UnixMilli(s.Utime),
+	}
+}
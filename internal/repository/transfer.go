@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao"
+)
+
+var ErrTransferTaskNotFound = dao.ErrTransferTaskNotFound
+
+//go:generate mockgen -source=transfer.go -destination=mocks/transfer.mock.go -package=svcmocks
+type TransferTaskRepository interface {
+	Create(ctx context.Context, t domain.TransferTask) (int64, error)
+	GetById(ctx context.Context, id int64) (domain.TransferTask, error)
+	// Start 把等待中的任务标记成执行中，返回 false 说明任务已经被执行过了
+	Start(ctx context.Context, id int64) (bool, error)
+	// UpdateProgress 更新 Total、Done、Failed 和 Errors
+	UpdateProgress(ctx context.Context, t domain.TransferTask) error
+	Finish(ctx context.Context, id int64, status domain.TransferStatus, msg string) error
+}
+
+type GORMTransferTaskRepository struct {
+	dao dao.TransferTaskDAO
+}
+
+func NewTransferTaskRepository(dao dao.TransferTaskDAO) TransferTaskRepository {
+	return &GORMTransferTaskRepository{dao: dao}
+}
+
+func (g *GORMTransferTaskRepository) Create(ctx context.Context, t domain.TransferTask) (int64, error) {
+	return g.dao.Insert(ctx, g.toEntity(t))
+}
+
+func (g *GORMTransferTaskRepository) GetById(ctx context.Context, id int64) (domain.TransferTask, error) {
+	t, err := g.dao.GetById(ctx, id)
+	if err != nil {
+		return domain.TransferTask{}, err
+	}
+	return g.toDomain(t), nil
+}
+
+func (g *GORMTransferTaskRepository) Start(ctx context.Context, id int64) (bool, error) {
+	return g.dao.Start(ctx, id)
+}
+
+func (g *GORMTransferTaskRepository) UpdateProgress(ctx context.Context, t domain.TransferTask) error {
+	return g.dao.UpdateProgress(ctx, t.Id, t.Total, t.Done, t.Failed, g.encodeErrors(t.Errors))
+}
+
+func (g *GORMTransferTaskRepository) Finish(ctx context.Context, id int64, status domain.TransferStatus, msg string) error {
+	return g.dao.Finish(ctx, id, status.ToUint8(), msg)
+}
+
+func (g *GORMTransferTaskRepository) encodeErrors(errs []string) string {
+	if len(errs) == 0 {
+		return ""
+	}
+	val, _ := json.Marshal(errs)
+	return string(val)
+}
+
+func (g *GORMTransferTaskRepository) toEntity(t domain.TransferTask) dao.TransferTask {
+	return dao.TransferTask{
+		Id:     t.Id,
+		Uid:    t.Uid,
+		Type:   t.Type.ToUint8(),
+		Format: t.Format.ToUint8(),
+		Status: t.Status.ToUint8(),
+		Total:  t.Total,
+		Done:   t.Done,
+		Failed: t.Failed,
+		Errors: g.encodeErrors(t.Errors),
+		Msg:    t.Msg,
+	}
+}
+
+func (g *GORMTransferTaskRepository) toDomain(t dao.TransferTask) domain.TransferTask {
+	var errs []string
+	if t.Errors != "" {
+		// 解析失败就当没有，不影响查看进度
+		_ = json.Unmarshal([]byte(t.Errors), &errs)
+	}
+	return domain.TransferTask{
+		Id:     t.Id,
+		Uid:    t.Uid,
+		Type:   domain.TransferType(t.Type),
+		Format: domain.TransferFormat(t.Format),
+		Status: domain.TransferStatus(t.Status),
+		Total:  t.Total,
+		Done:   t.Done,
+		Failed: t.Failed,
+		Errors: errs,
+		Msg:    t.Msg,
+		Ctime:  time.UnixMilli(t.Ctime),
+		Utime:  time.UnixMilli(t.Utime),
+	}
+}
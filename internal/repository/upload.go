@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/dao"
+)
+
+var ErrUploadNotFound = dao.ErrUploadNotFound
+
+//go:generate mockgen -source=upload.go -destination=mocks/upload.mock.go -package=svcmocks
+type UploadRepository interface {
+	Create(ctx context.Context, u domain.Upload) (int64, error)
+	GetByName(ctx context.Context, name string) (domain.Upload, error)
+	// SetRefs 按照文章内容更新引用，typ 区分制作库和线上库
+	SetRefs(ctx context.Context, aid int64, typ domain.UploadRefType, content string) error
+	DeleteRefs(ctx context.Context, aid int64) error
+	ListOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Upload, error)
+	// DeleteOrphan 在这期间被引用了就不删，返回 false
+	DeleteOrphan(ctx context.Context, id int64) (bool, error)
+}
+
+type GORMUploadRepository struct {
+	dao dao.UploadDAO
+}
+
+func NewUploadRepository(dao dao.UploadDAO) UploadRepository {
+	return &GORMUploadRepository{dao: dao}
+}
+
+func (u *GORMUploadRepository) Create(ctx context.Context, upload domain.Upload) (int64, error) {
+	return u.dao.Insert(ctx, u.toEntity(upload))
+}
+
+func (u *GORMUploadRepository) GetByName(ctx context.Context, name string) (domain.Upload, error) {
+	res, err := u.dao.GetByName(ctx, name)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	return u.toDomain(res), nil
+}
+
+func (u *GORMUploadRepository) SetRefs(ctx context.Context, aid int64, typ domain.UploadRefType, content string) error {
+	return u.dao.SetRefs(ctx, aid, typ.ToUint8(), domain.UploadNames(content))
+}
+
+func (u *GORMUploadRepository) DeleteRefs(ctx context.Context, aid int64) error {
+	return u.dao.DeleteRefs(ctx, aid)
+}
+
+func (u *GORMUploadRepository) ListOrphans(ctx context.Context, before time.Time, limit int) ([]domain.Upload, error) {
+	uploads, err := u.dao.ListOrphans(ctx, before.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Upload, 0, len(uploads))
+	for _, upload := range uploads {
+		res = append(res, u.toDomain(upload))
+	}
+	return res, nil
+}
+
+func (u *GORMUploadRepository) DeleteOrphan(ctx context.Context, id int64) (bool, error) {
+	return u.dao.DeleteOrphan(ctx, id)
+}
+
+func (u *GORMUploadRepository) toEntity(upload domain.Upload) dao.Upload {
+	return dao.Upload{
+		Id:          upload.Id,
+		Name:        upload.Name,
+		Key:         upload.Key,
+		ThumbKey:    upload.ThumbKey,
+		Uploader:    upload.Uploader,
+		ContentType: upload.ContentType,
+		Size:        upload.Size,
+		Width:       upload.Width,
+		Height:      upload.Height,
+	}
+}
+
+func (u *GORMUploadRepository) toDomain(upload dao.Upload) domain.Upload {
+	return domain.Upload{
+		Id:          upload.Id,
+		Name:        upload.Name,
+		Key:         upload.Key,
+		ThumbKey:    upload.ThumbKey,
+		Uploader:    upload.Uploader,
+		ContentType: upload.ContentType,
+		Size:        upload.Size,
+		Width:       upload.Width,
+		Height:      upload.Height,
+		Ctime:       time.UnixMilli(upload.Ctime),
+	}
+}
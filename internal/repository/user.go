@@ -109,6 +109,9 @@ func (ur *CacheUserRepository) FindById(ctx context.Context, id int64) (domain.U
 	//}
 
 	userEntity, err := ur.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
 	u := ur.toDomainUser(userEntity)
 	_ = ur.cache.Set(ctx, u)
 	// 忽略错误，可能是 redis 服务挂了
@@ -0,0 +1,58 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Highlight 把 text 中出现的 terms 用 <em></em> 包起来
+// 相邻或者重叠的命中会合并成一段，比如 "并发" 和 "发编" 合并成 "<em>并发编</em>"
+func Highlight(text string, terms []string) string {
+	src := []rune(text)
+	lower := make([]rune, len(src))
+	for i, r := range src {
+		lower[i] = unicode.ToLower(r)
+	}
+	marked := make([]bool, len(src))
+	for _, t := range terms {
+		tr := []rune(t)
+		if len(tr) == 0 {
+			continue
+		}
+		for i := 0; i+len(tr) <= len(lower); i++ {
+			if runesEqual(lower[i:i+len(tr)], tr) {
+				for j := i; j < i+len(tr); j++ {
+					marked[j] = true
+				}
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(src); {
+		j := i
+		for j < len(src) && marked[j] == marked[i] {
+			j++
+		}
+		seg := html.EscapeString(string(src[i:j]))
+		if marked[i] {
+			sb.WriteString("<em>")
+			sb.WriteString(seg)
+			sb.WriteString("</em>")
+		} else {
+			sb.WriteString(seg)
+		}
+		i = j
+	}
+	return sb.String()
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,202 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+	"we_book/internal/domain"
+)
+
+const (
+	// titleBoost 标题里面出现的词按照出现多次来算
+	titleBoost = 3
+	// BM25 的参数，取常用的默认值
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+type memoryDoc struct {
+	art domain.Article
+	// tf 词在这篇文章中出现的次数，标题已经按照 titleBoost 加权
+	tf     map[string]int
+	length int
+}
+
+// MemoryIndex 进程内的倒排索引，同时实现了 Indexer 和 Searcher
+// 重启之后需要重新构建，适合数据量不大的时候使用
+type MemoryIndex struct {
+	mu   sync.RWMutex
+	docs map[int64]*memoryDoc
+	// postings 词 -> 包含这个词的文章
+	postings map[string]map[int64]struct{}
+	// deleted 被删除的文章和删除时间，比它旧的内容不能再放回索引
+	deleted  map[int64]time.Time
+	totalLen int
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[int64]*memoryDoc),
+		postings: make(map[string]map[int64]struct{}),
+		deleted:  make(map[int64]time.Time),
+	}
+}
+
+func (m *MemoryIndex) Index(ctx context.Context, art domain.Article) error {
+	// 搜索结果的摘要在建索引的时候算好，不用每次搜索都渲染
+	art.Summary = art.Abstract()
+	doc := &memoryDoc{
+		art: art,
+		tf:  make(map[string]int),
+	}
+	for _, t := range Tokenize(art.Title) {
+		doc.tf[t] += titleBoost
+		doc.length += titleBoost
+	}
+	for _, t := range Tokenize(art.Content) {
+		doc.tf[t]++
+		doc.length++
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if dtime, ok := m.deleted[art.Id]; ok {
+		if !art.Utime.After(dtime) {
+			return nil
+		}
+		delete(m.deleted, art.Id)
+	}
+	if old, ok := m.docs[art.Id]; ok {
+		// 消息可能乱序或者和全量构建交叉，旧的内容不能覆盖新的
+		if old.art.Utime.After(art.Utime) {
+			return nil
+		}
+		m.remove(old)
+	}
+	m.docs[art.Id] = doc
+	m.totalLen += doc.length
+	for t := range doc.tf {
+		ids, ok := m.postings[t]
+		if !ok {
+			ids = make(map[int64]struct{})
+			m.postings[t] = ids
+		}
+		ids[art.Id] = struct{}{}
+	}
+	return nil
+}
+
+func (m *MemoryIndex) Delete(ctx context.Context, aid int64, utime time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if doc, ok := m.docs[aid]; ok {
+		// 删除之后又重新发表了
+		if doc.art.Utime.After(utime) {
+			return nil
+		}
+		m.remove(doc)
+	}
+	if dtime, ok := m.deleted[aid]; !ok || utime.After(dtime) {
+		m.deleted[aid] = utime
+	}
+	return nil
+}
+
+// remove 调用者需要持有写锁
+func (m *MemoryIndex) remove(doc *memoryDoc) {
+	id := doc.art.Id
+	for t := range doc.tf {
+		ids := m.postings[t]
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(m.postings, t)
+		}
+	}
+	m.totalLen -= doc.length
+	delete(m.docs, id)
+}
+
+func (m *MemoryIndex) Search(ctx context.Context, query string, offset int, limit int) ([]Hit, error) {
+	terms := dedup(QueryTokens(query))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n := len(m.docs)
+	if n == 0 {
+		return nil, nil
+	}
+	// 所有的词都要命中，从最短的倒排列表开始求交集
+	lists := make([]map[int64]struct{}, 0, len(terms))
+	for _, t := range terms {
+		ids, ok := m.postings[t]
+		if !ok {
+			return nil, nil
+		}
+		lists = append(lists, ids)
+	}
+	sort.Slice(lists, func(i, j int) bool {
+		return len(lists[i]) < len(lists[j])
+	})
+
+	avgLen := float64(m.totalLen) / float64(n)
+	hits := make([]Hit, 0, len(lists[0]))
+	for id := range lists[0] {
+		matched := true
+		for _, ids := range lists[1:] {
+			if _, ok := ids[id]; !ok {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		doc := m.docs[id]
+		var score float64
+		for _, t := range terms {
+			df := float64(len(m.postings[t]))
+			idf := math.Log(1 + (float64(n)-df+0.5)/(df+0.5))
+			tf := float64(doc.tf[t])
+			score += idf * tf * (bm25K1 + 1) /
+				(tf + bm25K1*(1-bm25B+bm25B*float64(doc.length)/avgLen))
+		}
+		hits = append(hits, Hit{Article: doc.art, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Article.Utime.After(hits[j].Article.Utime)
+	})
+
+	offset = max(offset, 0)
+	if offset >= len(hits) {
+		return nil, nil
+	}
+	hits = hits[offset:]
+	if limit < len(hits) {
+		hits = hits[:limit]
+	}
+	for i := range hits {
+		hits[i].Snippet = Highlight(hits[i].Article.Abstract(), terms)
+	}
+	return hits, nil
+}
+
+func dedup(terms []string) []string {
+	seen := make(map[string]struct{}, len(terms))
+	res := terms[:0]
+	for _, t := range terms {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	return res
+}
@@ -0,0 +1,138 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"we_book/internal/domain"
+)
+
+func TestMemoryIndex_Search(t *testing.T) {
+	now := time.Now()
+	idx := NewMemoryIndex()
+	ctx := context.Background()
+	arts := []domain.Article{
+		{Id: 1, Title: "Go 并发编程", Content: "goroutine 和 channel", Utime: now},
+		{Id: 2, Title: "MySQL 索引", Content: "聊一聊并发场景下的锁", Utime: now},
+		{Id: 3, Title: "Redis", Content: "缓存", Utime: now},
+	}
+	for _, art := range arts {
+		require.NoError(t, idx.Index(ctx, art))
+	}
+
+	testCases := []struct {
+		name    string
+		before  func(t *testing.T)
+		query   string
+		wantIds []int64
+	}{
+		{
+			name:    "标题命中排在前面",
+			query:   "并发",
+			wantIds: []int64{1, 2},
+		},
+		{
+			name:    "所有词都要命中",
+			query:   "并发 mysql",
+			wantIds: []int64{2},
+		},
+		{
+			name:  "没有命中",
+			query: "kafka",
+		},
+		{
+			name: "删除之后搜不到",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Delete(ctx, 1, now))
+			},
+			query:   "并发",
+			wantIds: []int64{2},
+		},
+		{
+			name: "删除之前的内容不会再放回索引",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Index(ctx, domain.Article{
+					Id: 1, Title: "Go 并发编程", Utime: now.Add(-time.Minute),
+				}))
+			},
+			query:   "并发",
+			wantIds: []int64{2},
+		},
+		{
+			name: "旧的删除不会删掉新的内容",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Delete(ctx, 2, now.Add(-time.Minute)))
+			},
+			query:   "并发",
+			wantIds: []int64{2},
+		},
+		{
+			name: "旧的内容不会覆盖新的",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Index(ctx, domain.Article{
+					Id: 3, Title: "Redis", Content: "并发", Utime: now.Add(-time.Hour),
+				}))
+			},
+			query:   "并发",
+			wantIds: []int64{2},
+		},
+		{
+			name: "删除之后重新发表",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Index(ctx, domain.Article{
+					Id: 1, Title: "Go 并发编程", Utime: now.Add(time.Minute),
+				}))
+			},
+			query:   "并发",
+			wantIds: []int64{1, 2},
+		},
+		{
+			name: "更新之后按照新的内容搜索",
+			before: func(t *testing.T) {
+				require.NoError(t, idx.Index(ctx, domain.Article{
+					Id: 3, Title: "Redis", Content: "并发", Utime: now.Add(time.Hour),
+				}))
+			},
+			query:   "缓存",
+			wantIds: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.before != nil {
+				tc.before(t)
+			}
+			hits, err := idx.Search(ctx, tc.query, 0, 10)
+			require.NoError(t, err)
+			var ids []int64
+			for _, h := range hits {
+				ids = append(ids, h.Article.Id)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
+
+func TestMemoryIndex_Snippet(t *testing.T) {
+	idx := NewMemoryIndex()
+	ctx := context.Background()
+	require.NoError(t, idx.Index(ctx, domain.Article{
+		Id: 1, Title: "标题", Content: "学习 Go 的并发编程",
+	}))
+	hits, err := idx.Search(ctx, "并发编程", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "学习 Go 的<em>并发编程</em>", hits[0].Snippet)
+}
+
+func TestMemoryIndex_SearchNegativeOffset(t *testing.T) {
+	idx := NewMemoryIndex()
+	ctx := context.Background()
+	require.NoError(t, idx.Index(ctx, domain.Article{Id: 1, Title: "并发"}))
+	hits, err := idx.Search(ctx, "并发", -1, 10)
+	require.NoError(t, err)
+	assert.Len(t, hits, 1)
+}
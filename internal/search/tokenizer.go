@@ -0,0 +1,74 @@
+package search
+
+import (
+	"unicode"
+)
+
+// Tokenize 把文本切成用来建索引的词
+// 英文和数字按照连续的字母数字切分并且转成小写
+// 中日韩文字没有空格，所以同时输出单字和相邻两个字组成的二元词，
+// 这样不需要词典，单字查询和多字查询都能命中
+func Tokenize(text string) []string {
+	return tokenize(text, true)
+}
+
+// QueryTokens 把查询切成词，和 Tokenize 的区别在于中文只用二元词，
+// 只有单独一个汉字的时候才用单字，避免 "并发" 命中所有带 "并" 的文章
+func QueryTokens(query string) []string {
+	return tokenize(query, false)
+}
+
+func tokenize(text string, unigram bool) []string {
+	var (
+		res  []string
+		word []rune
+		cjk  []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			res = append(res, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 0:
+			return
+		case len(cjk) == 1:
+			res = append(res, string(cjk))
+		default:
+			for i := 0; i < len(cjk); i++ {
+				if unigram {
+					res = append(res, string(cjk[i]))
+				}
+				if i+1 < len(cjk) {
+					res = append(res, string(cjk[i:i+2]))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return res
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,83 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		wantIndex []string
+		wantQuery []string
+	}{
+		{
+			name:      "英文转小写",
+			text:      "Hello, Go1.21!",
+			wantIndex: []string{"hello", "go1", "21"},
+			wantQuery: []string{"hello", "go1", "21"},
+		},
+		{
+			name:      "中文二元切分",
+			text:      "并发编程",
+			wantIndex: []string{"并", "并发", "发", "发编", "编", "编程", "程"},
+			wantQuery: []string{"并发", "发编", "编程"},
+		},
+		{
+			name:      "单个汉字",
+			text:      "锁",
+			wantIndex: []string{"锁"},
+			wantQuery: []string{"锁"},
+		},
+		{
+			name:      "中英混合",
+			text:      "Go语言",
+			wantIndex: []string{"go", "语", "语言", "言"},
+			wantQuery: []string{"go", "语言"},
+		},
+		{
+			name: "空字符串",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIndex, Tokenize(tc.text))
+			assert.Equal(t, tc.wantQuery, QueryTokens(tc.text))
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	testCases := []struct {
+		name  string
+		text  string
+		terms []string
+		want  string
+	}{
+		{
+			name:  "合并相邻的命中",
+			text:  "Go 的并发编程",
+			terms: []string{"并发", "发编", "编程"},
+			want:  "Go 的<em>并发编程</em>",
+		},
+		{
+			name:  "忽略大小写并且转义",
+			text:  "<b>Go</b>",
+			terms: []string{"go"},
+			want:  "&lt;b&gt;<em>Go</em>&lt;/b&gt;",
+		},
+		{
+			name:  "没有命中",
+			text:  "内容",
+			terms: []string{"标题"},
+			want:  "内容",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Highlight(tc.text, tc.terms))
+		})
+	}
+}
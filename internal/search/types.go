@@ -0,0 +1,27 @@
+package search
+
+import (
+	"context"
+	"time"
+	"we_book/internal/domain"
+)
+
+// Indexer 维护已发表文章的索引
+// 同一篇文章重复 Index 会覆盖之前的内容
+// Index 和 Delete 按照 Utime 取最新的，消息乱序的时候旧的操作会被忽略
+type Indexer interface {
+	Index(ctx context.Context, art domain.Article) error
+	Delete(ctx context.Context, aid int64, utime time.Time) error
+}
+
+// Searcher 按照相关度从高到低返回命中的文章
+type Searcher interface {
+	Search(ctx context.Context, query string, offset int, limit int) ([]Hit, error)
+}
+
+type Hit struct {
+	Article domain.Article
+	Score   float64
+	// Snippet 由摘要生成，命中的词用 <em></em> 包起来，其余部分已经转义过
+	Snippet string
+}
@@ -2,15 +2,30 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 	events "we_book/events/article"
 	"we_book/internal/domain"
+	"we_book/internal/repository"
 	"we_book/internal/repository/article"
+	"we_book/internal/service/moderation"
+	"we_book/pkg/diffx"
 	"we_book/pkg/logger"
 )
 
+var (
+	ErrNotArticleAuthor = errors.New("not the author of the article")
+	ErrInvalidRevision  = errors.New("revision does not belong to the article")
+	ErrArticleNotFound  = article.ErrArticleNotFound
+)
+
 type articleService struct {
 	repo       article.ArticleRepository
+	jobRepo    repository.JobRepository
+	collabRepo repository.CollaboratorRepository
+	reviewRepo repository.ReviewRepository
+	// moderator 为 nil 的时候不审核，直接发表
+	moderator  moderation.Moderator
 	readerRepo article.ArticleReaderRepository
 	authorRepo article.ArticleAuthorRepository
 	l          logger.V1
@@ -22,6 +37,10 @@ func (asv *articleService) ListPub(ctx context.Context, start time.Time, offset
 	return asv.repo.ListPub(ctx, start, offset, limit)
 }
 
+func (asv *articleService) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return asv.repo.ListPubByCursor(ctx, cursor, limit)
+}
+
 type readInfo struct {
 	Uid int64
 	Aid int64
@@ -34,16 +53,81 @@ type ArticleService interface {
 	Publish(ctx context.Context, article domain.Article) (int64, error)
 	Withdraw(ctx context.Context, article domain.Article) error
 	List(ctx context.Context, uid int64, set int, limit int) ([]domain.Article, error)
+	ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	// ListByLength 作者列表按照字数排序，asc 为 true 的时候短的在前面
+	ListByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 	GetPubById(ctx context.Context, aid, uid int64) (domain.Article, error)
+	// ListPubByIds 批量查询线上库，没有发表或者已经撤回的直接跳过，不会产生阅读事件
+	ListPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
 	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error)
+	// ListPubByCursor 按照 utime、id 倒序翻页，cursor 是零值的时候返回第一页
+	ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	// ListRevisions 列出文章的历史版本，只有作者能看
+	ListRevisions(ctx context.Context, aid, uid int64, offset int, limit int) ([]domain.ArticleRevision, error)
+	// DiffRevisions 按行比较同一篇文章的两个版本
+	DiffRevisions(ctx context.Context, aid, uid, from, to int64) ([]diffx.Line, error)
+	// Rollback 把草稿回滚到指定版本，回滚本身也会产生一个新版本
+	Rollback(ctx context.Context, aid, uid, revisionId int64) error
+	// SchedulePublish 保存草稿，并且在 publishAt 的时候自动发布
+	SchedulePublish(ctx context.Context, article domain.Article, publishAt time.Time) (int64, error)
+	ListScheduled(ctx context.Context, uid int64, offset int, limit int) ([]domain.ScheduledPublication, error)
+	Reschedule(ctx context.Context, aid, uid int64, publishAt time.Time) error
+	CancelSchedule(ctx context.Context, aid, uid int64) error
+	// AbortSchedule 定时发布一直失败，文章改回未发表，作者可以重新发表或者重新设置时间
+	AbortSchedule(ctx context.Context, aid, uid int64) error
+	ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error)
+	ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error)
+	// PopularTags 按照已发表文章数倒序返回标签
+	PopularTags(ctx context.Context, limit int) ([]domain.Tag, error)
+	// Trash 删除文章，先移到回收站，保留期内可以恢复
+	Trash(ctx context.Context, aid, uid int64) error
+	ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	Restore(ctx context.Context, aid, uid int64) error
+	// ListExpiredTrash 返回超过保留期的回收站文章，给清理任务用
+	ListExpiredTrash(ctx context.Context, limit int) ([]domain.Article, error)
+	// Purge 彻底删除回收站里的文章
+	Purge(ctx context.Context, aid int64) error
+	// BackfillStats 给老文章补上字数统计和摘要，返回这一批最大的 id 和文章数
+	BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error)
+	// Render 把 Markdown 渲染成过滤过的 HTML，同一个版本只渲染一次
+	Render(ctx context.Context, art domain.Article) (domain.RenderedContent, error)
+	// Invite 所有者邀请别人协作，role 只能是编辑或者只读
+	Invite(ctx context.Context, aid, owner, uid int64, role domain.CollaboratorRole) error
+	AcceptInvitation(ctx context.Context, aid, uid int64) error
+	DeclineInvitation(ctx context.Context, aid, uid int64) error
+	// RemoveCollaborator 所有者移除协作者，协作者也可以自己退出
+	RemoveCollaborator(ctx context.Context, aid, operator, uid int64) error
+	// ListCollaborators 所有者和协作者都能看
+	ListCollaborators(ctx context.Context, aid, uid int64) ([]domain.Collaborator, error)
+	// ListInvitations 用户还没有处理的邀请
+	ListInvitations(ctx context.Context, uid int64, offset int, limit int) ([]domain.Collaborator, error)
+	// GetDraft 返回制作库里的文章，所有者和协作者都能看
+	GetDraft(ctx context.Context, aid, uid int64) (domain.Article, error)
+	// Bylines 读者端的署名，所有者在前面，然后是编辑
+	Bylines(ctx context.Context, art domain.Article) ([]domain.Author, error)
+	// ListReviews 管理后台按照提交时间列出复审
+	ListReviews(ctx context.Context, status domain.ReviewStatus, offset int, limit int) ([]domain.ArticleReview, error)
+	// Approve 复审通过，把草稿发表出去
+	Approve(ctx context.Context, aid, reviewer int64) error
+	// Reject 复审驳回，reason 作者可以看到
+	Reject(ctx context.Context, aid, reviewer int64, reason string) error
+	// GetReview 作者和协作者查看文章最近一次复审
+	GetReview(ctx context.Context, aid, uid int64) (domain.ArticleReview, error)
 }
 
-func NewArticleService(repo article.ArticleRepository, l logger.V1, producer events.Producer) ArticleService {
+func NewArticleService(repo article.ArticleRepository, jobRepo repository.JobRepository,
+	collabRepo repository.CollaboratorRepository,
+	reviewRepo repository.ReviewRepository, moderator moderation.Moderator,
+	l logger.V1, producer events.Producer) ArticleService {
 	return &articleService{
-		repo:     repo,
-		l:        l,
-		producer: producer,
+		repo:       repo,
+		jobRepo:    jobRepo,
+		collabRepo: collabRepo,
+		reviewRepo: reviewRepo,
+		moderator:  moderator,
+		l:          l,
+		producer:   producer,
 		//ch: make(chan readInfo, 10),
 	}
 }
@@ -86,7 +170,11 @@ func NewArticleServiceV2(repo article.ArticleRepository,
 	}
 }
 
+// Withdraw 撤回事件和状态在同一个事务里面写进发件箱，由 relay 投递
 func (asv *articleService) Withdraw(ctx context.Context, art domain.Article) error {
+	if err := asv.asOwner(ctx, &art); err != nil {
+		return err
+	}
 	return asv.repo.SyncStatus(ctx, art.Id, art.Author.Id, domain.ArticleStatusPrivate)
 }
 
@@ -98,43 +186,85 @@ func (asv *articleService) GetPubById(ctx context.Context, aid, uid int64) (doma
 	art, err := asv.repo.GetPubById(ctx, aid)
 	if err == nil {
 		go func() {
+			// 请求结束之后 ctx 就取消了，不能用它发消息
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
 			er := asv.producer.ProducerReadEvent(
 				ctx,
 				events.ReadEvent{
 					Uid: uid,
 					Aid: aid,
 				})
-			if er == nil {
-				asv.l.Error("producer read event error")
+			if er != nil {
+				asv.l.Error("producer read event error",
+					logger.Int64("aid", aid),
+					logger.Int64("uid", uid),
+					logger.Error(er))
 			}
 		}()
 
-		go func() {
-			asv.ch <- readInfo{
-				Uid: uid,
-				Aid: aid,
-			}
-		}()
+		// 只有 NewArticleServiceV2 会批量发送，其他情况 ch 是 nil，发了会一直阻塞
+		if asv.ch != nil {
+			go func() {
+				asv.ch <- readInfo{
+					Uid: uid,
+					Aid: aid,
+				}
+			}()
+		}
 	}
 	return art, err
 }
 
+func (asv *articleService) ListPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	arts := make([]domain.Article, 0, len(ids))
+	for _, id := range ids {
+		art, err := asv.repo.GetPubById(ctx, id)
+		if errors.Is(err, ErrArticleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		arts = append(arts, art)
+	}
+	return arts, nil
+}
+
 func (asv *articleService) GetById(ctx context.Context, id int64) (domain.Article, error) {
 	return asv.repo.GetById(ctx, id)
 }
 
+func (asv *articleService) BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error) {
+	return asv.repo.BackfillStats(ctx, startId, limit)
+}
+
 func (asv *articleService) List(ctx context.Context, uid int64, set int, limit int) ([]domain.Article, error) {
 	return asv.repo.List(ctx, uid, set, limit)
 }
 
+func (asv *articleService) ListByLength(ctx context.Context, uid int64, asc bool, offset int, limit int) ([]domain.Article, error) {
+	return asv.repo.ListByLength(ctx, uid, asc, offset, limit)
+}
+
+func (asv *articleService) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return asv.repo.ListByCursor(ctx, uid, cursor, limit)
+}
+
 func (asv *articleService) Edit(ctx context.Context, article domain.Article) (int64, error) {
-	return asv.repo.Create(ctx, article)
+	return asv.Save(ctx, article)
 }
 
 func (asv *articleService) Save(ctx context.Context, article domain.Article) (int64, error) {
+	if err := normalizeTaxonomy(&article); err != nil {
+		return 0, err
+	}
 	article.Status = domain.ArticleStatusUnpublished
 	// 如果 article 的 ID 为 0 则调用 create 方法
 	if article.Id > 0 {
+		if err := asv.asOwner(ctx, &article); err != nil {
+			return 0, err
+		}
 		err := asv.update(ctx, article)
 		return article.Id, err
 	}
@@ -154,6 +284,22 @@ func (asv *articleService) PublishV1(ctx context.Context, article domain.Article
 }
 
 func (asv *articleService) Publish(ctx context.Context, article domain.Article) (int64, error) {
+	if err := normalizeTaxonomy(&article); err != nil {
+		return 0, err
+	}
+	if asv.repo != nil {
+		// 制作库和线上库在同一个 repository 里面
+		// 发表事件和线上库在同一个事务里面写进发件箱，由 relay 投递
+		if err := asv.asOwner(ctx, &article); err != nil {
+			return 0, err
+		}
+		pass, err := asv.moderate(ctx, &article)
+		if err != nil || !pass {
+			return article.Id, err
+		}
+		article.Status = domain.ArticleStatusPublished
+		return asv.repo.Sync(ctx, article)
+	}
 	var (
 		id  = article.Id
 		err error
@@ -175,5 +321,85 @@ func (asv *articleService) Publish(ctx context.Context, article domain.Article)
 			break
 		}
 	}
-	return id, nil
+	// 重试了还是失败，线上库和制作库不一致，要让调用方知道
+	return id, err
+}
+
+func (asv *articleService) ListRevisions(ctx context.Context, aid, uid int64, offset int, limit int) ([]domain.ArticleRevision, error) {
+	err := asv.checkAuthor(ctx, aid, uid)
+	if err != nil {
+		return nil, err
+	}
+	return asv.repo.ListRevisions(ctx, aid, offset, limit)
+}
+
+func (asv *articleService) DiffRevisions(ctx context.Context, aid, uid, from, to int64) ([]diffx.Line, error) {
+	err := asv.checkAuthor(ctx, aid, uid)
+	if err != nil {
+		return nil, err
+	}
+	src, err := asv.getRevision(ctx, aid, from)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := asv.getRevision(ctx, aid, to)
+	if err != nil {
+		return nil, err
+	}
+	// 标题也当成一行参与比较
+	return diffx.Lines(src.Title+"\n"+src.Content, dst.Title+"\n"+dst.Content), nil
+}
+
+func (asv *articleService) Rollback(ctx context.Context, aid, uid, revisionId int64) error {
+	art, err := asv.getByAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	rev, err := asv.getRevision(ctx, aid, revisionId)
+	if err != nil {
+		return err
+	}
+	// 版本里面只有标题和内容，分类和标签保持草稿当前的
+	return asv.repo.Update(ctx, domain.Article{
+		Id:      aid,
+		Title:   rev.Title,
+		Content: rev.Content,
+		Author: domain.Author{
+			Id: uid,
+		},
+		Status:   domain.ArticleStatusUnpublished,
+		Category: art.Category,
+		Tags:     art.Tags,
+	})
+}
+
+func (asv *articleService) checkAuthor(ctx context.Context, aid, uid int64) error {
+	_, err := asv.getByAuthor(ctx, aid, uid)
+	return err
+}
+
+func (asv *articleService) getByAuthor(ctx context.Context, aid, uid int64) (domain.Article, error) {
+	art, err := asv.repo.GetById(ctx, aid)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if art.Author.Id != uid {
+		return domain.Article{}, ErrNotArticleAuthor
+	}
+	return art, nil
+}
+
+func (asv *articleService) getRevision(ctx context.Context, aid, id int64) (domain.ArticleRevision, error) {
+	rev, err := asv.repo.GetRevisionById(ctx, id)
+	if errors.Is(err, article.ErrRevisionNotFound) {
+		// 和别的文章的版本一样，都是参数不对
+		return domain.ArticleRevision{}, ErrInvalidRevision
+	}
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	if rev.ArticleId != aid {
+		return domain.ArticleRevision{}, ErrInvalidRevision
+	}
+	return rev, nil
 }
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+)
+
+var (
+	// ErrArticlePermissionDenied 不是所有者，也不是有对应角色的协作者
+	ErrArticlePermissionDenied = errors.New("no permission on the article")
+	// ErrInvalidCollaborator 不能邀请自己，也不能邀请别人当所有者
+	ErrInvalidCollaborator = errors.New("invalid collaborator")
+	// ErrInvitationNotFound 没有邀请，或者已经处理过了
+	ErrInvitationNotFound = repository.ErrCollaboratorNotFound
+)
+
+func (asv *articleService) Invite(ctx context.Context, aid, owner, uid int64, role domain.CollaboratorRole) error {
+	if uid == owner || uid <= 0 ||
+		(role != domain.CollaboratorRoleEditor && role != domain.CollaboratorRoleViewer) {
+		return ErrInvalidCollaborator
+	}
+	_, err := asv.getByAuthor(ctx, aid, owner)
+	if err != nil {
+		return err
+	}
+	err = asv.collabRepo.Invite(ctx, domain.Collaborator{
+		ArticleId: aid,
+		User: domain.Author{
+			Id: uid,
+		},
+		Role:    role,
+		Status:  domain.InvitationStatusPending,
+		Inviter: owner,
+	})
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return ErrInvalidCollaborator
+	}
+	if err != nil {
+		return err
+	}
+	// 重新邀请已经接受的编辑，要等对方再次接受才署名
+	asv.repo.DelBylines(ctx, aid)
+	return nil
+}
+
+func (asv *articleService) AcceptInvitation(ctx context.Context, aid, uid int64) error {
+	err := asv.collabRepo.UpdateStatus(ctx, aid, uid,
+		domain.InvitationStatusPending, domain.InvitationStatusAccepted)
+	if err != nil {
+		return err
+	}
+	asv.repo.DelBylines(ctx, aid)
+	return nil
+}
+
+func (asv *articleService) DeclineInvitation(ctx context.Context, aid, uid int64) error {
+	return asv.collabRepo.UpdateStatus(ctx, aid, uid,
+		domain.InvitationStatusPending, domain.InvitationStatusDeclined)
+}
+
+func (asv *articleService) RemoveCollaborator(ctx context.Context, aid, operator, uid int64) error {
+	if operator != uid {
+		// 移除别人只有所有者可以
+		_, err := asv.getByAuthor(ctx, aid, operator)
+		if err != nil {
+			return err
+		}
+	}
+	err := asv.collabRepo.Delete(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	asv.repo.DelBylines(ctx, aid)
+	return nil
+}
+
+func (asv *articleService) ListCollaborators(ctx context.Context, aid, uid int64) ([]domain.Collaborator, error) {
+	_, err := asv.authorize(ctx, aid, uid, domain.CollaboratorRole.CanView)
+	if err != nil {
+		return nil, err
+	}
+	return asv.collabRepo.ListByArticle(ctx, aid)
+}
+
+func (asv *articleService) ListInvitations(ctx context.Context, uid int64, offset int, limit int) ([]domain.Collaborator, error) {
+	return asv.collabRepo.ListPending(ctx, uid, offset, limit)
+}
+
+func (asv *articleService) GetDraft(ctx context.Context, aid, uid int64) (domain.Article, error) {
+	return asv.authorize(ctx, aid, uid, domain.CollaboratorRole.CanView)
+}
+
+// Bylines 线上库的文章带着缓存好的署名，没有的时候才查协作者
+func (asv *articleService) Bylines(ctx context.Context, art domain.Article) ([]domain.Author, error) {
+	if art.Bylines != nil {
+		return art.Bylines, nil
+	}
+	collabs, err := asv.collabRepo.ListByArticle(ctx, art.Id)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewBylines(art.Author, collabs), nil
+}
+
+// authorize 返回文章，uid 在文章上的角色不满足 allow 就返回 ErrArticlePermissionDenied
+func (asv *articleService) authorize(ctx context.Context, aid, uid int64,
+	allow func(role domain.CollaboratorRole) bool) (domain.Article, error) {
+	art, err := asv.repo.GetById(ctx, aid)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	role, err := asv.roleOf(ctx, art, uid)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if !allow(role) {
+		return domain.Article{}, ErrArticlePermissionDenied
+	}
+	return art, nil
+}
+
+func (asv *articleService) roleOf(ctx context.Context, art domain.Article, uid int64) (domain.CollaboratorRole, error) {
+	if art.Author.Id == uid {
+		return domain.CollaboratorRoleOwner, nil
+	}
+	c, err := asv.collabRepo.Get(ctx, art.Id, uid)
+	if errors.Is(err, repository.ErrCollaboratorNotFound) {
+		return domain.CollaboratorRoleNone, nil
+	}
+	if err != nil {
+		return domain.CollaboratorRoleNone, err
+	}
+	if !c.Active() {
+		return domain.CollaboratorRoleNone, nil
+	}
+	return c.Role, nil
+}
+
+// asOwner 协作者修改的时候检查角色，然后用所有者的身份保存，DAO 按照所有者过滤
+func (asv *articleService) asOwner(ctx context.Context, art *domain.Article) error {
+	if art.Id == 0 {
+		// 新建的文章，自己就是所有者
+		return nil
+	}
+	cur, err := asv.authorize(ctx, art.Id, art.Author.Id, domain.CollaboratorRole.CanEdit)
+	if err != nil {
+		return err
+	}
+	if cur.Author.Id != art.Author.Id {
+		// 版本上要记下真正修改的人
+		art.Editor = art.Author
+	}
+	art.Author = cur.Author
+	return nil
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	articlerepomock "we_book/internal/repository/article/mocks"
+	repomocks "we_book/internal/repository/mocks"
+)
+
+func Test_articleService_EditByCollaborator(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository)
+
+		art domain.Article
+
+		wantedErr error
+		wantedId  int64
+	}{
+		{
+			name: "所有者修改",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+				}).Return(nil)
+				return repo, collabRepo
+			},
+			art: domain.Article{
+				Id:      1,
+				Title:   "我的标题",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 123},
+			},
+			wantedId: 1,
+		},
+		{
+			name: "编辑修改，用所有者的身份保存",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(1), int64(456)).Return(domain.Collaborator{
+					ArticleId: 1,
+					User:      domain.Author{Id: 456},
+					Role:      domain.CollaboratorRoleEditor,
+					Status:    domain.InvitationStatusAccepted,
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+					Editor:  domain.Author{Id: 456},
+				}).Return(nil)
+				return repo, collabRepo
+			},
+			art: domain.Article{
+				Id:      1,
+				Title:   "我的标题",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 456},
+			},
+			wantedId: 1,
+		},
+		{
+			name: "只读的协作者不能修改",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(1), int64(456)).Return(domain.Collaborator{
+					ArticleId: 1,
+					User:      domain.Author{Id: 456},
+					Role:      domain.CollaboratorRoleViewer,
+					Status:    domain.InvitationStatusAccepted,
+				}, nil)
+				return repo, collabRepo
+			},
+			art: domain.Article{
+				Id:     1,
+				Author: domain.Author{Id: 456},
+			},
+			wantedErr: ErrArticlePermissionDenied,
+		},
+		{
+			name: "还没有接受邀请",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(1), int64(456)).Return(domain.Collaborator{
+					ArticleId: 1,
+					User:      domain.Author{Id: 456},
+					Role:      domain.CollaboratorRoleEditor,
+					Status:    domain.InvitationStatusPending,
+				}, nil)
+				return repo, collabRepo
+			},
+			art: domain.Article{
+				Id:     1,
+				Author: domain.Author{Id: 456},
+			},
+			wantedErr: ErrArticlePermissionDenied,
+		},
+		{
+			name: "不是协作者",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(1), int64(456)).
+					Return(domain.Collaborator{}, repository.ErrCollaboratorNotFound)
+				return repo, collabRepo
+			},
+			art: domain.Article{
+				Id:     1,
+				Author: domain.Author{Id: 456},
+			},
+			wantedErr: ErrArticlePermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, collabRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, collabRepo, nil, nil, nil, nil)
+			id, err := svc.Edit(context.Background(), tc.art)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedId, id)
+		})
+	}
+}
+
+func Test_articleService_Invite(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository)
+
+		aid   int64
+		owner int64
+		uid   int64
+		role  domain.CollaboratorRole
+
+		wantedErr error
+	}{
+		{
+			name: "邀请成功",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Invite(gomock.Any(), domain.Collaborator{
+					ArticleId: 1,
+					User:      domain.Author{Id: 456},
+					Role:      domain.CollaboratorRoleEditor,
+					Status:    domain.InvitationStatusPending,
+					Inviter:   123,
+				}).Return(nil)
+				repo.EXPECT().DelBylines(gomock.Any(), int64(1))
+				return repo, collabRepo
+			},
+			aid:   1,
+			owner: 123,
+			uid:   456,
+			role:  domain.CollaboratorRoleEditor,
+		},
+		{
+			name: "被邀请的人不存在",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Invite(gomock.Any(), gomock.Any()).
+					Return(repository.ErrUserNotFound)
+				return repo, collabRepo
+			},
+			aid:       1,
+			owner:     123,
+			uid:       999,
+			role:      domain.CollaboratorRoleEditor,
+			wantedErr: ErrInvalidCollaborator,
+		},
+		{
+			name: "不能邀请自己",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				return articlerepomock.NewMockArticleRepository(ctrl),
+					repomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			aid:       1,
+			owner:     123,
+			uid:       123,
+			role:      domain.CollaboratorRoleEditor,
+			wantedErr: ErrInvalidCollaborator,
+		},
+		{
+			name: "不能邀请别人当所有者",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				return articlerepomock.NewMockArticleRepository(ctrl),
+					repomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			aid:       1,
+			owner:     123,
+			uid:       456,
+			role:      domain.CollaboratorRoleOwner,
+			wantedErr: ErrInvalidCollaborator,
+		},
+		{
+			name: "编辑不能邀请别人",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				return repo, repomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			aid:       1,
+			owner:     456,
+			uid:       789,
+			role:      domain.CollaboratorRoleViewer,
+			wantedErr: ErrNotArticleAuthor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, collabRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, collabRepo, nil, nil, nil, nil)
+			err := svc.Invite(context.Background(), tc.aid, tc.owner, tc.uid, tc.role)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleService_Bylines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+	collabRepo.EXPECT().ListByArticle(gomock.Any(), int64(1)).Return([]domain.Collaborator{
+		{User: domain.Author{Id: 2, Name: "编辑"}, Role: domain.CollaboratorRoleEditor, Status: domain.InvitationStatusAccepted},
+		{User: domain.Author{Id: 3, Name: "只读"}, Role: domain.CollaboratorRoleViewer, Status: domain.InvitationStatusAccepted},
+		{User: domain.Author{Id: 4, Name: "没接受"}, Role: domain.CollaboratorRoleEditor, Status: domain.InvitationStatusPending},
+	}, nil)
+	svc := NewArticleService(nil, nil, collabRepo, nil, nil, nil, nil)
+	res, err := svc.Bylines(context.Background(), domain.Article{
+		Id:     1,
+		Author: domain.Author{Id: 1, Name: "所有者"},
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []domain.Author{
+		{Id: 1, Name: "所有者"},
+		{Id: 2, Name: "编辑"},
+	}, res)
+}
+
+func Test_articleService_BylinesCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// 缓存里面带着署名，不查协作者
+	svc := NewArticleService(nil, nil, repomocks.NewMockCollaboratorRepository(ctrl), nil, nil, nil, nil)
+	bylines := []domain.Author{
+		{Id: 1, Name: "所有者"},
+		{Id: 2, Name: "编辑"},
+	}
+	res, err := svc.Bylines(context.Background(), domain.Article{
+		Id:      1,
+		Author:  domain.Author{Id: 1, Name: "所有者"},
+		Bylines: bylines,
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, bylines, res)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"we_book/internal/domain"
+	"we_book/pkg/logger"
+	"we_book/pkg/markdown"
+)
+
+func (asv *articleService) Render(ctx context.Context, art domain.Article) (domain.RenderedContent, error) {
+	// 没有版本号的老数据每次都渲染
+	if art.RevisionId > 0 {
+		res, err := asv.repo.GetRendered(ctx, art.RevisionId)
+		if err == nil {
+			return res, nil
+		}
+	}
+	doc, err := markdown.Render(art.Content)
+	if err != nil {
+		return domain.RenderedContent{}, err
+	}
+	res := domain.NewRenderedContent(doc)
+	if art.RevisionId > 0 {
+		er := asv.repo.SetRendered(ctx, art.RevisionId, res)
+		if er != nil {
+			asv.l.Error("set rendered content cache error",
+				logger.Int64("aid", art.Id),
+				logger.Int64("revision_id", art.RevisionId),
+				logger.Error(er))
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/pkg/logger"
+)
+
+var (
+	// ErrArticlePendingReview 没有通过自动审核，草稿已经保存，等人工复审通过之后才会发表
+	ErrArticlePendingReview = errors.New("article is pending review")
+	// ErrReviewNotPending 复审已经处理过了，或者作者在这期间又修改了文章
+	ErrReviewNotPending = errors.New("review is not pending")
+	// ErrInvalidReviewReason 驳回的时候要告诉作者原因
+	ErrInvalidReviewReason = errors.New("reject reason is required")
+	ErrReviewNotFound      = repository.ErrReviewNotFound
+)
+
+// moderate 自动审核，没有通过的话保存草稿并提交复审，返回 ErrArticlePendingReview
+func (asv *articleService) moderate(ctx context.Context, art *domain.Article) (bool, error) {
+	if asv.moderator == nil {
+		return true, nil
+	}
+	res, err := asv.moderator.Moderate(ctx, *art)
+	if err != nil {
+		return false, err
+	}
+	if res.Pass {
+		return true, nil
+	}
+	art.Status = domain.ArticleStatusPendingReview
+	if art.Id > 0 {
+		err = asv.update(ctx, *art)
+	} else {
+		art.Id, err = asv.create(ctx, *art)
+	}
+	if err != nil {
+		return false, err
+	}
+	asv.l.Info("article pending review",
+		logger.Int64("aid", art.Id),
+		logger.String("hits", strings.Join(res.Hits, ",")))
+	err = asv.reviewRepo.Submit(ctx, domain.ArticleReview{
+		ArticleId: art.Id,
+		Author:    art.Author,
+		Status:    domain.ReviewStatusPending,
+		Hits:      res.Hits,
+	})
+	if err != nil {
+		return false, err
+	}
+	return false, ErrArticlePendingReview
+}
+
+func (asv *articleService) ListReviews(ctx context.Context, status domain.ReviewStatus, offset int, limit int) ([]domain.ArticleReview, error) {
+	return asv.reviewRepo.ListByStatus(ctx, status, offset, limit)
+}
+
+func (asv *articleService) Approve(ctx context.Context, aid, reviewer int64) error {
+	art, err := asv.pendingReview(ctx, aid, reviewer)
+	if err != nil {
+		return err
+	}
+	art.Status = domain.ArticleStatusPublished
+	_, err = asv.repo.Sync(ctx, art)
+	if err != nil {
+		return err
+	}
+	return asv.finishReview(ctx, aid, domain.ReviewStatusApproved, reviewer, "")
+}
+
+func (asv *articleService) Reject(ctx context.Context, aid, reviewer int64, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrInvalidReviewReason
+	}
+	art, err := asv.pendingReview(ctx, aid, reviewer)
+	if err != nil {
+		return err
+	}
+	// 线上库不动，之前发表过的版本还在
+	err = asv.repo.UpdateStatus(ctx, aid, art.Author.Id, domain.ArticleStatusRejected)
+	if err != nil {
+		return err
+	}
+	return asv.finishReview(ctx, aid, domain.ReviewStatusRejected, reviewer, reason)
+}
+
+func (asv *articleService) GetReview(ctx context.Context, aid, uid int64) (domain.ArticleReview, error) {
+	_, err := asv.authorize(ctx, aid, uid, domain.CollaboratorRole.CanView)
+	if err != nil {
+		return domain.ArticleReview{}, err
+	}
+	return asv.reviewRepo.Get(ctx, aid)
+}
+
+// pendingReview 返回等待复审的草稿
+// 作者在复审之前修改、删除了文章，复审就作废了，修改之后再发表会重新审核
+func (asv *articleService) pendingReview(ctx context.Context, aid, reviewer int64) (domain.Article, error) {
+	review, err := asv.reviewRepo.Get(ctx, aid)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if review.Status != domain.ReviewStatusPending {
+		return domain.Article{}, ErrReviewNotPending
+	}
+	art, err := asv.repo.GetById(ctx, aid)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if art.Status != domain.ArticleStatusPendingReview {
+		err = asv.finishReview(ctx, aid, domain.ReviewStatusCancelled, reviewer, "")
+		if err != nil {
+			return domain.Article{}, err
+		}
+		return domain.Article{}, ErrReviewNotPending
+	}
+	return art, nil
+}
+
+func (asv *articleService) finishReview(ctx context.Context, aid int64, to domain.ReviewStatus, reviewer int64, reason string) error {
+	err := asv.reviewRepo.Finish(ctx, aid, domain.ReviewStatusPending, to, reviewer, reason)
+	if errors.Is(err, repository.ErrReviewNotFound) {
+		// 别的审核员同时处理了
+		return ErrReviewNotPending
+	}
+	return err
+}
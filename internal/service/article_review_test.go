@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	articlerepomock "we_book/internal/repository/article/mocks"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/internal/service/moderation"
+	"we_book/pkg/logger"
+)
+
+func Test_articleService_PublishModeration(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository)
+
+		art domain.Article
+
+		wantedErr error
+		wantedId  int64
+	}{
+		{
+			name: "没有命中敏感词，直接发表",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().Sync(gomock.Any(), domain.Article{
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPublished,
+				}).Return(int64(1), nil)
+				return repo, repomocks.NewMockReviewRepository(ctrl)
+			},
+			art: domain.Article{
+				Title:   "我的标题",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 123},
+			},
+			wantedId: 1,
+		},
+		{
+			name: "新建的文章命中敏感词，保存草稿等待复审",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain.Article{
+					Title:   "我的标题",
+					Content: "这里有赌 博",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPendingReview,
+				}).Return(int64(1), nil)
+				reviewRepo.EXPECT().Submit(gomock.Any(), domain.ArticleReview{
+					ArticleId: 1,
+					Author:    domain.Author{Id: 123},
+					Status:    domain.ReviewStatusPending,
+					Hits:      []string{"赌博"},
+				}).Return(nil)
+				return repo, reviewRepo
+			},
+			art: domain.Article{
+				Title:   "我的标题",
+				Content: "这里有赌 博",
+				Author:  domain.Author{Id: 123},
+			},
+			wantedId:  1,
+			wantedErr: ErrArticlePendingReview,
+		},
+		{
+			name: "修改的文章命中敏感词，更新草稿等待复审",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      2,
+					Title:   "赌博",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusPendingReview,
+				}).Return(nil)
+				reviewRepo.EXPECT().Submit(gomock.Any(), domain.ArticleReview{
+					ArticleId: 2,
+					Author:    domain.Author{Id: 123},
+					Status:    domain.ReviewStatusPending,
+					Hits:      []string{"赌博"},
+				}).Return(nil)
+				return repo, reviewRepo
+			},
+			art: domain.Article{
+				Id:      2,
+				Title:   "赌博",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 123},
+			},
+			wantedId:  2,
+			wantedErr: ErrArticlePendingReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, reviewRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, reviewRepo,
+				moderation.NewKeywordModerator([]string{"赌博"}), logger.NewNoLogger(), nil)
+			id, err := svc.Publish(context.Background(), tc.art)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedId, id)
+		})
+	}
+}
+
+func Test_articleService_Approve(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository)
+
+		wantedErr error
+	}{
+		{
+			name: "通过之后发表",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				reviewRepo.EXPECT().Get(gomock.Any(), int64(1)).
+					Return(domain.ArticleReview{ArticleId: 1, Status: domain.ReviewStatusPending}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Title:  "我的标题",
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPendingReview,
+				}, nil)
+				repo.EXPECT().Sync(gomock.Any(), domain.Article{
+					Id:     1,
+					Title:  "我的标题",
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished,
+				}).Return(int64(1), nil)
+				reviewRepo.EXPECT().Finish(gomock.Any(), int64(1), domain.ReviewStatusPending,
+					domain.ReviewStatusApproved, int64(9), "").Return(nil)
+				return repo, reviewRepo
+			},
+		},
+		{
+			name: "作者已经修改了文章，复审作废",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				reviewRepo.EXPECT().Get(gomock.Any(), int64(1)).
+					Return(domain.ArticleReview{ArticleId: 1, Status: domain.ReviewStatusPending}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusUnpublished,
+				}, nil)
+				reviewRepo.EXPECT().Finish(gomock.Any(), int64(1), domain.ReviewStatusPending,
+					domain.ReviewStatusCancelled, int64(9), "").Return(nil)
+				return repo, reviewRepo
+			},
+			wantedErr: ErrReviewNotPending,
+		},
+		{
+			name: "已经处理过了",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				reviewRepo.EXPECT().Get(gomock.Any(), int64(1)).
+					Return(domain.ArticleReview{ArticleId: 1, Status: domain.ReviewStatusRejected}, nil)
+				return articlerepomock.NewMockArticleRepository(ctrl), reviewRepo
+			},
+			wantedErr: ErrReviewNotPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, reviewRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, reviewRepo, nil, logger.NewNoLogger(), nil)
+			err := svc.Approve(context.Background(), 1, 9)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleService_Reject(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository)
+
+		reason string
+
+		wantedErr error
+	}{
+		{
+			name: "驳回，作者能看到原因",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				reviewRepo.EXPECT().Get(gomock.Any(), int64(1)).
+					Return(domain.ArticleReview{ArticleId: 1, Status: domain.ReviewStatusPending}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPendingReview,
+				}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), int64(123),
+					domain.ArticleStatusRejected).Return(nil)
+				reviewRepo.EXPECT().Finish(gomock.Any(), int64(1), domain.ReviewStatusPending,
+					domain.ReviewStatusRejected, int64(9), "包含赌博内容").Return(nil)
+				return repo, reviewRepo
+			},
+			reason: " 包含赌博内容 ",
+		},
+		{
+			name: "没有原因",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				return articlerepomock.NewMockArticleRepository(ctrl), repomocks.NewMockReviewRepository(ctrl)
+			},
+			reason:    "  ",
+			wantedErr: ErrInvalidReviewReason,
+		},
+		{
+			name: "别的审核员同时处理了",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.ReviewRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockReviewRepository(ctrl)
+				reviewRepo.EXPECT().Get(gomock.Any(), int64(1)).
+					Return(domain.ArticleReview{ArticleId: 1, Status: domain.ReviewStatusPending}, nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPendingReview,
+				}, nil)
+				repo.EXPECT().UpdateStatus(gomock.Any(), int64(1), int64(123),
+					domain.ArticleStatusRejected).Return(nil)
+				reviewRepo.EXPECT().Finish(gomock.Any(), int64(1), domain.ReviewStatusPending,
+					domain.ReviewStatusRejected, int64(9), "违规").Return(repository.ErrReviewNotFound)
+				return repo, reviewRepo
+			},
+			reason:    "违规",
+			wantedErr: ErrReviewNotPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, reviewRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, reviewRepo, nil, logger.NewNoLogger(), nil)
+			err := svc.Reject(context.Background(), 1, 9, tc.reason)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
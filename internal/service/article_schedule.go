@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+)
+
+// PublishArticleExecutor 定时发布任务使用的 Executor 名字
+const PublishArticleExecutor = "publish_article"
+
+var (
+	ErrInvalidPublishTime = errors.New("publish time must be in the future")
+	// ErrScheduleNotPending 定时发布已经在执行，或者已经被取消了
+	ErrScheduleNotPending = repository.ErrJobNotWaiting
+)
+
+// PublishArticleJobCfg 定时发布任务的 Cfg
+type PublishArticleJobCfg struct {
+	Aid int64 `json:"aid"`
+	Uid int64 `json:"uid"`
+}
+
+func publishJobName(aid int64) string {
+	return fmt.Sprintf("publish_article:%d", aid)
+}
+
+func (asv *articleService) SchedulePublish(ctx context.Context, art domain.Article, publishAt time.Time) (int64, error) {
+	if !publishAt.After(time.Now()) {
+		return 0, ErrInvalidPublishTime
+	}
+	if err := normalizeTaxonomy(&art); err != nil {
+		return 0, err
+	}
+	// 和发表一样，编辑设置的定时发布也是以所有者的身份
+	if err := asv.asOwner(ctx, &art); err != nil {
+		return 0, err
+	}
+	art.Status = domain.ArticleStatusScheduled
+	var (
+		id  = art.Id
+		err error
+	)
+	if id > 0 {
+		err = asv.repo.Update(ctx, art)
+	} else {
+		id, err = asv.repo.Create(ctx, art)
+	}
+	if err != nil {
+		return 0, err
+	}
+	cfg, err := json.Marshal(PublishArticleJobCfg{Aid: id, Uid: art.Author.Id})
+	if err != nil {
+		return 0, err
+	}
+	// 同一篇文章只会有一个定时发布，重复设置会覆盖前一次
+	return id, asv.jobRepo.Upsert(ctx, domain.Job{
+		Name:         publishJobName(id),
+		Executor:     PublishArticleExecutor,
+		Cfg:          string(cfg),
+		NextExecTime: publishAt,
+	})
+}
+
+func (asv *articleService) ListScheduled(ctx context.Context, uid int64, offset int, limit int) ([]domain.ScheduledPublication, error) {
+	arts, err := asv.repo.ListByStatus(ctx, uid, domain.ArticleStatusScheduled, offset, limit)
+	if err != nil || len(arts) == 0 {
+		return nil, err
+	}
+	names := slice.Map(arts, func(idx int, src domain.Article) string {
+		return publishJobName(src.Id)
+	})
+	jobs, err := asv.jobRepo.GetByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	publishAt := make(map[string]time.Time, len(jobs))
+	for _, j := range jobs {
+		publishAt[j.Name] = j.NextExecTime
+	}
+	res := make([]domain.ScheduledPublication, 0, len(arts))
+	for _, art := range arts {
+		at, ok := publishAt[publishJobName(art.Id)]
+		if !ok {
+			// 任务已经被删掉了，但是文章状态还没改过来
+			continue
+		}
+		res = append(res, domain.ScheduledPublication{
+			Article:   art,
+			PublishAt: at,
+		})
+	}
+	return res, nil
+}
+
+func (asv *articleService) Reschedule(ctx context.Context, aid, uid int64, publishAt time.Time) error {
+	if !publishAt.After(time.Now()) {
+		return ErrInvalidPublishTime
+	}
+	err := asv.checkAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	return asv.jobRepo.RescheduleWaiting(ctx, publishJobName(aid), publishAt)
+}
+
+func (asv *articleService) CancelSchedule(ctx context.Context, aid, uid int64) error {
+	err := asv.checkAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	// 先删任务，删不掉说明已经在发布了
+	err = asv.jobRepo.DeleteWaiting(ctx, publishJobName(aid))
+	if err != nil {
+		return err
+	}
+	return asv.repo.UpdateStatus(ctx, aid, uid, domain.ArticleStatusUnpublished)
+}
+
+func (asv *articleService) AbortSchedule(ctx context.Context, aid, uid int64) error {
+	art, err := asv.getByAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	if art.Status != domain.ArticleStatusScheduled {
+		// 已经发表了，或者作者改过了
+		return nil
+	}
+	return asv.repo.UpdateStatus(ctx, aid, uid, domain.ArticleStatusUnpublished)
+}
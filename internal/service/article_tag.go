@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+	"we_book/internal/domain"
+)
+
+const (
+	// maxTagCnt 一篇文章最多的标签数
+	maxTagCnt = 5
+	// maxTagLen 标签和分类名字的最大长度，按字符算
+	maxTagLen = 32
+)
+
+var ErrInvalidTaxonomy = errors.New("too many tags or tag/category is too long")
+
+// normalizeTaxonomy 去掉标签首尾的空白，统一转成小写并去重
+func normalizeTaxonomy(art *domain.Article) error {
+	art.Category = strings.TrimSpace(art.Category)
+	if utf8.RuneCountInString(art.Category) > maxTagLen {
+		return ErrInvalidTaxonomy
+	}
+	if len(art.Tags) == 0 {
+		return nil
+	}
+	tags := make([]string, 0, len(art.Tags))
+	seen := make(map[string]struct{}, len(art.Tags))
+	for _, tag := range art.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTagLen {
+			return ErrInvalidTaxonomy
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxTagCnt {
+		return ErrInvalidTaxonomy
+	}
+	art.Tags = tags
+	return nil
+}
+
+func (asv *articleService) ListPubByTag(ctx context.Context, tag string, offset int, limit int) ([]domain.Article, error) {
+	return asv.repo.ListPubByTag(ctx, strings.ToLower(strings.TrimSpace(tag)), offset, limit)
+}
+
+func (asv *articleService) ListPubByCategory(ctx context.Context, category string, offset int, limit int) ([]domain.Article, error) {
+	return asv.repo.ListPubByCategory(ctx, strings.TrimSpace(category), offset, limit)
+}
+
+func (asv *articleService) PopularTags(ctx context.Context, limit int) ([]domain.Tag, error) {
+	return asv.repo.PopularTags(ctx, limit)
+}
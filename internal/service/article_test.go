@@ -5,10 +5,15 @@ import (
 	"errors"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
+	"strings"
 	"testing"
+	"time"
 	"we_book/internal/domain"
+	"we_book/internal/repository"
 	"we_book/internal/repository/article"
 	articlerepomock "we_book/internal/repository/article/mocks"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/pkg/logger"
 )
 
 func Test_articleService_Publish(t *testing.T) {
@@ -176,3 +181,499 @@ func Test_articleService_Publish(t *testing.T) {
 		})
 	}
 }
+
+func Test_articleService_Rollback(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) article.ArticleRepository
+
+		aid        int64
+		uid        int64
+		revisionId int64
+
+		wantedErr error
+	}{
+		{
+			name: "回滚成功",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo.EXPECT().GetRevisionById(gomock.Any(), int64(10)).Return(domain.ArticleRevision{
+					Id:        10,
+					ArticleId: 1,
+					Version:   2,
+					Title:     "旧的标题",
+					Content:   "旧的内容",
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "旧的标题",
+					Content: "旧的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+				}).Return(nil)
+				return repo
+			},
+			aid:        1,
+			uid:        123,
+			revisionId: 10,
+		},
+		{
+			name: "不是作者",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 456},
+				}, nil)
+				return repo
+			},
+			aid:        1,
+			uid:        123,
+			revisionId: 10,
+			wantedErr:  ErrNotArticleAuthor,
+		},
+		{
+			name: "版本不属于这篇文章",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo.EXPECT().GetRevisionById(gomock.Any(), int64(10)).Return(domain.ArticleRevision{
+					Id:        10,
+					ArticleId: 2,
+				}, nil)
+				return repo
+			},
+			aid:        1,
+			uid:        123,
+			revisionId: 10,
+			wantedErr:  ErrInvalidRevision,
+		},
+		{
+			name: "版本不存在",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				repo.EXPECT().GetRevisionById(gomock.Any(), int64(10)).
+					Return(domain.ArticleRevision{}, article.ErrRevisionNotFound)
+				return repo
+			},
+			aid:        1,
+			uid:        123,
+			revisionId: 10,
+			wantedErr:  ErrInvalidRevision,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewArticleService(tc.mock(ctrl), nil, nil, nil, nil, nil, nil)
+			err := svc.Rollback(context.Background(), tc.aid, tc.uid, tc.revisionId)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleService_SchedulePublish(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository, repository.CollaboratorRepository)
+
+		art       domain.Article
+		publishAt time.Time
+
+		wantedId  int64
+		wantedErr error
+	}{
+		{
+			name: "新建并定时发布",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), domain.Article{
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusScheduled,
+				}).Return(int64(1), nil)
+				jobRepo := repomocks.NewMockJobRepository(ctrl)
+				jobRepo.EXPECT().Upsert(gomock.Any(), domain.Job{
+					Name:         "publish_article:1",
+					Executor:     PublishArticleExecutor,
+					Cfg:          `{"aid":1,"uid":123}`,
+					NextExecTime: publishAt,
+				}).Return(nil)
+				return repo, jobRepo, repomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			art: domain.Article{
+				Title:   "我的标题",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 123},
+			},
+			publishAt: publishAt,
+			wantedId:  1,
+		},
+		{
+			name: "编辑定时发布，用所有者的身份",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(2), int64(456)).Return(domain.Collaborator{
+					ArticleId: 2,
+					User:      domain.Author{Id: 456},
+					Role:      domain.CollaboratorRoleEditor,
+					Status:    domain.InvitationStatusAccepted,
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      2,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusScheduled,
+					Editor:  domain.Author{Id: 456},
+				}).Return(nil)
+				jobRepo := repomocks.NewMockJobRepository(ctrl)
+				jobRepo.EXPECT().Upsert(gomock.Any(), domain.Job{
+					Name:         "publish_article:2",
+					Executor:     PublishArticleExecutor,
+					Cfg:          `{"aid":2,"uid":123}`,
+					NextExecTime: publishAt,
+				}).Return(nil)
+				return repo, jobRepo, collabRepo
+			},
+			art: domain.Article{
+				Id:      2,
+				Title:   "我的标题",
+				Content: "我的内容",
+				Author:  domain.Author{Id: 456},
+			},
+			publishAt: publishAt,
+			wantedId:  2,
+		},
+		{
+			name: "不是作者也不是协作者",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository, repository.CollaboratorRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				collabRepo := repomocks.NewMockCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+					Id:     2,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collabRepo.EXPECT().Get(gomock.Any(), int64(2), int64(789)).
+					Return(domain.Collaborator{}, repository.ErrCollaboratorNotFound)
+				return repo, repomocks.NewMockJobRepository(ctrl), collabRepo
+			},
+			art: domain.Article{
+				Id:     2,
+				Title:  "我的标题",
+				Author: domain.Author{Id: 789},
+			},
+			publishAt: publishAt,
+			wantedErr: ErrArticlePermissionDenied,
+		},
+		{
+			name: "发布时间已经过去",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository, repository.CollaboratorRepository) {
+				return articlerepomock.NewMockArticleRepository(ctrl),
+					repomocks.NewMockJobRepository(ctrl),
+					repomocks.NewMockCollaboratorRepository(ctrl)
+			},
+			art: domain.Article{
+				Title:  "我的标题",
+				Author: domain.Author{Id: 123},
+			},
+			publishAt: time.Now().Add(-time.Minute),
+			wantedErr: ErrInvalidPublishTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, jobRepo, collabRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, jobRepo, collabRepo, nil, nil, nil, nil)
+			id, err := svc.SchedulePublish(context.Background(), tc.art, tc.publishAt)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedId, id)
+		})
+	}
+}
+
+func Test_normalizeTaxonomy(t *testing.T) {
+	testCases := []struct {
+		name string
+		art  domain.Article
+
+		wantArt domain.Article
+		wantErr error
+	}{
+		{
+			name: "去重并转成小写",
+			art: domain.Article{
+				Category: " 后端 ",
+				Tags:     []string{"Go", " go", "", "MySQL", "  "},
+			},
+			wantArt: domain.Article{
+				Category: "后端",
+				Tags:     []string{"go", "mysql"},
+			},
+		},
+		{
+			name: "没有标签",
+			art: domain.Article{
+				Category: "后端",
+			},
+			wantArt: domain.Article{
+				Category: "后端",
+			},
+		},
+		{
+			name: "标签太多",
+			art: domain.Article{
+				Tags: []string{"a", "b", "c", "d", "e", "f"},
+			},
+			wantErr: ErrInvalidTaxonomy,
+		},
+		{
+			name: "标签太长",
+			art: domain.Article{
+				Tags: []string{strings.Repeat("标", 33)},
+			},
+			wantErr: ErrInvalidTaxonomy,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			art := tc.art
+			err := normalizeTaxonomy(&art)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantArt, art)
+		})
+	}
+}
+
+func Test_articleService_Trash(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository)
+
+		aid int64
+		uid int64
+
+		wantedErr error
+	}{
+		{
+			name: "不是作者",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 456},
+				}, nil)
+				return repo, repomocks.NewMockJobRepository(ctrl)
+			},
+			aid:       1,
+			uid:       123,
+			wantedErr: ErrNotArticleAuthor,
+		},
+		{
+			name: "已经在回收站",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusTrashed,
+				}, nil)
+				return repo, repomocks.NewMockJobRepository(ctrl)
+			},
+			aid: 1,
+			uid: 123,
+		},
+		{
+			name: "定时发布正在执行",
+			mock: func(ctrl *gomock.Controller) (article.ArticleRepository, repository.JobRepository) {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusScheduled,
+				}, nil)
+				jobRepo := repomocks.NewMockJobRepository(ctrl)
+				jobRepo.EXPECT().DeleteWaiting(gomock.Any(), "publish_article:1").
+					Return(repository.ErrJobNotWaiting)
+				return repo, jobRepo
+			},
+			aid:       1,
+			uid:       123,
+			wantedErr: ErrScheduleNotPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, jobRepo := tc.mock(ctrl)
+			svc := NewArticleService(repo, jobRepo, nil, nil, nil, nil, nil)
+			err := svc.Trash(context.Background(), tc.aid, tc.uid)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleService_Restore(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) article.ArticleRepository
+
+		aid int64
+		uid int64
+
+		wantedErr error
+	}{
+		{
+			name: "恢复成功",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusTrashed,
+					Dtime:  time.Now().Add(-time.Hour),
+				}, nil)
+				repo.EXPECT().Restore(gomock.Any(), int64(1), int64(123)).Return(nil)
+				return repo
+			},
+			aid: 1,
+			uid: 123,
+		},
+		{
+			name: "不在回收站",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished,
+				}, nil)
+				return repo
+			},
+			aid:       1,
+			uid:       123,
+			wantedErr: ErrNotInTrash,
+		},
+		{
+			name: "超过保留期",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusTrashed,
+					Dtime:  time.Now().Add(-TrashRetention - time.Hour),
+				}, nil)
+				return repo
+			},
+			aid:       1,
+			uid:       123,
+			wantedErr: ErrTrashExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewArticleService(tc.mock(ctrl), nil, nil, nil, nil, nil, nil)
+			err := svc.Restore(context.Background(), tc.aid, tc.uid)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleService_Render(t *testing.T) {
+	rendered := domain.RenderedContent{
+		HTML:     "<h1 id=\"title\">Title</h1>\n<p>body</p>\n",
+		TOC:      []domain.TOCItem{{Level: 1, Id: "title", Title: "Title"}},
+		Abstract: "Title body",
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) article.ArticleRepository
+
+		art domain.Article
+
+		wantedRes domain.RenderedContent
+		wantedErr error
+	}{
+		{
+			name: "命中缓存",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetRendered(gomock.Any(), int64(10)).Return(rendered, nil)
+				return repo
+			},
+			art:       domain.Article{Id: 1, RevisionId: 10},
+			wantedRes: rendered,
+		},
+		{
+			name: "没有命中缓存，渲染之后回写",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetRendered(gomock.Any(), int64(10)).
+					Return(domain.RenderedContent{}, errors.New("redis: nil"))
+				repo.EXPECT().SetRendered(gomock.Any(), int64(10), rendered).
+					Return(errors.New("回写失败也能返回"))
+				return repo
+			},
+			art: domain.Article{
+				Id:         1,
+				RevisionId: 10,
+				Content:    "# Title\n\nbody<script>alert(1)</script>",
+			},
+			wantedRes: rendered,
+		},
+		{
+			name: "没有版本号不走缓存",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				return articlerepomock.NewMockArticleRepository(ctrl)
+			},
+			art: domain.Article{
+				Id:      1,
+				Content: "# Title\n\nbody",
+			},
+			wantedRes: rendered,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewArticleService(tc.mock(ctrl), nil, nil, nil, nil, logger.NewNoLogger(), nil)
+			res, err := svc.Render(context.Background(), tc.art)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedRes, res)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/pkg/blobx"
+	"we_book/pkg/logger"
+	"we_book/pkg/mdbundle"
+)
+
+// ArticleTransferExecutor 导入导出任务使用的 Executor 名字
+const ArticleTransferExecutor = "article_transfer"
+
+const (
+	// transferProgressBatch 每处理这么多篇文章更新一次进度
+	transferProgressBatch = 20
+	// transferMaxErrors 任务里面最多记录这么多条失败原因
+	transferMaxErrors = 50
+	exportPageSize    = 100
+)
+
+var (
+	ErrInvalidBundle         = errors.New("invalid article bundle")
+	ErrInvalidTransferFormat = errors.New("invalid transfer format")
+	ErrTransferTaskNotFound  = repository.ErrTransferTaskNotFound
+	// ErrTransferNotReady 导出还没有完成，或者不是导出任务
+	ErrTransferNotReady = errors.New("transfer task is not ready")
+
+	errImportNoTitle = errors.New("title is empty")
+)
+
+// ArticleTransferJobCfg 导入导出任务的 Cfg
+type ArticleTransferJobCfg struct {
+	TaskId int64 `json:"task_id"`
+}
+
+//go:generate mockgen -source=article_transfer.go -destination=mocks/article_transfer.mock.go -package=svcmocks
+type ArticleTransferService interface {
+	// Import 保存上传的压缩包，交给后台任务导入，返回任务 ID
+	Import(ctx context.Context, uid int64, bundle []byte) (int64, error)
+	// Export 交给后台任务导出作者所有的文章，返回任务 ID
+	Export(ctx context.Context, uid int64, format domain.TransferFormat) (int64, error)
+	// GetTask 查询任务进度，只能查自己的任务
+	GetTask(ctx context.Context, id, uid int64) (domain.TransferTask, error)
+	// Download 返回导出好的压缩包和它的长度，用完之后要 Close
+	Download(ctx context.Context, id, uid int64) (io.ReadCloser, int64, error)
+	// Run 在后台任务里面执行导入或者导出
+	Run(ctx context.Context, id int64) error
+}
+
+type articleTransferService struct {
+	svc     ArticleService
+	repo    repository.TransferTaskRepository
+	jobRepo repository.JobRepository
+	// blob 存放上传和导出的压缩包
+	blob blobx.Storage
+	l    logger.V1
+}
+
+func NewArticleTransferService(svc ArticleService,
+	repo repository.TransferTaskRepository,
+	jobRepo repository.JobRepository,
+	blob blobx.Storage,
+	l logger.V1) ArticleTransferService {
+	return &articleTransferService{
+		svc:     svc,
+		repo:    repo,
+		jobRepo: jobRepo,
+		blob:    blob,
+		l:       l,
+	}
+}
+
+func (a *articleTransferService) Import(ctx context.Context, uid int64, bundle []byte) (int64, error) {
+	// 先确认是一个压缩包，里面的文章在后台任务里面解析
+	_, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	if err != nil {
+		return 0, ErrInvalidBundle
+	}
+	id, err := a.repo.Create(ctx, domain.TransferTask{
+		Uid:    uid,
+		Type:   domain.TransferTypeImport,
+		Status: domain.TransferStatusPending,
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = a.blob.Put(ctx, importBundleKey(id), bundle)
+	if err != nil {
+		a.abort(ctx, id, err)
+		return 0, err
+	}
+	return id, a.schedule(ctx, id)
+}
+
+func (a *articleTransferService) Export(ctx context.Context, uid int64, format domain.TransferFormat) (int64, error) {
+	if format != domain.TransferFormatMarkdown && format != domain.TransferFormatJSON {
+		return 0, ErrInvalidTransferFormat
+	}
+	id, err := a.repo.Create(ctx, domain.TransferTask{
+		Uid:    uid,
+		Type:   domain.TransferTypeExport,
+		Format: format,
+		Status: domain.TransferStatusPending,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, a.schedule(ctx, id)
+}
+
+func (a *articleTransferService) schedule(ctx context.Context, id int64) error {
+	cfg, err := json.Marshal(ArticleTransferJobCfg{TaskId: id})
+	if err != nil {
+		return err
+	}
+	err = a.jobRepo.Upsert(ctx, domain.Job{
+		Name:         fmt.Sprintf("article_transfer:%d", id),
+		Executor:     ArticleTransferExecutor,
+		Cfg:          string(cfg),
+		NextExecTime: time.Now(),
+	})
+	if err != nil {
+		a.abort(ctx, id, err)
+	}
+	return err
+}
+
+// abort 任务没能交给后台执行，直接标记成失败，免得一直是等待中
+func (a *articleTransferService) abort(ctx context.Context, id int64, cause error) {
+	err := a.repo.Finish(ctx, id, domain.TransferStatusFailed, cause.Error())
+	if err != nil {
+		a.l.Error("mark transfer task failed error",
+			logger.Int64("task_id", id),
+			logger.Error(err))
+	}
+}
+
+func (a *articleTransferService) GetTask(ctx context.Context, id, uid int64) (domain.TransferTask, error) {
+	task, err := a.repo.GetById(ctx, id)
+	if err != nil {
+		return domain.TransferTask{}, err
+	}
+	// 别人的任务当成不存在
+	if task.Uid != uid {
+		return domain.TransferTask{}, ErrTransferTaskNotFound
+	}
+	return task, nil
+}
+
+func (a *articleTransferService) Download(ctx context.Context, id, uid int64) (io.ReadCloser, int64, error) {
+	task, err := a.GetTask(ctx, id, uid)
+	if err != nil {
+		return nil, 0, err
+	}
+	if task.Type != domain.TransferTypeExport || task.Status != domain.TransferStatusDone {
+		return nil, 0, ErrTransferNotReady
+	}
+	return a.blob.Open(ctx, exportBundleKey(id))
+}
+
+func (a *articleTransferService) Run(ctx context.Context, id int64) error {
+	task, err := a.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	ok, err := a.repo.Start(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 导入不能重复执行，不然会导入两份
+		a.l.Warn("transfer task already started", logger.Int64("task_id", id))
+		return nil
+	}
+	switch task.Type {
+	case domain.TransferTypeImport:
+		err = a.runImport(ctx, task)
+	case domain.TransferTypeExport:
+		err = a.runExport(ctx, task)
+	default:
+		err = fmt.Errorf("unknown transfer type %d", task.Type)
+	}
+	status, msg := domain.TransferStatusDone, ""
+	if err != nil {
+		status, msg = domain.TransferStatusFailed, err.Error()
+	}
+	// 任务可能就是因为超时失败的，ctx 已经用不了了，结果还是要记下来，不然会一直是执行中
+	fctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*3)
+	defer cancel()
+	if er := a.repo.Finish(fctx, id, status, msg); er != nil && err == nil {
+		err = er
+	}
+	return err
+}
+
+func (a *articleTransferService) runImport(ctx context.Context, task domain.TransferTask) error {
+	key := importBundleKey(task.Id)
+	data, err := a.blob.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	results, err := mdbundle.Read(data)
+	if err != nil {
+		return ErrInvalidBundle
+	}
+	task.Total = len(results)
+	a.updateProgress(ctx, task)
+	for i, r := range results {
+		err = r.Err
+		if err == nil {
+			err = a.importOne(ctx, task.Uid, r.Entry)
+		}
+		a.record(&task, r.Entry.Name, err)
+		if (i+1)%transferProgressBatch == 0 {
+			a.updateProgress(ctx, task)
+		}
+	}
+	err = a.repo.UpdateProgress(ctx, task)
+	if err != nil {
+		return err
+	}
+	// 导入完了上传的压缩包就没用了
+	if err = a.blob.Delete(ctx, key); err != nil {
+		a.l.Warn("delete import bundle error",
+			logger.Int64("task_id", task.Id),
+			logger.Error(err))
+	}
+	return nil
+}
+
+// importOne 导入一篇文章，创建时间以导入的时间为准
+// front matter 里面的 date 只在定时发布的时候当成发布时间
+func (a *articleTransferService) importOne(ctx context.Context, uid int64, e mdbundle.Entry) error {
+	if strings.TrimSpace(e.Title) == "" {
+		return errImportNoTitle
+	}
+	art := domain.Article{
+		Title:    e.Title,
+		Content:  e.Content,
+		Category: e.Category,
+		Tags:     e.Tags,
+		Author: domain.Author{
+			Id: uid,
+		},
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	var err error
+	switch strings.ToLower(e.Status) {
+	case "", "draft", "unpublished", "private":
+		// 没法直接导入成仅自己可见，先当成草稿
+		_, err = a.svc.Save(ctx, art)
+	case "published":
+		_, err = a.svc.Publish(ctx, art)
+	case "scheduled":
+		if e.Date.After(time.Now()) {
+			_, err = a.svc.SchedulePublish(ctx, art, e.Date)
+		} else {
+			// 发布时间已经过了，交给作者自己决定
+			_, err = a.svc.Save(ctx, art)
+		}
+	default:
+		err = fmt.Errorf("unknown status %q", e.Status)
+	}
+	return err
+}
+
+// runExport 一边打包一边上传，压缩包不会整个放在内存里
+func (a *articleTransferService) runExport(ctx context.Context, task domain.TransferTask) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := a.writeExport(ctx, &task, pw)
+		// 打包失败的话上传那边会读到这个 error，不会留下不完整的压缩包
+		_ = pw.CloseWithError(err)
+		done <- err
+	}()
+	err := a.blob.PutReader(ctx, exportBundleKey(task.Id), pr)
+	// 上传失败了打包那边也要停下来，不然会一直卡在写上
+	_ = pr.CloseWithError(err)
+	if werr := <-done; werr != nil {
+		return werr
+	}
+	if err != nil {
+		return err
+	}
+	return a.repo.UpdateProgress(ctx, task)
+}
+
+func (a *articleTransferService) writeExport(ctx context.Context, task *domain.TransferTask, out io.Writer) error {
+	w := mdbundle.NewMarkdownWriter(out)
+	if task.Format == domain.TransferFormatJSON {
+		w = mdbundle.NewJSONWriter(out)
+	}
+	var cursor domain.ArticleCursor
+	for {
+		arts, err := a.svc.ListByCursor(ctx, task.Uid, cursor, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, art := range arts {
+			// 列表里面的内容可能只是摘要，要重新查一次完整的
+			full, err := a.svc.GetById(ctx, art.Id)
+			if err == nil {
+				err = w.Add(exportEntry(full))
+			}
+			if errors.Is(err, io.ErrClosedPipe) {
+				// 上传已经失败了
+				return err
+			}
+			a.record(task, fmt.Sprintf("%d", art.Id), err)
+		}
+		task.Total = task.Done + task.Failed
+		a.updateProgress(ctx, *task)
+		if len(arts) < exportPageSize {
+			break
+		}
+		cursor = domain.NextCursor(arts)
+	}
+	return w.Close()
+}
+
+// record 记录一篇文章的处理结果
+func (a *articleTransferService) record(task *domain.TransferTask, name string, err error) {
+	if err == nil {
+		task.Done++
+		return
+	}
+	task.Failed++
+	if len(task.Errors) < transferMaxErrors {
+		task.Errors = append(task.Errors, fmt.Sprintf("%s: %s", name, err))
+	}
+}
+
+// updateProgress 中间的进度更新失败不影响任务，最后一次失败才算失败
+func (a *articleTransferService) updateProgress(ctx context.Context, task domain.TransferTask) {
+	err := a.repo.UpdateProgress(ctx, task)
+	if err != nil {
+		a.l.Warn("update transfer progress error",
+			logger.Int64("task_id", task.Id),
+			logger.Error(err))
+	}
+}
+
+func exportEntry(art domain.Article) mdbundle.Entry {
+	return mdbundle.Entry{
+		Name: fmt.Sprintf("%d-%s.md", art.Id, exportFileName(art.Title)),
+		Meta: mdbundle.Meta{
+			Title:    art.Title,
+			Category: art.Category,
+			Tags:     art.Tags,
+			Status:   exportStatus(art.Status),
+			Date:     art.Ctime,
+			Updated:  art.Utime,
+		},
+		Content: art.Content,
+	}
+}
+
+func exportStatus(status domain.ArticleStatus) string {
+	switch status {
+	case domain.ArticleStatusPublished:
+		return "published"
+	case domain.ArticleStatusPrivate:
+		return "private"
+	case domain.ArticleStatusScheduled:
+		return "scheduled"
+	default:
+		return "draft"
+	}
+}
+
+// exportFileName 去掉文件名里面不能用的字符，太长的标题截断
+func exportFileName(title string) string {
+	const maxLen = 50
+	res := make([]rune, 0, maxLen)
+	for _, r := range title {
+		if len(res) == maxLen {
+			break
+		}
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < ' ' {
+			r = '_'
+		}
+		res = append(res, r)
+	}
+	return strings.TrimSpace(string(res))
+}
+
+func importBundleKey(id int64) string {
+	return fmt.Sprintf("transfer/import/%d.zip", id)
+}
+
+func exportBundleKey(id int64) string {
+	return fmt.Sprintf("transfer/export/%d.zip", id)
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	repomocks "we_book/internal/repository/mocks"
+	svcmocks "we_book/internal/service/mocks"
+	"we_book/pkg/blobx"
+	"we_book/pkg/logger"
+	"we_book/pkg/mdbundle"
+)
+
+func Test_articleTransferService_Import(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	var bundle bytes.Buffer
+	w := mdbundle.NewMarkdownWriter(&bundle)
+	for _, e := range []mdbundle.Entry{
+		{Name: "a.md", Meta: mdbundle.Meta{Title: "草稿", Tags: []string{"go"}}, Content: "内容 a"},
+		{Name: "b.md", Meta: mdbundle.Meta{Title: "已发表", Status: "published"}, Content: "内容 b"},
+		{Name: "c.md", Meta: mdbundle.Meta{Title: "定时", Status: "scheduled", Date: publishAt}, Content: "内容 c"},
+		{Name: "d.md", Meta: mdbundle.Meta{Title: "未知", Status: "deleted"}, Content: "内容 d"},
+	} {
+		if err := w.Add(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (ArticleService, repository.TransferTaskRepository)
+
+		wantedErr error
+	}{
+		{
+			name: "导入成功，部分文章失败",
+			mock: func(ctrl *gomock.Controller) (ArticleService, repository.TransferTaskRepository) {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Save(gomock.Any(), domain.Article{
+					Title:   "草稿",
+					Content: "内容 a",
+					Tags:    []string{"go"},
+					Author:  domain.Author{Id: 123},
+				}).Return(int64(1), nil)
+				svc.EXPECT().Publish(gomock.Any(), domain.Article{
+					Title:   "已发表",
+					Content: "内容 b",
+					Author:  domain.Author{Id: 123},
+				}).Return(int64(2), nil)
+				svc.EXPECT().SchedulePublish(gomock.Any(), domain.Article{
+					Title:   "定时",
+					Content: "内容 c",
+					Author:  domain.Author{Id: 123},
+				}, gomock.Any()).Return(int64(3), nil)
+				repo := repomocks.NewMockTransferTaskRepository(ctrl)
+				task := domain.TransferTask{
+					Id:     1,
+					Uid:    123,
+					Type:   domain.TransferTypeImport,
+					Status: domain.TransferStatusPending,
+				}
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(task, nil)
+				repo.EXPECT().Start(gomock.Any(), int64(1)).Return(true, nil)
+				task.Total = 4
+				repo.EXPECT().UpdateProgress(gomock.Any(), task).Return(nil)
+				task.Done = 3
+				task.Failed = 1
+				task.Errors = []string{`d.md: unknown status "deleted"`}
+				repo.EXPECT().UpdateProgress(gomock.Any(), task).Return(nil)
+				repo.EXPECT().Finish(gomock.Any(), int64(1), domain.TransferStatusDone, "").Return(nil)
+				return svc, repo
+			},
+		},
+		{
+			name: "已经执行过",
+			mock: func(ctrl *gomock.Controller) (ArticleService, repository.TransferTaskRepository) {
+				repo := repomocks.NewMockTransferTaskRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.TransferTask{
+					Id:     1,
+					Uid:    123,
+					Type:   domain.TransferTypeImport,
+					Status: domain.TransferStatusDone,
+				}, nil)
+				repo.EXPECT().Start(gomock.Any(), int64(1)).Return(false, nil)
+				return svcmocks.NewMockArticleService(ctrl), repo
+			},
+		},
+		{
+			name: "数据库错误",
+			mock: func(ctrl *gomock.Controller) (ArticleService, repository.TransferTaskRepository) {
+				repo := repomocks.NewMockTransferTaskRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.TransferTask{}, errors.New("mock db error"))
+				return svcmocks.NewMockArticleService(ctrl), repo
+			},
+			wantedErr: errors.New("mock db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			blob, err := blobx.NewLocalStorage(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = blob.Put(context.Background(), importBundleKey(1), bundle.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			svc, repo := tc.mock(ctrl)
+			tsvc := NewArticleTransferService(svc, repo, nil, blob, logger.NewNoLogger())
+			err = tsvc.Run(context.Background(), 1)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_articleTransferService_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	svc := svcmocks.NewMockArticleService(ctrl)
+	svc.EXPECT().ListByCursor(gomock.Any(), int64(123), domain.ArticleCursor{}, exportPageSize).
+		Return([]domain.Article{{Id: 2}, {Id: 1}}, nil)
+	svc.EXPECT().GetById(gomock.Any(), int64(2)).Return(domain.Article{
+		Id:       2,
+		Title:    "a/b",
+		Content:  "内容 2",
+		Category: "go",
+		Status:   domain.ArticleStatusPublished,
+		Ctime:    now,
+		Utime:    now,
+	}, nil)
+	svc.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{}, errors.New("mock db error"))
+
+	repo := repomocks.NewMockTransferTaskRepository(ctrl)
+	task := domain.TransferTask{
+		Id:     1,
+		Uid:    123,
+		Type:   domain.TransferTypeExport,
+		Format: domain.TransferFormatMarkdown,
+		Status: domain.TransferStatusPending,
+	}
+	repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(task, nil)
+	repo.EXPECT().Start(gomock.Any(), int64(1)).Return(true, nil)
+	task.Total = 2
+	task.Done = 1
+	task.Failed = 1
+	task.Errors = []string{"1: mock db error"}
+	repo.EXPECT().UpdateProgress(gomock.Any(), task).Times(2).Return(nil)
+	repo.EXPECT().Finish(gomock.Any(), int64(1), domain.TransferStatusDone, "").Return(nil)
+
+	blob, err := blobx.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsvc := NewArticleTransferService(svc, repo, nil, blob, logger.NewNoLogger())
+	err = tsvc.Run(context.Background(), 1)
+	assert.Equal(t, nil, err)
+
+	data, err := blob.Get(context.Background(), exportBundleKey(1))
+	assert.Equal(t, nil, err)
+	res, err := mdbundle.Read(data)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []mdbundle.Result{
+		{
+			Entry: mdbundle.Entry{
+				Name: "2-a_b.md",
+				Meta: mdbundle.Meta{
+					Title:    "a/b",
+					Category: "go",
+					Status:   "published",
+					Date:     now,
+					Updated:  now,
+				},
+				Content: "内容 2",
+			},
+		},
+	}, res)
+}
+
+func Test_articleTransferService_RunTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	svc := svcmocks.NewMockArticleService(ctrl)
+	svc.EXPECT().ListByCursor(gomock.Any(), int64(123), domain.ArticleCursor{}, exportPageSize).
+		DoAndReturn(func(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+			// 执行到一半超时了
+			cancel()
+			return nil, ctx.Err()
+		})
+	repo := repomocks.NewMockTransferTaskRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.TransferTask{
+		Id:     1,
+		Uid:    123,
+		Type:   domain.TransferTypeExport,
+		Format: domain.TransferFormatJSON,
+	}, nil)
+	repo.EXPECT().Start(gomock.Any(), int64(1)).Return(true, nil)
+	// 超时之后还是要把任务标记成失败
+	repo.EXPECT().Finish(gomock.Any(), int64(1), domain.TransferStatusFailed, context.Canceled.Error()).
+		DoAndReturn(func(ctx context.Context, id int64, status domain.TransferStatus, msg string) error {
+			return ctx.Err()
+		})
+
+	blob, err := blobx.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsvc := NewArticleTransferService(svc, repo, nil, blob, logger.NewNoLogger())
+	err = tsvc.Run(ctx, 1)
+	assert.Equal(t, context.Canceled, err)
+}
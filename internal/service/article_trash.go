@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"we_book/internal/domain"
+)
+
+// TrashRetention 回收站里的文章保留这么久，过期之后由清理任务彻底删除
+const TrashRetention = 30 * 24 * time.Hour
+
+var (
+	ErrNotInTrash   = errors.New("article is not in trash")
+	ErrTrashExpired = errors.New("article trash retention expired")
+)
+
+func (asv *articleService) Trash(ctx context.Context, aid, uid int64) error {
+	art, err := asv.getByAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	switch art.Status {
+	case domain.ArticleStatusTrashed:
+		// 重复删除
+		return nil
+	case domain.ArticleStatusScheduled:
+		// 先删定时发布的任务，删不掉说明已经在发布了
+		err = asv.jobRepo.DeleteWaiting(ctx, publishJobName(aid))
+		if err != nil {
+			return err
+		}
+	}
+	// 对读者来说和撤回一样，撤回事件由 DAO 写进发件箱
+	return asv.repo.Trash(ctx, aid, uid)
+}
+
+func (asv *articleService) ListTrash(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+	return asv.repo.ListByStatus(ctx, uid, domain.ArticleStatusTrashed, offset, limit)
+}
+
+// Restore 恢复之后是未发表的状态，需要作者重新发表
+func (asv *articleService) Restore(ctx context.Context, aid, uid int64) error {
+	art, err := asv.getByAuthor(ctx, aid, uid)
+	if err != nil {
+		return err
+	}
+	if art.Status != domain.ArticleStatusTrashed {
+		return ErrNotInTrash
+	}
+	if time.Since(art.Dtime) > TrashRetention {
+		// 已经过期，只是清理任务还没有删掉
+		return ErrTrashExpired
+	}
+	return asv.repo.Restore(ctx, aid, uid)
+}
+
+func (asv *articleService) ListExpiredTrash(ctx context.Context, limit int) ([]domain.Article, error) {
+	return asv.repo.ListTrashedBefore(ctx, time.Now().Add(-TrashRetention), limit)
+}
+
+// Purge 文章删掉之后协作者也没有意义了
+func (asv *articleService) Purge(ctx context.Context, aid int64) error {
+	err := asv.repo.Purge(ctx, aid)
+	if err != nil {
+		return err
+	}
+	return asv.collabRepo.DeleteByArticle(ctx, aid)
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	"we_book/pkg/feedx"
+	"we_book/pkg/logger"
+)
+
+const (
+	FeedFormatRSS  = "rss"
+	FeedFormatAtom = "atom"
+)
+
+var (
+	ErrInvalidFeedFormat  = errors.New("invalid feed format")
+	ErrFeedAuthorNotFound = repository.ErrUserNotFound
+)
+
+// FeedConfig 订阅源里面的站点信息
+type FeedConfig struct {
+	Title       string
+	Description string
+	// Link 站点的地址，文章链接是 Link/articles/pub/:id
+	Link string
+	// Size 订阅源里面最多有多少篇文章
+	Size int
+}
+
+//go:generate mockgen -source=feed.go -destination=mocks/feed.mock.go -package=svcmocks
+type FeedService interface {
+	// Feed uid 为 0 是全站的订阅源，否则是作者的
+	Feed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error)
+}
+
+type feedService struct {
+	repo     article.ArticleRepository
+	userRepo repository.UserRepository
+	cfg      FeedConfig
+	l        logger.V1
+}
+
+func NewFeedService(repo article.ArticleRepository, userRepo repository.UserRepository,
+	cfg FeedConfig, l logger.V1) FeedService {
+	return &feedService{
+		repo:     repo,
+		userRepo: userRepo,
+		cfg:      cfg,
+		l:        l,
+	}
+}
+
+// Feed 先查缓存，没有的话重新生成并回写
+func (f *feedService) Feed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	if format != FeedFormatRSS && format != FeedFormatAtom {
+		return domain.FeedDoc{}, ErrInvalidFeedFormat
+	}
+	doc, err := f.repo.GetFeed(ctx, format, uid)
+	if err == nil {
+		return doc, nil
+	}
+	doc, err = f.build(ctx, format, uid)
+	if err != nil {
+		return domain.FeedDoc{}, err
+	}
+	er := f.repo.SetFeed(ctx, format, uid, doc)
+	if er != nil {
+		f.l.Error("set feed cache error",
+			logger.String("format", format),
+			logger.Int64("uid", uid),
+			logger.Error(er))
+	}
+	return doc, nil
+}
+
+func (f *feedService) build(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	feed := feedx.Feed{
+		Title:       f.cfg.Title,
+		Link:        f.cfg.Link,
+		Description: f.cfg.Description,
+		Author:      f.cfg.Title,
+	}
+	var (
+		arts []domain.Article
+		err  error
+	)
+	if uid > 0 {
+		var u domain.User
+		u, err = f.userRepo.FindById(ctx, uid)
+		if err != nil {
+			return domain.FeedDoc{}, err
+		}
+		feed.Title = fmt.Sprintf("%s - %s", u.NickName, f.cfg.Title)
+		feed.Author = u.NickName
+		arts, err = f.repo.ListPubByAuthor(ctx, uid, 0, f.cfg.Size)
+	} else {
+		arts, err = f.repo.ListPub(ctx, time.Now(), 0, f.cfg.Size)
+	}
+	if err != nil {
+		return domain.FeedDoc{}, err
+	}
+	for _, art := range arts {
+		if art.Utime.After(feed.Updated) {
+			feed.Updated = art.Utime
+		}
+		feed.Items = append(feed.Items, feedx.Item{
+			Title:       art.Title,
+			Link:        fmt.Sprintf("%s/articles/pub/%d", f.cfg.Link, art.Id),
+			Description: art.Abstract(),
+			Categories:  art.Tags,
+			Published:   art.Ctime,
+			Updated:     art.Utime,
+		})
+	}
+	// 撤回、删除之后剩下的文章可能都比较旧，要用失效的时间，不然 Last-Modified 会往回走
+	modified, err := f.repo.FeedModified(ctx, uid)
+	if err != nil {
+		f.l.Error("get feed modified error",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+		modified = time.Now()
+	}
+	if modified.After(feed.Updated) {
+		feed.Updated = modified
+	}
+	if feed.Updated.IsZero() {
+		// 一篇文章都没有
+		feed.Updated = time.Now()
+	}
+	var data []byte
+	if format == FeedFormatRSS {
+		data, err = feedx.RSS(feed)
+	} else {
+		data, err = feedx.Atom(feed)
+	}
+	if err != nil {
+		return domain.FeedDoc{}, err
+	}
+	return domain.FeedDoc{
+		Content: data,
+		ETag:    fmt.Sprintf(`"%x"`, sha1.Sum(data)),
+		// HTTP 的时间只精确到秒
+		Modified: feed.Updated.Truncate(time.Second),
+	}, nil
+}
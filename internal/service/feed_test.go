@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository/article"
+	articlerepomock "we_book/internal/repository/article/mocks"
+	"we_book/pkg/logger"
+)
+
+func Test_feedService_Feed(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	arts := []domain.Article{
+		{Id: 1, Title: "旧文章", Content: "内容", Ctime: now.Add(-time.Hour * 2), Utime: now.Add(-time.Hour * 2)},
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) article.ArticleRepository
+
+		format string
+
+		wantedModified time.Time
+		wantedErr      error
+	}{
+		{
+			name: "命中缓存",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetFeed(gomock.Any(), FeedFormatRSS, int64(0)).
+					Return(domain.FeedDoc{Modified: now}, nil)
+				return repo
+			},
+			format:         FeedFormatRSS,
+			wantedModified: now,
+		},
+		{
+			name: "重新生成，按照最新的文章",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetFeed(gomock.Any(), FeedFormatAtom, int64(0)).
+					Return(domain.FeedDoc{}, errors.New("cache miss"))
+				repo.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, 20).Return(arts, nil)
+				repo.EXPECT().FeedModified(gomock.Any(), int64(0)).Return(time.Time{}, nil)
+				repo.EXPECT().SetFeed(gomock.Any(), FeedFormatAtom, int64(0), gomock.Any()).Return(nil)
+				return repo
+			},
+			format:         FeedFormatAtom,
+			wantedModified: now.Add(-time.Hour * 2),
+		},
+		{
+			// 最新的一篇撤回了，剩下的都比之前的 Last-Modified 旧
+			name: "撤回之后不会往回走",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				repo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().GetFeed(gomock.Any(), FeedFormatRSS, int64(0)).
+					Return(domain.FeedDoc{}, errors.New("cache miss"))
+				repo.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, 20).Return(arts, nil)
+				repo.EXPECT().FeedModified(gomock.Any(), int64(0)).Return(now, nil)
+				repo.EXPECT().SetFeed(gomock.Any(), FeedFormatRSS, int64(0), gomock.Any()).Return(nil)
+				return repo
+			},
+			format:         FeedFormatRSS,
+			wantedModified: now,
+		},
+		{
+			name: "不支持的格式",
+			mock: func(ctrl *gomock.Controller) article.ArticleRepository {
+				return articlerepomock.NewMockArticleRepository(ctrl)
+			},
+			format:    "json",
+			wantedErr: ErrInvalidFeedFormat,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewFeedService(tc.mock(ctrl), nil, FeedConfig{
+				Title: "we_book",
+				Link:  "https://example.com",
+				Size:  20,
+			}, logger.NewNoLogger())
+			doc, err := svc.Feed(context.Background(), tc.format, 0)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedModified, doc.Modified)
+		})
+	}
+}
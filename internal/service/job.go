@@ -2,21 +2,35 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 	"we_book/internal/domain"
 	"we_book/internal/repository"
 	"we_book/pkg/logger"
 )
 
+// ErrJobRetryExhausted 一次性任务的重试次数用完了
+var ErrJobRetryExhausted = errors.New("job retry exhausted")
+
 type JobService interface {
 	Preempt(ctx context.Context) (domain.Job, error)
+	// ResetNextTime 执行成功之后调用，一次性任务到这里就结束了
 	ResetNextTime(ctx context.Context, j domain.Job) error
+	// Retry 执行失败之后调用，定时任务等下一次执行，一次性任务退避之后重试，
+	// 次数用完了返回 ErrJobRetryExhausted，任务不会停掉，还是按照最长的间隔重试
+	Retry(ctx context.Context, j domain.Job) error
+	// AddOnce 添加一次性任务，同名的任务已经有了就什么都不做，重新部署不会再执行一次
+	AddOnce(ctx context.Context, j domain.Job) error
 }
 
 type cronJobService struct {
 	repo            repository.JobRepository
 	refreshInterval time.Duration
 	l               logger.V1
+	// 一次性任务失败之后从 minBackoff 开始翻倍退避，最多 maxBackoff
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxAttempts int
 }
 
 func (c *cronJobService) Preempt(ctx context.Context) (domain.Job, error) {
@@ -61,10 +75,43 @@ func (c *cronJobService) ResetNextTime(ctx context.Context, j domain.Job) error
 	return c.repo.UpdateNextUtime(ctx, j.Id, next)
 }
 
-//func NewCronJobService(repo repository.JobRepository, refreshInterval time.Duration, l logger.V1) JobService {
-//	return &cronJobService{
-//		repo:            repo,
-//		refreshInterval: refreshInterval,
-//		l:               l,
-//	}
-//}
+func (c *cronJobService) Retry(ctx context.Context, j domain.Job) error {
+	if j.Cron != "" {
+		return c.ResetNextTime(ctx, j)
+	}
+	attempts := j.Attempts + 1
+	backoff := c.minBackoff
+	for i := 1; i < attempts && backoff < c.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+	err := c.repo.Retry(ctx, j.Id, attempts, time.Now().Add(backoff))
+	if err != nil {
+		return err
+	}
+	if attempts >= c.maxAttempts {
+		return ErrJobRetryExhausted
+	}
+	return nil
+}
+
+func (c *cronJobService) AddOnce(ctx context.Context, j domain.Job) error {
+	jobs, err := c.repo.GetByNames(ctx, []string{j.Name})
+	if err != nil || len(jobs) > 0 {
+		return err
+	}
+	return c.repo.Upsert(ctx, j)
+}
+
+func NewCronJobService(repo repository.JobRepository, l logger.V1) JobService {
+	return &cronJobService{
+		repo:            repo,
+		refreshInterval: time.Second * 10,
+		l:               l,
+		minBackoff:      time.Second * 10,
+		maxBackoff:      time.Minute * 10,
+		maxAttempts:     5,
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/pkg/logger"
+)
+
+func Test_cronJobService_Retry(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.JobRepository
+		job  domain.Job
+
+		wantedErr error
+	}{
+		{
+			name: "一次性任务第一次失败，10 秒之后重试",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Retry(gomock.Any(), int64(1), 1, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id int64, attempts int, next time.Time) error {
+						d := time.Until(next)
+						if d < time.Second*9 || d > time.Second*10 {
+							return errors.New("unexpected backoff")
+						}
+						return nil
+					})
+				return repo
+			},
+			job: domain.Job{Id: 1},
+		},
+		{
+			name: "一次性任务重试次数用完了",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Retry(gomock.Any(), int64(1), 5, gomock.Any()).Return(nil)
+				return repo
+			},
+			job:       domain.Job{Id: 1, Attempts: 4},
+			wantedErr: ErrJobRetryExhausted,
+		},
+		{
+			name: "定时任务等下一次执行",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().UpdateNextUtime(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				return repo
+			},
+			job: domain.Job{Id: 1, Cron: "*/5 * * * *"},
+		},
+		{
+			name: "数据库错误",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().Retry(gomock.Any(), int64(1), 5, gomock.Any()).
+					Return(errors.New("mock db error"))
+				return repo
+			},
+			job:       domain.Job{Id: 1, Attempts: 4},
+			wantedErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCronJobService(tc.mock(ctrl), logger.NewNoLogger())
+			err := svc.Retry(context.Background(), tc.job)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func Test_cronJobService_AddOnce(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.JobRepository
+
+		wantedErr error
+	}{
+		{
+			name: "还没有添加过",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetByNames(gomock.Any(), []string{"backfill"}).Return(nil, nil)
+				repo.EXPECT().Upsert(gomock.Any(), domain.Job{Name: "backfill", Executor: "local"}).Return(nil)
+				return repo
+			},
+		},
+		{
+			name: "已经添加过，不再执行",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetByNames(gomock.Any(), []string{"backfill"}).
+					Return([]domain.Job{{Id: 1, Name: "backfill"}}, nil)
+				return repo
+			},
+		},
+		{
+			name: "数据库错误",
+			mock: func(ctrl *gomock.Controller) repository.JobRepository {
+				repo := repomocks.NewMockJobRepository(ctrl)
+				repo.EXPECT().GetByNames(gomock.Any(), []string{"backfill"}).
+					Return(nil, errors.New("mock db error"))
+				return repo
+			},
+			wantedErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCronJobService(tc.mock(ctrl), logger.NewNoLogger())
+			err := svc.AddOnce(context.Background(), domain.Job{Name: "backfill", Executor: "local"})
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 	domain "we_book/internal/domain"
+	diffx "we_book/pkg/diffx"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,122 @@ func (m *MockArticleService) EXPECT() *MockArticleServiceMockRecorder {
 	return m.recorder
 }
 
+// AbortSchedule mocks base method.
+func (m *MockArticleService) AbortSchedule(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortSchedule", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortSchedule indicates an expected call of AbortSchedule.
+func (mr *MockArticleServiceMockRecorder) AbortSchedule(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortSchedule", reflect.TypeOf((*MockArticleService)(nil).AbortSchedule), ctx, aid, uid)
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockArticleService) AcceptInvitation(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockArticleServiceMockRecorder) AcceptInvitation(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockArticleService)(nil).AcceptInvitation), ctx, aid, uid)
+}
+
+// Approve mocks base method.
+func (m *MockArticleService) Approve(ctx context.Context, aid, reviewer int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, aid, reviewer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockArticleServiceMockRecorder) Approve(ctx, aid, reviewer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockArticleService)(nil).Approve), ctx, aid, reviewer)
+}
+
+// BackfillStats mocks base method.
+func (m *MockArticleService) BackfillStats(ctx context.Context, startId int64, limit int) (int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillStats", ctx, startId, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BackfillStats indicates an expected call of BackfillStats.
+func (mr *MockArticleServiceMockRecorder) BackfillStats(ctx, startId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillStats", reflect.TypeOf((*MockArticleService)(nil).BackfillStats), ctx, startId, limit)
+}
+
+// Bylines mocks base method.
+func (m *MockArticleService) Bylines(ctx context.Context, art domain.Article) ([]domain.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bylines", ctx, art)
+	ret0, _ := ret[0].([]domain.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bylines indicates an expected call of Bylines.
+func (mr *MockArticleServiceMockRecorder) Bylines(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bylines", reflect.TypeOf((*MockArticleService)(nil).Bylines), ctx, art)
+}
+
+// CancelSchedule mocks base method.
+func (m *MockArticleService) CancelSchedule(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockArticleServiceMockRecorder) CancelSchedule(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockArticleService)(nil).CancelSchedule), ctx, aid, uid)
+}
+
+// DeclineInvitation mocks base method.
+func (m *MockArticleService) DeclineInvitation(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitation", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockArticleServiceMockRecorder) DeclineInvitation(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockArticleService)(nil).DeclineInvitation), ctx, aid, uid)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockArticleService) DiffRevisions(ctx context.Context, aid, uid, from, to int64) ([]diffx.Line, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", ctx, aid, uid, from, to)
+	ret0, _ := ret[0].([]diffx.Line)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockArticleServiceMockRecorder) DiffRevisions(ctx, aid, uid, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockArticleService)(nil).DiffRevisions), ctx, aid, uid, from, to)
+}
+
 // Edit mocks base method.
 func (m *MockArticleService) Edit(ctx context.Context, article domain.Article) (int64, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +188,21 @@ func (mr *MockArticleServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockArticleService)(nil).GetById), ctx, id)
 }
 
+// GetDraft mocks base method.
+func (m *MockArticleService) GetDraft(ctx context.Context, aid, uid int64) (domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraft", ctx, aid, uid)
+	ret0, _ := ret[0].(domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraft indicates an expected call of GetDraft.
+func (mr *MockArticleServiceMockRecorder) GetDraft(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockArticleService)(nil).GetDraft), ctx, aid, uid)
+}
+
 // GetPubById mocks base method.
 func (m *MockArticleService) GetPubById(ctx context.Context, aid, uid int64) (domain.Article, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +218,35 @@ func (mr *MockArticleServiceMockRecorder) GetPubById(ctx, aid, uid any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubById", reflect.TypeOf((*MockArticleService)(nil).GetPubById), ctx, aid, uid)
 }
 
+// GetReview mocks base method.
+func (m *MockArticleService) GetReview(ctx context.Context, aid, uid int64) (domain.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, aid, uid)
+	ret0, _ := ret[0].(domain.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockArticleServiceMockRecorder) GetReview(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockArticleService)(nil).GetReview), ctx, aid, uid)
+}
+
+// Invite mocks base method.
+func (m *MockArticleService) Invite(ctx context.Context, aid, owner, uid int64, role domain.CollaboratorRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, aid, owner, uid, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockArticleServiceMockRecorder) Invite(ctx, aid, owner, uid, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockArticleService)(nil).Invite), ctx, aid, owner, uid, role)
+}
+
 // List mocks base method.
 func (m *MockArticleService) List(ctx context.Context, uid int64, set, limit int) ([]domain.Article, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +262,81 @@ func (mr *MockArticleServiceMockRecorder) List(ctx, uid, set, limit any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockArticleService)(nil).List), ctx, uid, set, limit)
 }
 
+// ListByCursor mocks base method.
+func (m *MockArticleService) ListByCursor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCursor", ctx, uid, cursor, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCursor indicates an expected call of ListByCursor.
+func (mr *MockArticleServiceMockRecorder) ListByCursor(ctx, uid, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCursor", reflect.TypeOf((*MockArticleService)(nil).ListByCursor), ctx, uid, cursor, limit)
+}
+
+// ListByLength mocks base method.
+func (m *MockArticleService) ListByLength(ctx context.Context, uid int64, asc bool, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByLength", ctx, uid, asc, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByLength indicates an expected call of ListByLength.
+func (mr *MockArticleServiceMockRecorder) ListByLength(ctx, uid, asc, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByLength", reflect.TypeOf((*MockArticleService)(nil).ListByLength), ctx, uid, asc, offset, limit)
+}
+
+// ListCollaborators mocks base method.
+func (m *MockArticleService) ListCollaborators(ctx context.Context, aid, uid int64) ([]domain.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollaborators", ctx, aid, uid)
+	ret0, _ := ret[0].([]domain.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollaborators indicates an expected call of ListCollaborators.
+func (mr *MockArticleServiceMockRecorder) ListCollaborators(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollaborators", reflect.TypeOf((*MockArticleService)(nil).ListCollaborators), ctx, aid, uid)
+}
+
+// ListExpiredTrash mocks base method.
+func (m *MockArticleService) ListExpiredTrash(ctx context.Context, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredTrash", ctx, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredTrash indicates an expected call of ListExpiredTrash.
+func (mr *MockArticleServiceMockRecorder) ListExpiredTrash(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredTrash", reflect.TypeOf((*MockArticleService)(nil).ListExpiredTrash), ctx, limit)
+}
+
+// ListInvitations mocks base method.
+func (m *MockArticleService) ListInvitations(ctx context.Context, uid int64, offset, limit int) ([]domain.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockArticleServiceMockRecorder) ListInvitations(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockArticleService)(nil).ListInvitations), ctx, uid, offset, limit)
+}
+
 // ListPub mocks base method.
 func (m *MockArticleService) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +352,141 @@ func (mr *MockArticleServiceMockRecorder) ListPub(ctx, start, offset, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleService)(nil).ListPub), ctx, start, offset, limit)
 }
 
+// ListPubByCategory mocks base method.
+func (m *MockArticleService) ListPubByCategory(ctx context.Context, category string, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCategory", ctx, category, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCategory indicates an expected call of ListPubByCategory.
+func (mr *MockArticleServiceMockRecorder) ListPubByCategory(ctx, category, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCategory", reflect.TypeOf((*MockArticleService)(nil).ListPubByCategory), ctx, category, offset, limit)
+}
+
+// ListPubByCursor mocks base method.
+func (m *MockArticleService) ListPubByCursor(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByCursor", ctx, cursor, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByCursor indicates an expected call of ListPubByCursor.
+func (mr *MockArticleServiceMockRecorder) ListPubByCursor(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByCursor", reflect.TypeOf((*MockArticleService)(nil).ListPubByCursor), ctx, cursor, limit)
+}
+
+// ListPubByIds mocks base method.
+func (m *MockArticleService) ListPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByIds", ctx, ids)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByIds indicates an expected call of ListPubByIds.
+func (mr *MockArticleServiceMockRecorder) ListPubByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByIds", reflect.TypeOf((*MockArticleService)(nil).ListPubByIds), ctx, ids)
+}
+
+// ListPubByTag mocks base method.
+func (m *MockArticleService) ListPubByTag(ctx context.Context, tag string, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByTag", ctx, tag, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByTag indicates an expected call of ListPubByTag.
+func (mr *MockArticleServiceMockRecorder) ListPubByTag(ctx, tag, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByTag", reflect.TypeOf((*MockArticleService)(nil).ListPubByTag), ctx, tag, offset, limit)
+}
+
+// ListReviews mocks base method.
+func (m *MockArticleService) ListReviews(ctx context.Context, status domain.ReviewStatus, offset, limit int) ([]domain.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviews", ctx, status, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviews indicates an expected call of ListReviews.
+func (mr *MockArticleServiceMockRecorder) ListReviews(ctx, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockArticleService)(nil).ListReviews), ctx, status, offset, limit)
+}
+
+// ListRevisions mocks base method.
+func (m *MockArticleService) ListRevisions(ctx context.Context, aid, uid int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, aid, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockArticleServiceMockRecorder) ListRevisions(ctx, aid, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockArticleService)(nil).ListRevisions), ctx, aid, uid, offset, limit)
+}
+
+// ListScheduled mocks base method.
+func (m *MockArticleService) ListScheduled(ctx context.Context, uid int64, offset, limit int) ([]domain.ScheduledPublication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduled", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.ScheduledPublication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduled indicates an expected call of ListScheduled.
+func (mr *MockArticleServiceMockRecorder) ListScheduled(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduled", reflect.TypeOf((*MockArticleService)(nil).ListScheduled), ctx, uid, offset, limit)
+}
+
+// ListTrash mocks base method.
+func (m *MockArticleService) ListTrash(ctx context.Context, uid int64, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockArticleServiceMockRecorder) ListTrash(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockArticleService)(nil).ListTrash), ctx, uid, offset, limit)
+}
+
+// PopularTags mocks base method.
+func (m *MockArticleService) PopularTags(ctx context.Context, limit int) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopularTags", ctx, limit)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopularTags indicates an expected call of PopularTags.
+func (mr *MockArticleServiceMockRecorder) PopularTags(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopularTags", reflect.TypeOf((*MockArticleService)(nil).PopularTags), ctx, limit)
+}
+
 // Publish mocks base method.
 func (m *MockArticleService) Publish(ctx context.Context, article domain.Article) (int64, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +502,105 @@ func (mr *MockArticleServiceMockRecorder) Publish(ctx, article any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockArticleService)(nil).Publish), ctx, article)
 }
 
+// Purge mocks base method.
+func (m *MockArticleService) Purge(ctx context.Context, aid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, aid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockArticleServiceMockRecorder) Purge(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockArticleService)(nil).Purge), ctx, aid)
+}
+
+// Reject mocks base method.
+func (m *MockArticleService) Reject(ctx context.Context, aid, reviewer int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, aid, reviewer, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockArticleServiceMockRecorder) Reject(ctx, aid, reviewer, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockArticleService)(nil).Reject), ctx, aid, reviewer, reason)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockArticleService) RemoveCollaborator(ctx context.Context, aid, operator, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, aid, operator, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockArticleServiceMockRecorder) RemoveCollaborator(ctx, aid, operator, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockArticleService)(nil).RemoveCollaborator), ctx, aid, operator, uid)
+}
+
+// Render mocks base method.
+func (m *MockArticleService) Render(ctx context.Context, art domain.Article) (domain.RenderedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, art)
+	ret0, _ := ret[0].(domain.RenderedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockArticleServiceMockRecorder) Render(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockArticleService)(nil).Render), ctx, art)
+}
+
+// Reschedule mocks base method.
+func (m *MockArticleService) Reschedule(ctx context.Context, aid, uid int64, publishAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, aid, uid, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockArticleServiceMockRecorder) Reschedule(ctx, aid, uid, publishAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockArticleService)(nil).Reschedule), ctx, aid, uid, publishAt)
+}
+
+// Restore mocks base method.
+func (m *MockArticleService) Restore(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleServiceMockRecorder) Restore(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticleService)(nil).Restore), ctx, aid, uid)
+}
+
+// Rollback mocks base method.
+func (m *MockArticleService) Rollback(ctx context.Context, aid, uid, revisionId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", ctx, aid, uid, revisionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockArticleServiceMockRecorder) Rollback(ctx, aid, uid, revisionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockArticleService)(nil).Rollback), ctx, aid, uid, revisionId)
+}
+
 // Save mocks base method.
 func (m *MockArticleService) Save(ctx context.Context, article domain.Article) (int64, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +616,35 @@ func (mr *MockArticleServiceMockRecorder) Save(ctx, article any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockArticleService)(nil).Save), ctx, article)
 }
 
+// SchedulePublish mocks base method.
+func (m *MockArticleService) SchedulePublish(ctx context.Context, article domain.Article, publishAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePublish", ctx, article, publishAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePublish indicates an expected call of SchedulePublish.
+func (mr *MockArticleServiceMockRecorder) SchedulePublish(ctx, article, publishAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublish", reflect.TypeOf((*MockArticleService)(nil).SchedulePublish), ctx, article, publishAt)
+}
+
+// Trash mocks base method.
+func (m *MockArticleService) Trash(ctx context.Context, aid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, aid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockArticleServiceMockRecorder) Trash(ctx, aid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockArticleService)(nil).Trash), ctx, aid, uid)
+}
+
 // Withdraw mocks base method.
 func (m *MockArticleService) Withdraw(ctx context.Context, article domain.Article) error {
 	m.ctrl.T.Helper()
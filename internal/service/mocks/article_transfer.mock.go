@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=article_transfer.go -destination=mocks/article_transfer.mock.go -package=svcmocks
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleTransferService is a mock of ArticleTransferService interface.
+type MockArticleTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleTransferServiceMockRecorder
+}
+
+// MockArticleTransferServiceMockRecorder is the mock recorder for MockArticleTransferService.
+type MockArticleTransferServiceMockRecorder struct {
+	mock *MockArticleTransferService
+}
+
+// NewMockArticleTransferService creates a new mock instance.
+func NewMockArticleTransferService(ctrl *gomock.Controller) *MockArticleTransferService {
+	mock := &MockArticleTransferService{ctrl: ctrl}
+	mock.recorder = &MockArticleTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleTransferService) EXPECT() *MockArticleTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockArticleTransferService) Download(ctx context.Context, id, uid int64) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, id, uid)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockArticleTransferServiceMockRecorder) Download(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockArticleTransferService)(nil).Download), ctx, id, uid)
+}
+
+// Export mocks base method.
+func (m *MockArticleTransferService) Export(ctx context.Context, uid int64, format domain.TransferFormat) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, uid, format)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockArticleTransferServiceMockRecorder) Export(ctx, uid, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockArticleTransferService)(nil).Export), ctx, uid, format)
+}
+
+// GetTask mocks base method.
+func (m *MockArticleTransferService) GetTask(ctx context.Context, id, uid int64) (domain.TransferTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, id, uid)
+	ret0, _ := ret[0].(domain.TransferTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockArticleTransferServiceMockRecorder) GetTask(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockArticleTransferService)(nil).GetTask), ctx, id, uid)
+}
+
+// Import mocks base method.
+func (m *MockArticleTransferService) Import(ctx context.Context, uid int64, bundle []byte) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, uid, bundle)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockArticleTransferServiceMockRecorder) Import(ctx, uid, bundle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockArticleTransferService)(nil).Import), ctx, uid, bundle)
+}
+
+// Run mocks base method.
+func (m *MockArticleTransferService) Run(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockArticleTransferServiceMockRecorder) Run(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockArticleTransferService)(nil).Run), ctx, id)
+}
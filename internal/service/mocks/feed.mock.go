@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: feed.go
+//
+// Generated by this command:
+//
+//	mockgen -source=feed.go -destination=mocks/feed.mock.go -package=svcmocks
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedService is a mock of FeedService interface.
+type MockFeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedServiceMockRecorder
+}
+
+// MockFeedServiceMockRecorder is the mock recorder for MockFeedService.
+type MockFeedServiceMockRecorder struct {
+	mock *MockFeedService
+}
+
+// NewMockFeedService creates a new mock instance.
+func NewMockFeedService(ctrl *gomock.Controller) *MockFeedService {
+	mock := &MockFeedService{ctrl: ctrl}
+	mock.recorder = &MockFeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedService) EXPECT() *MockFeedServiceMockRecorder {
+	return m.recorder
+}
+
+// Feed mocks base method.
+func (m *MockFeedService) Feed(ctx context.Context, format string, uid int64) (domain.FeedDoc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, format, uid)
+	ret0, _ := ret[0].(domain.FeedDoc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockFeedServiceMockRecorder) Feed(ctx, format, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockFeedService)(nil).Feed), ctx, format, uid)
+}
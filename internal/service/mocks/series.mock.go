@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=series.go -destination=mocks/series.mock.go -package=svcmocks
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesService is a mock of SeriesService interface.
+type MockSeriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesServiceMockRecorder
+}
+
+// MockSeriesServiceMockRecorder is the mock recorder for MockSeriesService.
+type MockSeriesServiceMockRecorder struct {
+	mock *MockSeriesService
+}
+
+// NewMockSeriesService creates a new mock instance.
+func NewMockSeriesService(ctrl *gomock.Controller) *MockSeriesService {
+	mock := &MockSeriesService{ctrl: ctrl}
+	mock.recorder = &MockSeriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesService) EXPECT() *MockSeriesServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeriesService) Create(ctx context.Context, s domain.Series) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeriesServiceMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeriesService)(nil).Create), ctx, s)
+}
+
+// Delete mocks base method.
+func (m *MockSeriesService) Delete(ctx context.Context, id, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeriesServiceMockRecorder) Delete(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesService)(nil).Delete), ctx, id, uid)
+}
+
+// GetPub mocks base method.
+func (m *MockSeriesService) GetPub(ctx context.Context, id int64) (domain.Series, []domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPub", ctx, id)
+	ret0, _ := ret[0].(domain.Series)
+	ret1, _ := ret[1].([]domain.Article)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPub indicates an expected call of GetPub.
+func (mr *MockSeriesServiceMockRecorder) GetPub(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPub", reflect.TypeOf((*MockSeriesService)(nil).GetPub), ctx, id)
+}
+
+// ListPub mocks base method.
+func (m *MockSeriesService) ListPub(ctx context.Context, uid int64, start time.Time, offset, limit int) ([]domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPub", ctx, uid, start, offset, limit)
+	ret0, _ := ret[0].([]domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPub indicates an expected call of ListPub.
+func (mr *MockSeriesServiceMockRecorder) ListPub(ctx, uid, start, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockSeriesService)(nil).ListPub), ctx, uid, start, offset, limit)
+}
+
+// Navigation mocks base method.
+func (m *MockSeriesService) Navigation(ctx context.Context, aid int64) (domain.SeriesNav, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Navigation", ctx, aid)
+	ret0, _ := ret[0].(domain.SeriesNav)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Navigation indicates an expected call of Navigation.
+func (mr *MockSeriesServiceMockRecorder) Navigation(ctx, aid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Navigation", reflect.TypeOf((*MockSeriesService)(nil).Navigation), ctx, aid)
+}
+
+// Reorder mocks base method.
+func (m *MockSeriesService) Reorder(ctx context.Context, id, uid int64, aids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, id, uid, aids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockSeriesServiceMockRecorder) Reorder(ctx, id, uid, aids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockSeriesService)(nil).Reorder), ctx, id, uid, aids)
+}
+
+// Update mocks base method.
+func (m *MockSeriesService) Update(ctx context.Context, s domain.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeriesServiceMockRecorder) Update(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeriesService)(nil).Update), ctx, s)
+}
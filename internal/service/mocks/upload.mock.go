@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: upload.go
+//
+// Generated by this command:
+//
+//	mockgen -source=upload.go -destination=mocks/upload.mock.go -package=svcmocks
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "we_book/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUploadService is a mock of UploadService interface.
+type MockUploadService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadServiceMockRecorder
+}
+
+// MockUploadServiceMockRecorder is the mock recorder for MockUploadService.
+type MockUploadServiceMockRecorder struct {
+	mock *MockUploadService
+}
+
+// NewMockUploadService creates a new mock instance.
+func NewMockUploadService(ctrl *gomock.Controller) *MockUploadService {
+	mock := &MockUploadService{ctrl: ctrl}
+	mock.recorder = &MockUploadServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadService) EXPECT() *MockUploadServiceMockRecorder {
+	return m.recorder
+}
+
+// GC mocks base method.
+func (m *MockUploadService) GC(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GC", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GC indicates an expected call of GC.
+func (mr *MockUploadServiceMockRecorder) GC(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GC", reflect.TypeOf((*MockUploadService)(nil).GC), ctx, before, limit)
+}
+
+// Open mocks base method.
+func (m *MockUploadService) Open(ctx context.Context, key string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockUploadServiceMockRecorder) Open(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockUploadService)(nil).Open), ctx, key)
+}
+
+// UploadImage mocks base method.
+func (m *MockUploadService) UploadImage(ctx context.Context, uid int64, data []byte) (domain.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, uid, data)
+	ret0, _ := ret[0].(domain.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockUploadServiceMockRecorder) UploadImage(ctx, uid, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUploadService)(nil).UploadImage), ctx, uid, data)
+}
@@ -0,0 +1,42 @@
+// Package moderation 文章发表之前的自动审核
+package moderation
+
+import (
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"we_book/internal/domain"
+	"we_book/pkg/acx"
+)
+
+// Moderator 自动审核，没有通过的文章进入人工复审
+// 以后接第三方的内容安全服务，实现这个接口就可以
+type Moderator interface {
+	Moderate(ctx context.Context, art domain.Article) (domain.ModerationResult, error)
+}
+
+// KeywordModerator 基于敏感词的审核，标题和内容命中任何一个词都不通过
+type KeywordModerator struct {
+	matcher *acx.Matcher
+}
+
+func NewKeywordModerator(words []string) *KeywordModerator {
+	return &KeywordModerator{
+		matcher: acx.NewMatcher(words),
+	}
+}
+
+func (k *KeywordModerator) Moderate(ctx context.Context, art domain.Article) (domain.ModerationResult, error) {
+	// 分开匹配，匹配的时候会忽略空白，拼在一起标题的结尾和内容的开头可能凑成一个词
+	hits := k.matcher.FindAll(art.Title)
+	for _, w := range k.matcher.FindAll(art.Content) {
+		if !slice.Contains[string](hits, w) {
+			hits = append(hits, w)
+		}
+	}
+	if len(hits) == 0 {
+		return domain.ModerationResult{Pass: true}, nil
+	}
+	return domain.ModerationResult{
+		Hits: hits,
+	}, nil
+}
@@ -41,7 +41,7 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 		score float64
 	}
 	now := time.Now()
-	offset := 0
+	var cursor domain.ArticleCursor
 	topN := queue.NewConcurrentPriorityQueue[Score](b.n,
 		func(a, b Score) int {
 			if a.score == b.score {
@@ -54,7 +54,7 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 		})
 
 	for {
-		arts, err := b.artSvc.ListPub(ctx, now, offset, b.batchSize)
+		arts, err := b.artSvc.ListPubByCursor(ctx, cursor, b.batchSize)
 		if err != nil {
 			return nil, err
 		}
@@ -93,10 +93,10 @@ func (b *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error
 			}
 		}
 
-		if len(arts) < b.batchSize || now.Sub(arts[len(arts)-1].Ctime).Hours() > 7*24 {
+		if len(arts) < b.batchSize || now.Sub(arts[len(arts)-1].Utime).Hours() > 7*24 {
 			break
 		}
-		offset = offset + len(arts)
+		cursor = domain.NextCursor(arts)
 	}
 
 	res := make([]domain.Article, b.n)
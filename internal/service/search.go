@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"we_book/internal/search"
+)
+
+type SearchService interface {
+	// SearchArticles 在已发表的文章中搜索标题和内容
+	SearchArticles(ctx context.Context, query string, offset int, limit int) ([]search.Hit, error)
+}
+
+type searchService struct {
+	searcher search.Searcher
+}
+
+func NewSearchService(searcher search.Searcher) SearchService {
+	return &searchService{
+		searcher: searcher,
+	}
+}
+
+func (s *searchService) SearchArticles(ctx context.Context, query string, offset int, limit int) ([]search.Hit, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+	return s.searcher.Search(ctx, query, offset, limit)
+}
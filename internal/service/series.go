@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	"we_book/pkg/logger"
+)
+
+// maxSeriesChapters 一个系列最多这么多章
+const maxSeriesChapters = 200
+
+var (
+	ErrSeriesNotFound = repository.ErrSeriesNotFound
+	// ErrSeriesArticleConflict 一篇文章只能属于一个系列
+	ErrSeriesArticleConflict = repository.ErrSeriesArticleConflict
+	// ErrInvalidSeries 标题为空，章节太多、重复，或者不是自己的文章
+	ErrInvalidSeries = errors.New("invalid series")
+)
+
+//go:generate mockgen -source=series.go -destination=mocks/series.mock.go -package=svcmocks
+type SeriesService interface {
+	// Create 创建的时候可以直接带上章节
+	Create(ctx context.Context, s domain.Series) (int64, error)
+	// Update 只修改标题和简介
+	Update(ctx context.Context, s domain.Series) error
+	// Reorder 整体替换章节，增删章节和调整顺序都用这个
+	Reorder(ctx context.Context, id, uid int64, aids []int64) error
+	Delete(ctx context.Context, id, uid int64) error
+	// GetPub 读者看系列，只返回已经发表的章节
+	GetPub(ctx context.Context, id int64) (domain.Series, []domain.Article, error)
+	// ListPub 作者的系列，和 ArticleService.ListPub 一样分页
+	ListPub(ctx context.Context, uid int64, start time.Time, offset int, limit int) ([]domain.Series, error)
+	// Navigation 文章所在的系列和前后章节，不在系列里面返回 ErrSeriesNotFound
+	Navigation(ctx context.Context, aid int64) (domain.SeriesNav, error)
+}
+
+type seriesService struct {
+	repo    repository.SeriesRepository
+	artRepo article.ArticleRepository
+	l       logger.V1
+}
+
+func NewSeriesService(repo repository.SeriesRepository,
+	artRepo article.ArticleRepository, l logger.V1) SeriesService {
+	return &seriesService{
+		repo:    repo,
+		artRepo: artRepo,
+		l:       l,
+	}
+}
+
+func (s *seriesService) Create(ctx context.Context, series domain.Series) (int64, error) {
+	series.Title = strings.TrimSpace(series.Title)
+	if series.Title == "" {
+		return 0, ErrInvalidSeries
+	}
+	err := s.checkChapters(ctx, series.Author.Id, series.ArticleIds)
+	if err != nil {
+		return 0, err
+	}
+	return s.repo.Create(ctx, series)
+}
+
+func (s *seriesService) Update(ctx context.Context, series domain.Series) error {
+	series.Title = strings.TrimSpace(series.Title)
+	if series.Title == "" {
+		return ErrInvalidSeries
+	}
+	return s.repo.Update(ctx, series)
+}
+
+func (s *seriesService) Reorder(ctx context.Context, id, uid int64, aids []int64) error {
+	err := s.checkChapters(ctx, uid, aids)
+	if err != nil {
+		return err
+	}
+	return s.repo.SetArticles(ctx, id, uid, aids)
+}
+
+func (s *seriesService) Delete(ctx context.Context, id, uid int64) error {
+	return s.repo.Delete(ctx, id, uid)
+}
+
+func (s *seriesService) GetPub(ctx context.Context, id int64) (domain.Series, []domain.Article, error) {
+	series, err := s.repo.GetById(ctx, id)
+	if err != nil {
+		return domain.Series{}, nil, err
+	}
+	arts := make([]domain.Article, 0, len(series.ArticleIds))
+	for _, aid := range series.ArticleIds {
+		art, er := s.artRepo.GetPubById(ctx, aid)
+		if errors.Is(er, ErrArticleNotFound) {
+			// 还没有发表或者已经撤回的章节读者看不到
+			continue
+		}
+		if er != nil {
+			return domain.Series{}, nil, er
+		}
+		arts = append(arts, art)
+	}
+	return series, arts, nil
+}
+
+func (s *seriesService) ListPub(ctx context.Context, uid int64, start time.Time, offset int, limit int) ([]domain.Series, error) {
+	return s.repo.ListByAuthor(ctx, uid, start, offset, limit)
+}
+
+func (s *seriesService) Navigation(ctx context.Context, aid int64) (domain.SeriesNav, error) {
+	series, err := s.repo.FindByArticle(ctx, aid)
+	if err != nil {
+		return domain.SeriesNav{}, err
+	}
+	idx := series.IndexOf(aid)
+	if idx < 0 {
+		// 刚好在查询的间隙被移出了系列
+		return domain.SeriesNav{}, ErrSeriesNotFound
+	}
+	prev, err := s.nearestPub(ctx, series.ArticleIds, idx, -1)
+	if err != nil {
+		return domain.SeriesNav{}, err
+	}
+	next, err := s.nearestPub(ctx, series.ArticleIds, idx, 1)
+	if err != nil {
+		return domain.SeriesNav{}, err
+	}
+	return domain.SeriesNav{
+		Series: series,
+		Index:  idx,
+		Prev:   prev,
+		Next:   next,
+	}, nil
+}
+
+// nearestPub 从 idx 开始往 step 的方向找第一篇已经发表的章节，找不到返回 nil
+func (s *seriesService) nearestPub(ctx context.Context, aids []int64, idx int, step int) (*domain.Article, error) {
+	for i := idx + step; i >= 0 && i < len(aids); i += step {
+		art, err := s.artRepo.GetPubById(ctx, aids[i])
+		if errors.Is(err, ErrArticleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &art, nil
+	}
+	return nil, nil
+}
+
+// checkChapters 章节不能重复，而且必须是作者自己的文章
+func (s *seriesService) checkChapters(ctx context.Context, uid int64, aids []int64) error {
+	if len(aids) > maxSeriesChapters {
+		return ErrInvalidSeries
+	}
+	seen := make(map[int64]struct{}, len(aids))
+	for _, aid := range aids {
+		if _, ok := seen[aid]; ok {
+			return ErrInvalidSeries
+		}
+		seen[aid] = struct{}{}
+		art, err := s.artRepo.GetById(ctx, aid)
+		if errors.Is(err, ErrArticleNotFound) {
+			return ErrInvalidSeries
+		}
+		if err != nil {
+			return err
+		}
+		if art.Author.Id != uid {
+			s.l.Warn("add others article to series",
+				logger.Int64("aid", aid),
+				logger.Int64("uid", uid))
+			return ErrInvalidSeries
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	articlerepomock "we_book/internal/repository/article/mocks"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/pkg/logger"
+)
+
+func Test_seriesService_Navigation(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository)
+
+		aid int64
+
+		wantedNav domain.SeriesNav
+		wantedErr error
+	}{
+		{
+			name: "中间的章节，跳过没有发表的",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindByArticle(gomock.Any(), int64(3)).Return(domain.Series{
+					Id:         1,
+					Title:      "教程",
+					ArticleIds: []int64{1, 2, 3, 4},
+				}, nil)
+				artRepo.EXPECT().GetPubById(gomock.Any(), int64(2)).
+					Return(domain.Article{}, ErrArticleNotFound)
+				artRepo.EXPECT().GetPubById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Title: "第一章"}, nil)
+				artRepo.EXPECT().GetPubById(gomock.Any(), int64(4)).
+					Return(domain.Article{Id: 4, Title: "第四章"}, nil)
+				return repo, artRepo
+			},
+			aid: 3,
+			wantedNav: domain.SeriesNav{
+				Series: domain.Series{
+					Id:         1,
+					Title:      "教程",
+					ArticleIds: []int64{1, 2, 3, 4},
+				},
+				Index: 2,
+				Prev:  &domain.Article{Id: 1, Title: "第一章"},
+				Next:  &domain.Article{Id: 4, Title: "第四章"},
+			},
+		},
+		{
+			name: "第一章",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindByArticle(gomock.Any(), int64(1)).Return(domain.Series{
+					Id:         1,
+					ArticleIds: []int64{1, 2},
+				}, nil)
+				artRepo.EXPECT().GetPubById(gomock.Any(), int64(2)).
+					Return(domain.Article{Id: 2, Title: "第二章"}, nil)
+				return repo, artRepo
+			},
+			aid: 1,
+			wantedNav: domain.SeriesNav{
+				Series: domain.Series{
+					Id:         1,
+					ArticleIds: []int64{1, 2},
+				},
+				Next: &domain.Article{Id: 2, Title: "第二章"},
+			},
+		},
+		{
+			name: "不在系列里面",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				repo.EXPECT().FindByArticle(gomock.Any(), int64(1)).
+					Return(domain.Series{}, repository.ErrSeriesNotFound)
+				return repo, articlerepomock.NewMockArticleRepository(ctrl)
+			},
+			aid:       1,
+			wantedErr: ErrSeriesNotFound,
+		},
+		{
+			name: "查询章节失败",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindByArticle(gomock.Any(), int64(2)).Return(domain.Series{
+					Id:         1,
+					ArticleIds: []int64{1, 2},
+				}, nil)
+				artRepo.EXPECT().GetPubById(gomock.Any(), int64(1)).
+					Return(domain.Article{}, errors.New("mock db error"))
+				return repo, artRepo
+			},
+			aid:       2,
+			wantedErr: errors.New("mock db error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo := tc.mock(ctrl)
+			svc := NewSeriesService(repo, artRepo, logger.NewNoLogger())
+			nav, err := svc.Navigation(context.Background(), tc.aid)
+			assert.Equal(t, tc.wantedErr, err)
+			assert.Equal(t, tc.wantedNav, nav)
+		})
+	}
+}
+
+func Test_seriesService_Reorder(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository)
+
+		aids []int64
+
+		wantedErr error
+	}{
+		{
+			name: "调整顺序成功",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(2)).
+					Return(domain.Article{Id: 2, Author: domain.Author{Id: 123}}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 123}}, nil)
+				repo.EXPECT().SetArticles(gomock.Any(), int64(1), int64(123), []int64{2, 1}).Return(nil)
+				return repo, artRepo
+			},
+			aids: []int64{2, 1},
+		},
+		{
+			name: "章节重复",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 123}}, nil)
+				return repomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			aids:      []int64{1, 1},
+			wantedErr: ErrInvalidSeries,
+		},
+		{
+			name: "不是自己的文章",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 456}}, nil)
+				return repomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			aids:      []int64{1},
+			wantedErr: ErrInvalidSeries,
+		},
+		{
+			name: "文章不存在",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(9)).
+					Return(domain.Article{}, ErrArticleNotFound)
+				return repomocks.NewMockSeriesRepository(ctrl), artRepo
+			},
+			aids:      []int64{9},
+			wantedErr: ErrInvalidSeries,
+		},
+		{
+			name: "文章已经在别的系列里面",
+			mock: func(ctrl *gomock.Controller) (repository.SeriesRepository, article.ArticleRepository) {
+				repo := repomocks.NewMockSeriesRepository(ctrl)
+				artRepo := articlerepomock.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(1)).
+					Return(domain.Article{Id: 1, Author: domain.Author{Id: 123}}, nil)
+				repo.EXPECT().SetArticles(gomock.Any(), int64(1), int64(123), []int64{1}).
+					Return(repository.ErrSeriesArticleConflict)
+				return repo, artRepo
+			},
+			aids:      []int64{1},
+			wantedErr: ErrSeriesArticleConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo := tc.mock(ctrl)
+			svc := NewSeriesService(repo, artRepo, logger.NewNoLogger())
+			err := svc.Reorder(context.Background(), 1, 123, tc.aids)
+			assert.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
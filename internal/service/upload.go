@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	"we_book/pkg/blobx"
+	"we_book/pkg/imagex"
+	"we_book/pkg/logger"
+)
+
+const (
+	// UploadMaxSize 单张图片最大 5MB
+	UploadMaxSize = 5 << 20
+	// uploadMaxPixels 解码之后最多 1600 万像素，解码出来的图片最多占 64MB 内存
+	uploadMaxPixels = 16_000_000
+	// thumbnailSide 缩略图的长边
+	thumbnailSide = 320
+)
+
+var (
+	ErrUploadTooLarge = errors.New("upload is too large")
+	// ErrUnsupportedUpload 只支持 JPEG、PNG 和 GIF，按照文件内容判断，不看扩展名
+	ErrUnsupportedUpload = errors.New("unsupported upload type")
+	ErrUploadNotFound    = errors.New("upload not found")
+)
+
+// uploadExts 允许上传的类型和保存的扩展名
+var uploadExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+//go:generate mockgen -source=upload.go -destination=mocks/upload.mock.go -package=svcmocks
+type UploadService interface {
+	// UploadImage 保存原图和缩略图，返回的 URL 可以直接写进文章
+	UploadImage(ctx context.Context, uid int64, data []byte) (domain.Upload, error)
+	// Open 读取上传的文件，返回内容和 Content-Type
+	Open(ctx context.Context, key string) ([]byte, string, error)
+	// GC 清理在 before 之前上传、并且没有任何文章引用的图片，返回清理了多少张
+	GC(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+type uploadService struct {
+	repo repository.UploadRepository
+	blob blobx.Storage
+	// baseURL 拼在 key 前面，可以是本站的 /files，也可以是 CDN 的地址
+	baseURL string
+	l       logger.V1
+}
+
+func NewUploadService(repo repository.UploadRepository, blob blobx.Storage,
+	baseURL string, l logger.V1) UploadService {
+	return &uploadService{
+		repo:    repo,
+		blob:    blob,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		l:       l,
+	}
+}
+
+func (u *uploadService) UploadImage(ctx context.Context, uid int64, data []byte) (domain.Upload, error) {
+	if len(data) > UploadMaxSize {
+		return domain.Upload{}, ErrUploadTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := uploadExts[contentType]
+	if !ok {
+		return domain.Upload{}, ErrUnsupportedUpload
+	}
+	img, err := imagex.Decode(data, uploadMaxPixels)
+	if errors.Is(err, imagex.ErrTooLarge) {
+		return domain.Upload{}, ErrUploadTooLarge
+	}
+	if err != nil {
+		// 文件头对了，但是内容是坏的
+		return domain.Upload{}, fmt.Errorf("%w: %v", ErrUnsupportedUpload, err)
+	}
+	thumb, err := imagex.EncodeJPEG(imagex.Thumbnail(img, thumbnailSide))
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	name, err := randomName()
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	// 和 domain 里面识别引用的格式保持一致
+	prefix := fmt.Sprintf("img/%d/%s/%s", uid, time.Now().Format("20060102"), name)
+	res := domain.Upload{
+		Name:        name,
+		Key:         prefix + ext,
+		ThumbKey:    prefix + "_thumb.jpg",
+		Uploader:    uid,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+	}
+	err = u.blob.Put(ctx, res.Key, data)
+	if err != nil {
+		return domain.Upload{}, err
+	}
+	err = u.blob.Put(ctx, res.ThumbKey, thumb)
+	if err != nil {
+		u.deleteBlobs(ctx, res)
+		return domain.Upload{}, err
+	}
+	res.Id, err = u.repo.Create(ctx, res)
+	if err != nil {
+		u.deleteBlobs(ctx, res)
+		return domain.Upload{}, err
+	}
+	return u.withURL(res), nil
+}
+
+func (u *uploadService) Open(ctx context.Context, key string) ([]byte, string, error) {
+	if !domain.IsUploadKey(key) {
+		return nil, "", ErrUploadNotFound
+	}
+	data, err := u.blob.Get(ctx, key)
+	if errors.Is(err, blobx.ErrNotFound) {
+		return nil, "", ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return data, mime.TypeByExtension(path.Ext(key)), nil
+}
+
+func (u *uploadService) GC(ctx context.Context, before time.Time, limit int) (int, error) {
+	uploads, err := u.repo.ListOrphans(ctx, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	cnt := 0
+	for _, upload := range uploads {
+		// 先删记录，删掉之后新保存的文章就引用不到了，再删文件
+		deleted, err := u.repo.DeleteOrphan(ctx, upload.Id)
+		if err != nil {
+			return cnt, err
+		}
+		if !deleted {
+			// 刚好被文章引用了
+			continue
+		}
+		u.deleteBlobs(ctx, upload)
+		cnt++
+	}
+	return cnt, nil
+}
+
+// deleteBlobs 删不掉只是在对象存储里面留下没人用的文件
+func (u *uploadService) deleteBlobs(ctx context.Context, upload domain.Upload) {
+	for _, key := range []string{upload.Key, upload.ThumbKey} {
+		err := u.blob.Delete(ctx, key)
+		if err != nil {
+			u.l.Error("delete upload blob error",
+				logger.String("key", key),
+				logger.Error(err))
+		}
+	}
+}
+
+func (u *uploadService) withURL(upload domain.Upload) domain.Upload {
+	upload.URL = u.baseURL + "/" + upload.Key
+	upload.ThumbURL = u.baseURL + "/" + upload.ThumbKey
+	return upload
+}
+
+func randomName() (string, error) {
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
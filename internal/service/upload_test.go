@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/repository"
+	repomocks "we_book/internal/repository/mocks"
+	"we_book/pkg/blobx"
+	"we_book/pkg/logger"
+)
+
+func Test_uploadService_UploadImage(t *testing.T) {
+	var img bytes.Buffer
+	err := png.Encode(&img, image.NewNRGBA(image.Rect(0, 0, 640, 480)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.UploadRepository
+		data []byte
+
+		wantedErr error
+	}{
+		{
+			name: "上传成功",
+			mock: func(ctrl *gomock.Controller) repository.UploadRepository {
+				repo := repomocks.NewMockUploadRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, u domain.Upload) (int64, error) {
+						if u.ContentType != "image/png" || u.Width != 640 || u.Height != 480 ||
+							!strings.HasSuffix(u.Key, ".png") || !domain.IsUploadKey(u.ThumbKey) {
+							return 0, errors.New("unexpected upload")
+						}
+						return 1, nil
+					})
+				return repo
+			},
+			data: img.Bytes(),
+		},
+		{
+			name: "不是图片",
+			mock: func(ctrl *gomock.Controller) repository.UploadRepository {
+				return repomocks.NewMockUploadRepository(ctrl)
+			},
+			data:      []byte("<html></html>"),
+			wantedErr: ErrUnsupportedUpload,
+		},
+		{
+			name: "太大了",
+			mock: func(ctrl *gomock.Controller) repository.UploadRepository {
+				return repomocks.NewMockUploadRepository(ctrl)
+			},
+			data:      make([]byte, UploadMaxSize+1),
+			wantedErr: ErrUploadTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			blob, err := blobx.NewLocalStorage(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			svc := NewUploadService(tc.mock(ctrl), blob, "/files/", logger.NewNoLogger())
+			u, err := svc.UploadImage(context.Background(), 123, tc.data)
+			assert.Equal(t, tc.wantedErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, "/files/"+u.Key, u.URL)
+			// 原图和缩略图都能读出来
+			data, contentType, err := svc.Open(context.Background(), u.Key)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, "image/png", contentType)
+			assert.Equal(t, tc.data, data)
+			_, contentType, err = svc.Open(context.Background(), u.ThumbKey)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, "image/jpeg", contentType)
+		})
+	}
+}
+
+func Test_uploadService_GC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	blob, err := blobx.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	orphan := domain.Upload{
+		Id:       1,
+		Key:      "img/123/20261018/00000000000000000000000000000001.png",
+		ThumbKey: "img/123/20261018/00000000000000000000000000000001_thumb.jpg",
+	}
+	referenced := domain.Upload{
+		Id:       2,
+		Key:      "img/123/20261018/00000000000000000000000000000002.png",
+		ThumbKey: "img/123/20261018/00000000000000000000000000000002_thumb.jpg",
+	}
+	for _, key := range []string{orphan.Key, orphan.ThumbKey, referenced.Key, referenced.ThumbKey} {
+		if err = blob.Put(context.Background(), key, []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before := time.Now()
+	repo := repomocks.NewMockUploadRepository(ctrl)
+	repo.EXPECT().ListOrphans(gomock.Any(), before, 10).
+		Return([]domain.Upload{orphan, referenced}, nil)
+	repo.EXPECT().DeleteOrphan(gomock.Any(), int64(1)).Return(true, nil)
+	// 查出来之后刚好被文章引用了
+	repo.EXPECT().DeleteOrphan(gomock.Any(), int64(2)).Return(false, nil)
+
+	svc := NewUploadService(repo, blob, "/files", logger.NewNoLogger())
+	n, err := svc.GC(context.Background(), before, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, n)
+	_, err = blob.Get(context.Background(), orphan.ThumbKey)
+	assert.Equal(t, blobx.ErrNotFound, err)
+	_, err = blob.Get(context.Background(), referenced.Key)
+	assert.Equal(t, nil, err)
+}
+
+func Test_uploadService_OpenInvalidKey(t *testing.T) {
+	blob, err := blobx.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = blob.Put(context.Background(), "article/1", []byte("content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewUploadService(nil, blob, "/files", logger.NewNoLogger())
+	// 不是上传的图片，不能通过 /files 读出来
+	_, _, err = svc.Open(context.Background(), "article/1")
+	assert.Equal(t, ErrUploadNotFound, err)
+}
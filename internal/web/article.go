@@ -1,31 +1,41 @@
 package web
 
 import (
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
 	"net/http"
 	"strconv"
+	"time"
 	domain2 "we_book/interactive/domain"
 	service2 "we_book/interactive/service"
 	"we_book/internal/domain"
 	"we_book/internal/service"
 	ijwt "we_book/internal/web/jwt"
+	"we_book/pkg/diffx"
 	"we_book/pkg/ginx/wrapper"
 	logger2 "we_book/pkg/logger"
 )
 
 type ArticleHandler struct {
-	svc     service.ArticleService
-	l       logger2.V1
-	intrSvc service2.InteractiveService
-	biz     string
+	svc       service.ArticleService
+	seriesSvc service.SeriesService
+	l         logger2.V1
+	intrSvc   service2.InteractiveService
+	biz       string
 }
 
-func NewArticleHandler(svc service.ArticleService, l logger2.V1) *ArticleHandler {
+func NewArticleHandler(svc service.ArticleService, seriesSvc service.SeriesService,
+	intrSvc service2.InteractiveService, l logger2.V1) *ArticleHandler {
 	return &ArticleHandler{
-		svc: svc,
-		l:   l,
+		svc:       svc,
+		seriesSvc: seriesSvc,
+		l:         l,
+		intrSvc:   intrSvc,
+		biz:       "article",
 	}
 }
 
@@ -35,12 +45,39 @@ func (at *ArticleHandler) RegisterRouters(server *gin.Engine) {
 	article.POST("/edit", at.Edit)
 	article.POST("/publish", at.Publish)
 	article.POST("/withdraw", at.Withdraw)
+	article.POST("/delete", at.Delete)
 	article.POST("/list",
 		wrapper.WarpBodyANDToken[ListReq, ijwt.UserClaims](at.List))
 	article.GET("/detail/:id",
 		wrapper.WrapToken[ijwt.UserClaims](at.Detail))
+	article.POST("/review", at.Review)
+
+	schedule := article.Group("/schedule")
+	schedule.POST("/list", at.ListScheduled)
+	schedule.POST("/reschedule", at.Reschedule)
+	schedule.POST("/cancel", at.CancelSchedule)
+
+	collab := article.Group("/collaborator")
+	collab.POST("/invite", at.Invite)
+	collab.POST("/accept", at.AcceptInvitation)
+	collab.POST("/decline", at.DeclineInvitation)
+	collab.POST("/remove", at.RemoveCollaborator)
+	collab.POST("/list", at.ListCollaborators)
+	collab.POST("/invitations", at.ListInvitations)
+
+	trash := article.Group("/trash")
+	trash.POST("/list", at.ListTrash)
+	trash.POST("/restore", at.Restore)
+
+	revision := article.Group("/revision")
+	revision.POST("/list", at.ListRevisions)
+	revision.POST("/diff", at.DiffRevisions)
+	revision.POST("/rollback", at.Rollback)
 
 	pub := article.Group("/pub")
+	pub.GET("/tag", at.ListByTag)
+	pub.GET("/category", at.ListByCategory)
+	pub.GET("/tags/popular", at.PopularTags)
 	pub.GET("/:id", at.PubDetail)
 	pub.POST("/like",
 		wrapper.WarpBodyANDToken[LikeReq, ijwt.UserClaims](at.Like))
@@ -57,29 +94,72 @@ func (at *ArticleHandler) PubDetail(ctx *gin.Context) {
 		return
 	}
 	var eg errgroup.Group
-	var art domain.Article
+	var (
+		art      domain.Article
+		rendered domain.RenderedContent
+	)
 	uc := ctx.MustGet("claims").(*ijwt.UserClaims)
 	eg.Go(func() error {
-		art, err = at.svc.GetPubById(ctx, aid, uc.Uid)
-		return err
+		var er error
+		art, er = at.svc.GetPubById(ctx, aid, uc.Uid)
+		if er != nil {
+			return er
+		}
+		rendered, er = at.svc.Render(ctx, art)
+		return er
+	})
+	var intr domain2.Interactive
+	eg.Go(func() error {
+		var er error
+		intr, er = at.intrSvc.Get(ctx, at.biz, aid, uc.Uid)
+		return er
 	})
-	var _ domain2.Interactive
+	var series *SeriesNavVO
 	eg.Go(func() error {
-		_, err = at.intrSvc.Get(ctx, at.biz, aid, uc.Uid)
-		return err
+		nav, er := at.seriesSvc.Navigation(ctx, aid)
+		switch {
+		case er == nil:
+			series = toSeriesNavVO(nav)
+		case !errors.Is(er, service.ErrSeriesNotFound):
+			// 拿不到系列信息不影响看文章
+			at.l.Warn("get article series error",
+				logger2.Int64("aid", aid),
+				logger2.Error(er))
+		}
+		return nil
 	})
 	err = eg.Wait()
+	if errors.Is(err, service.ErrArticleNotFound) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article not found",
+		})
+		return
+	}
 	if err != nil {
+		at.l.Error("get pub article error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
 			Msg:  "system error",
 			Data: nil,
 		})
+		return
+	}
+
+	authors, err := at.svc.Bylines(ctx, art)
+	if err != nil {
+		// 拿不到协作者就只署所有者的名字
+		at.l.Warn("get article bylines error",
+			logger2.Int64("aid", art.Id),
+			logger2.Error(err))
+		authors = []domain.Author{art.Author}
 	}
 
 	go func() {
 		// 增加阅读计数
-		er := at.intrSvc.IncrReadCnt(ctx, at.biz, art.Id)
+		er := at.intrSvc.IncrReadCnt(ctx, at.biz, art.Id, uc.Uid)
 		if er != nil {
 			at.l.Error("add read count error",
 				logger2.Int64("aid", art.Id),
@@ -92,13 +172,44 @@ func (at *ArticleHandler) PubDetail(ctx *gin.Context) {
 		Code: 2,
 		Msg:  "success",
 		Data: ArticleVO{
-			Id:      art.Id,
-			Title:   art.Title,
-			Content: art.Content,
-			Status:  uint8(art.Status),
-			Author:  art.Author.Name,
-			Ctime:   art.Ctime.Format("2006-01-02 15:04:05"),
-			Utime:   art.Utime.Format("2006-01-02 15:04:05"),
+			Id:       art.Id,
+			Title:    art.Title,
+			Abstract: rendered.Abstract,
+			Content:  art.Content,
+			HTML:     rendered.HTML,
+			TOC: slice.Map(rendered.TOC, func(idx int, src domain.TOCItem) TOCItemVO {
+				return TOCItemVO{
+					Level: src.Level,
+					Id:    src.Id,
+					Title: src.Title,
+				}
+			}),
+			Status: uint8(art.Status),
+			Author: art.Author.Name,
+			Authors: slice.Map[domain.Author, AuthorVO](authors, func(idx int, src domain.Author) AuthorVO {
+				return AuthorVO{
+					Id:   src.Id,
+					Name: src.Name,
+				}
+			}),
+			Category: art.Category,
+			Tags:     art.Tags,
+			Series:   series,
+			Ctime:    art.Ctime.Format("2006-01-02 15:04:05"),
+			Utime:    art.Utime.Format("2006-01-02 15:04:05"),
+
+			WordCnt:     art.Stats.Words,
+			CharCnt:     art.Stats.Chars,
+			ImageCnt:    art.Stats.Images,
+			ReadingTime: art.Stats.ReadingMinutes(),
+
+			ReadCnt:    intr.ReadCnt,
+			LikeCnt:    intr.LikedCnt,
+			CollectCnt: intr.CollectCnt,
+			CommentCnt: intr.CommentCnt,
+			UvCnt:      intr.UvCnt,
+			Liked:      intr.Liked,
+			Collected:  intr.Collected,
 		},
 	})
 }
@@ -112,14 +223,8 @@ func (at *ArticleHandler) Detail(ctx *gin.Context, claims ijwt.UserClaims) (wrap
 			Msg:  "id error",
 		}, err
 	}
-	art, err := at.svc.GetById(ctx, id)
-	if err != nil {
-		return wrapper.Result{
-			Code: 5,
-			Msg:  "system error",
-		}, err
-	}
-	if art.Author.Id != claims.Uid {
+	art, err := at.svc.GetDraft(ctx, id, claims.Uid)
+	if errors.Is(err, service.ErrArticlePermissionDenied) {
 		// 此时用户为非法攻击用户
 		// 应该报警
 		return wrapper.Result{
@@ -127,21 +232,47 @@ func (at *ArticleHandler) Detail(ctx *gin.Context, claims ijwt.UserClaims) (wrap
 			Msg:  "not your article",
 		}, err
 	}
+	if err != nil {
+		return wrapper.Result{
+			Code: 5,
+			Msg:  "system error",
+		}, err
+	}
 	return wrapper.Result{
 		Code: 2,
 		Msg:  "success",
 		Data: ArticleVO{
-			Id:      art.Id,
-			Title:   art.Title,
-			Content: art.Content,
-			Status:  uint8(art.Status),
-			Ctime:   art.Ctime.Format("2006-01-02 15:04:05"),
-			Utime:   art.Utime.Format("2006-01-02 15:04:05"),
+			Id:       art.Id,
+			Title:    art.Title,
+			Content:  art.Content,
+			Status:   uint8(art.Status),
+			Category: art.Category,
+			Tags:     art.Tags,
+			Ctime:    art.Ctime.Format("2006-01-02 15:04:05"),
+			Utime:    art.Utime.Format("2006-01-02 15:04:05"),
+
+			WordCnt:     art.Stats.Words,
+			CharCnt:     art.Stats.Chars,
+			ImageCnt:    art.Stats.Images,
+			ReadingTime: art.Stats.ReadingMinutes(),
 		},
 	}, nil
 }
 
 func (at *ArticleHandler) List(ctx *gin.Context, req ListReq, uc ijwt.UserClaims) (wrapper.Result, error) {
+	switch req.Sort {
+	case "":
+	case sortLongest, sortShortest:
+		return at.listByLength(ctx, req, uc)
+	default:
+		return wrapper.Result{
+			Code: 4,
+			Msg:  "sort error",
+		}, nil
+	}
+	if req.Cursor != nil {
+		return at.listByCursor(ctx, *req.Cursor, pubPageLimit(req.Limit), uc)
+	}
 	res, err := at.svc.List(ctx, uc.Uid, req.OffSet, req.Limit)
 	if err != nil {
 		return wrapper.Result{
@@ -152,16 +283,101 @@ func (at *ArticleHandler) List(ctx *gin.Context, req ListReq, uc ijwt.UserClaims
 	return wrapper.Result{
 		Code: 2,
 		Msg:  "success",
-		Data: slice.Map[domain.Article, ArticleVO](res,
-			func(idx int, src domain.Article) ArticleVO {
-				return ArticleVO{
-					Id:       src.Id,
-					Title:    src.Title,
-					Abstract: src.Abstract(),
-					Ctime:    src.Ctime.Format("2006-01-02 15:04:05"),
-					Utime:    src.Utime.Format("2006-01-02 15:04:05"),
-				}
-			}),
+		Data: slice.Map[domain.Article, ArticleVO](res, toAuthorListVO),
+	}, nil
+}
+
+const (
+	sortLongest  = "longest"
+	sortShortest = "shortest"
+)
+
+func (at *ArticleHandler) listByLength(ctx *gin.Context, req ListReq, uc ijwt.UserClaims) (wrapper.Result, error) {
+	res, err := at.svc.ListByLength(ctx, uc.Uid, req.Sort == sortShortest, req.OffSet, pubPageLimit(req.Limit))
+	if err != nil {
+		return wrapper.Result{
+			Code: 5,
+			Msg:  "system error",
+		}, err
+	}
+	return wrapper.Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.Article, ArticleVO](res, toAuthorListVO),
+	}, nil
+}
+
+func (at *ArticleHandler) listByCursor(ctx *gin.Context, cursorStr string, limit int, uc ijwt.UserClaims) (wrapper.Result, error) {
+	cursor, err := decodeCursor(cursorStr)
+	if err != nil {
+		return wrapper.Result{
+			Code: 4,
+			Msg:  "cursor error",
+		}, nil
+	}
+	res, err := at.svc.ListByCursor(ctx, uc.Uid, cursor, limit)
+	if err != nil {
+		return wrapper.Result{
+			Code: 5,
+			Msg:  "system error",
+		}, err
+	}
+	next := ""
+	// 不满一页说明没有下一页了
+	if len(res) == limit {
+		next = encodeCursor(domain.NextCursor(res))
+	}
+	return wrapper.Result{
+		Code: 2,
+		Msg:  "success",
+		Data: ArticleListVO{
+			Articles: slice.Map[domain.Article, ArticleVO](res, toAuthorListVO),
+			Cursor:   next,
+		},
+	}, nil
+}
+
+func toAuthorListVO(idx int, src domain.Article) ArticleVO {
+	return ArticleVO{
+		Id:       src.Id,
+		Title:    src.Title,
+		Abstract: src.Abstract(),
+		Status:   uint8(src.Status),
+		Category: src.Category,
+		Tags:     src.Tags,
+		Ctime:    src.Ctime.Format("2006-01-02 15:04:05"),
+		Utime:    src.Utime.Format("2006-01-02 15:04:05"),
+
+		WordCnt:     src.Stats.Words,
+		CharCnt:     src.Stats.Chars,
+		ImageCnt:    src.Stats.Images,
+		ReadingTime: src.Stats.ReadingMinutes(),
+	}
+}
+
+// encodeCursor 前端只管原样传回来，不需要知道里面是什么
+func encodeCursor(c domain.ArticleCursor) string {
+	raw := fmt.Sprintf("%d_%d", c.Utime.UnixMilli(), c.Id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor 空字符串是第一页
+func decodeCursor(s string) (domain.ArticleCursor, error) {
+	if s == "" {
+		return domain.ArticleCursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return domain.ArticleCursor{}, err
+	}
+	var utime, id int64
+	_, err = fmt.Sscanf(string(raw), "%d_%d", &utime, &id)
+	if err != nil {
+		return domain.ArticleCursor{}, err
+	}
+	return domain.ArticleCursor{
+		Utime: time.UnixMilli(utime),
+		Id:    id,
 	}, nil
 }
 
@@ -183,6 +399,23 @@ func (at *ArticleHandler) Edit(ctx *gin.Context) {
 	}
 	// 校验部分
 	id, err := at.svc.Edit(ctx, req.toDomain(claims.Uid))
+	if errors.Is(err, service.ErrInvalidTaxonomy) {
+		ctx.JSON(200, Result{
+			Code: 4,
+			Msg:  "tags or category error",
+		})
+		return
+	}
+	if errors.Is(err, service.ErrArticlePermissionDenied) {
+		at.l.Warn("edit article without permission",
+			logger2.Int64("aid", req.Id),
+			logger2.Int64("uid", claims.Uid))
+		ctx.JSON(200, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(200, Result{
 			Code: 5,
@@ -215,13 +448,44 @@ func (at *ArticleHandler) Publish(ctx *gin.Context) {
 		at.l.Error("not find user session")
 		return
 	}
+	if req.PublishAt > 0 {
+		at.schedulePublish(ctx, req, claims.Uid)
+		return
+	}
 	id, err := at.svc.Publish(ctx, req.toDomain(claims.Uid))
+	if errors.Is(err, service.ErrArticlePendingReview) {
+		// 草稿已经保存了，前端提示作者等待审核
+		ctx.JSON(http.StatusOK, Result{
+			Code: 2,
+			Msg:  "pending review",
+			Data: id,
+		})
+		return
+	}
+	if errors.Is(err, service.ErrInvalidTaxonomy) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "tags or category error",
+		})
+		return
+	}
+	if errors.Is(err, service.ErrArticlePermissionDenied) {
+		at.l.Warn("publish article without permission",
+			logger2.Int64("aid", req.Id),
+			logger2.Int64("uid", claims.Uid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
 			Msg:  "publish article error",
 		})
 		at.l.Error("save article error")
+		return
 	}
 	ctx.JSON(http.StatusOK, Result{
 		Code: 2,
@@ -254,6 +518,16 @@ func (at *ArticleHandler) Withdraw(ctx *gin.Context) {
 			Id: claims.Uid,
 		},
 	})
+	if errors.Is(err, service.ErrArticlePermissionDenied) {
+		at.l.Warn("withdraw article without permission",
+			logger2.Int64("aid", req.Id),
+			logger2.Int64("uid", claims.Uid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -267,25 +541,808 @@ func (at *ArticleHandler) Withdraw(ctx *gin.Context) {
 	})
 }
 
-func (at *ArticleHandler) Like(ctx *gin.Context, req LikeReq, claims ijwt.UserClaims) (wrapper.Result, error) {
-	var err error
-	if req.Like {
-		err = at.intrSvc.Like(ctx, at.biz, req.Id, claims.Uid)
-	} else {
-		err = at.intrSvc.CancelLike(ctx, at.biz, req.Id, claims.Uid)
+// Invite 只有所有者可以邀请，role 是 editor 或者 viewer
+func (at *ArticleHandler) Invite(ctx *gin.Context) {
+	var req InviteReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
 	}
+	err := at.svc.Invite(ctx, req.Id, claims.Uid, req.Uid, domain.CollaboratorRoleOf(req.Role))
+	if err != nil {
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
 
+func (at *ArticleHandler) AcceptInvitation(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.AcceptInvitation(ctx, req.Id, claims.Uid)
 	if err != nil {
-		return wrapper.Result{
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) DeclineInvitation(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
 			Msg:  "system error",
-			Data: nil,
-		}, err
+		})
+		at.l.Error("not find user session")
+		return
 	}
-	return wrapper.Result{
+	err := at.svc.DeclineInvitation(ctx, req.Id, claims.Uid)
+	if err != nil {
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
 		Code: 2,
 		Msg:  "success",
-	}, nil
+	})
+}
+
+// RemoveCollaborator uid 是自己的时候就是退出协作
+func (at *ArticleHandler) RemoveCollaborator(ctx *gin.Context) {
+	var req RemoveCollaboratorReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.RemoveCollaborator(ctx, req.Id, claims.Uid, req.Uid)
+	if err != nil {
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) ListCollaborators(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	res, err := at.svc.ListCollaborators(ctx, req.Id, claims.Uid)
+	if err != nil {
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.Collaborator, CollaboratorVO](res, toCollaboratorVO),
+	})
+}
+
+// ListInvitations 自己收到的、还没有处理的邀请
+func (at *ArticleHandler) ListInvitations(ctx *gin.Context) {
+	var req ListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	res, err := at.svc.ListInvitations(ctx, claims.Uid, req.OffSet, pubPageLimit(req.Limit))
+	if err != nil {
+		at.l.Error("list invitations error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.Collaborator, CollaboratorVO](res, toCollaboratorVO),
+	})
+}
+
+// Review 作者查看文章最近一次复审的状态和驳回的原因
+func (at *ArticleHandler) Review(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	review, err := at.svc.GetReview(ctx, req.Id, claims.Uid)
+	if errors.Is(err, service.ErrReviewNotFound) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "review not found",
+		})
+		return
+	}
+	if err != nil {
+		at.collaboratorError(ctx, err, req.Id)
+		return
+	}
+	vo := toReviewVO(0, review)
+	// 命中了哪些词不告诉作者
+	vo.Hits = nil
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: vo,
+	})
+}
+
+func toCollaboratorVO(idx int, src domain.Collaborator) CollaboratorVO {
+	return CollaboratorVO{
+		ArticleId: src.ArticleId,
+		Uid:       src.User.Id,
+		Name:      src.User.Name,
+		Role:      src.Role.String(),
+		Status:    src.Status.String(),
+		Inviter:   src.Inviter,
+		Ctime:     src.Ctime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (at *ArticleHandler) collaboratorError(ctx *gin.Context, err error, aid int64) {
+	switch {
+	case errors.Is(err, service.ErrNotArticleAuthor),
+		errors.Is(err, service.ErrArticlePermissionDenied):
+		at.l.Warn("no permission on the article",
+			logger2.Int64("aid", aid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+	case errors.Is(err, service.ErrInvalidCollaborator):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "collaborator error",
+		})
+	case errors.Is(err, service.ErrInvitationNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "invitation not found",
+		})
+	default:
+		at.l.Error("article collaborator error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
+
+func (at *ArticleHandler) Like(ctx *gin.Context, req LikeReq, claims ijwt.UserClaims) (wrapper.Result, error) {
+	var err error
+	if req.Like {
+		err = at.intrSvc.Like(ctx, at.biz, req.Id, claims.Uid)
+	} else {
+		err = at.intrSvc.CancelLike(ctx, at.biz, req.Id, claims.Uid)
+	}
+
+	if err != nil {
+		return wrapper.Result{
+			Code: 5,
+			Msg:  "system error",
+			Data: nil,
+		}, err
+	}
+	return wrapper.Result{
+		Code: 2,
+		Msg:  "success",
+	}, nil
+}
+
+func (at *ArticleHandler) ListRevisions(ctx *gin.Context) {
+	var req RevisionListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	revs, err := at.svc.ListRevisions(ctx, req.Id, claims.Uid, req.OffSet, pubPageLimit(req.Limit))
+	if err != nil {
+		at.revisionError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.ArticleRevision, RevisionVO](revs,
+			func(idx int, src domain.ArticleRevision) RevisionVO {
+				return RevisionVO{
+					Id:      src.Id,
+					Version: src.Version,
+					Title:   src.Title,
+					Ctime:   src.Ctime.Format("2006-01-02 15:04:05"),
+					Editor:  src.Editor.Id,
+				}
+			}),
+	})
+}
+
+func (at *ArticleHandler) DiffRevisions(ctx *gin.Context) {
+	var req RevisionDiffReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	lines, err := at.svc.DiffRevisions(ctx, req.Id, claims.Uid, req.From, req.To)
+	if err != nil {
+		at.revisionError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[diffx.Line, DiffLineVO](lines,
+			func(idx int, src diffx.Line) DiffLineVO {
+				return DiffLineVO{
+					Op:   src.Op.String(),
+					Text: src.Text,
+				}
+			}),
+	})
+}
+
+func (at *ArticleHandler) Rollback(ctx *gin.Context) {
+	var req RollbackReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.Rollback(ctx, req.Id, claims.Uid, req.RevisionId)
+	if err != nil {
+		at.revisionError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) revisionError(ctx *gin.Context, err error, aid int64) {
+	switch {
+	case errors.Is(err, service.ErrNotArticleAuthor):
+		// 有人在尝试看别人的历史版本
+		at.l.Warn("not the author of the article",
+			logger2.Int64("aid", aid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "not your article",
+		})
+	case errors.Is(err, service.ErrInvalidRevision):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "revision error",
+		})
+	case errors.Is(err, service.ErrArticleNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article not found",
+		})
+	default:
+		at.l.Error("article revision error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
+
+func (at *ArticleHandler) schedulePublish(ctx *gin.Context, req ArticleReq, uid int64) {
+	id, err := at.svc.SchedulePublish(ctx, req.toDomain(uid), time.UnixMilli(req.PublishAt))
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, Result{
+			Code: 2,
+			Msg:  "success",
+			Data: id,
+		})
+	case errors.Is(err, service.ErrInvalidPublishTime):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "publish time error",
+		})
+	case errors.Is(err, service.ErrInvalidTaxonomy):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "tags or category error",
+		})
+	case errors.Is(err, service.ErrArticlePermissionDenied):
+		at.l.Warn("schedule article without permission",
+			logger2.Int64("aid", req.Id),
+			logger2.Int64("uid", uid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+	default:
+		at.l.Error("schedule publish error",
+			logger2.Int64("uid", uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "publish article error",
+		})
+	}
+}
+
+func (at *ArticleHandler) ListScheduled(ctx *gin.Context) {
+	var req ListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	res, err := at.svc.ListScheduled(ctx, claims.Uid, req.OffSet, req.Limit)
+	if err != nil {
+		at.l.Error("list scheduled article error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.ScheduledPublication, ScheduledVO](res,
+			func(idx int, src domain.ScheduledPublication) ScheduledVO {
+				return ScheduledVO{
+					Id:        src.Article.Id,
+					Title:     src.Article.Title,
+					Abstract:  src.Article.Abstract(),
+					PublishAt: src.PublishAt.Format("2006-01-02 15:04:05"),
+				}
+			}),
+	})
+}
+
+func (at *ArticleHandler) Reschedule(ctx *gin.Context) {
+	var req RescheduleReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.Reschedule(ctx, req.Id, claims.Uid, time.UnixMilli(req.PublishAt))
+	if err != nil {
+		at.scheduleError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) CancelSchedule(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.CancelSchedule(ctx, req.Id, claims.Uid)
+	if err != nil {
+		at.scheduleError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) scheduleError(ctx *gin.Context, err error, aid int64) {
+	switch {
+	case errors.Is(err, service.ErrNotArticleAuthor):
+		at.l.Warn("not the author of the article",
+			logger2.Int64("aid", aid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "not your article",
+		})
+	case errors.Is(err, service.ErrInvalidPublishTime):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "publish time error",
+		})
+	case errors.Is(err, service.ErrScheduleNotPending):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article is publishing or not scheduled",
+		})
+	default:
+		at.l.Error("article schedule error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
+
+// Delete 删除的文章先进回收站
+func (at *ArticleHandler) Delete(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.Trash(ctx, req.Id, claims.Uid)
+	if err != nil {
+		at.trashError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) ListTrash(ctx *gin.Context) {
+	var req ListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	res, err := at.svc.ListTrash(ctx, claims.Uid, req.OffSet, req.Limit)
+	if err != nil {
+		at.l.Error("list trashed article error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.Article, TrashVO](res,
+			func(idx int, src domain.Article) TrashVO {
+				return TrashVO{
+					Id:       src.Id,
+					Title:    src.Title,
+					Abstract: src.Abstract(),
+					Dtime:    src.Dtime.Format("2006-01-02 15:04:05"),
+					ExpireAt: src.Dtime.Add(service.TrashRetention).Format("2006-01-02 15:04:05"),
+				}
+			}),
+	})
+}
+
+func (at *ArticleHandler) Restore(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		at.l.Error("not find user session")
+		return
+	}
+	err := at.svc.Restore(ctx, req.Id, claims.Uid)
+	if err != nil {
+		at.trashError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (at *ArticleHandler) trashError(ctx *gin.Context, err error, aid int64) {
+	switch {
+	case errors.Is(err, service.ErrNotArticleAuthor):
+		at.l.Warn("not the author of the article",
+			logger2.Int64("aid", aid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "not your article",
+		})
+	case errors.Is(err, service.ErrScheduleNotPending):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article is publishing",
+		})
+	case errors.Is(err, service.ErrNotInTrash):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article is not in trash",
+		})
+	case errors.Is(err, service.ErrTrashExpired):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article has expired",
+		})
+	default:
+		at.l.Error("article trash error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
+
+func (at *ArticleHandler) ListByTag(ctx *gin.Context) {
+	var req TagListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Tag == "" {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "tag is empty",
+		})
+		return
+	}
+	res, err := at.svc.ListPubByTag(ctx, req.Tag, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		at.l.Error("list article by tag error",
+			logger2.String("tag", req.Tag),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toPubListVO(res),
+	})
+}
+
+func (at *ArticleHandler) ListByCategory(ctx *gin.Context) {
+	var req CategoryListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Category == "" {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "category is empty",
+		})
+		return
+	}
+	res, err := at.svc.ListPubByCategory(ctx, req.Category, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		at.l.Error("list article by category error",
+			logger2.String("category", req.Category),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toPubListVO(res),
+	})
+}
+
+func (at *ArticleHandler) PopularTags(ctx *gin.Context) {
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	tags, err := at.svc.PopularTags(ctx, pubPageLimit(limit))
+	if err != nil {
+		at.l.Error("get popular tags error", logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.Tag, TagVO](tags, func(idx int, src domain.Tag) TagVO {
+			return TagVO{
+				Name: src.Name,
+				Cnt:  src.Cnt,
+			}
+		}),
+	})
+}
+
+// pubPageLimit 读者侧的列表一页最多 100 条，不传默认 10 条
+func pubPageLimit(limit int) int {
+	if limit <= 0 {
+		return 10
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+func toPubListVO(arts []domain.Article) []ArticleVO {
+	return slice.Map[domain.Article, ArticleVO](arts,
+		func(idx int, src domain.Article) ArticleVO {
+			return ArticleVO{
+				Id:       src.Id,
+				Title:    src.Title,
+				Abstract: src.Abstract(),
+				Category: src.Category,
+				Tags:     src.Tags,
+				Ctime:    src.Ctime.Format("2006-01-02 15:04:05"),
+				Utime:    src.Utime.Format("2006-01-02 15:04:05"),
+
+				WordCnt:     src.Stats.Words,
+				ImageCnt:    src.Stats.Images,
+				ReadingTime: src.Stats.ReadingMinutes(),
+			}
+		})
 }
 
 func kthDistinct(arr []string, k int) string {
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 	"we_book/internal/domain"
 	"we_book/pkg/logger"
 
@@ -96,7 +97,7 @@ func TestArticleHandler_Publish(t *testing.T) {
 				})
 			})
 
-			h := NewArticleHandler(tc.mock(ctrl), &logger.ZapLogger{})
+			h := NewArticleHandler(tc.mock(ctrl), nil, nil, &logger.ZapLogger{})
 			h.RegisterRouters(server)
 
 			req, err := http.NewRequest(http.MethodPost,
@@ -117,3 +118,112 @@ func TestArticleHandler_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestArticleHandler_Revision(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) service.ArticleService
+
+		path    string
+		reqBody string
+
+		wantRes Result
+	}{
+		{
+			name: "每页最多 100 个版本",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().ListRevisions(gomock.Any(), int64(1), int64(123), 0, 100).
+					Return(nil, nil)
+				return svc
+			},
+			path:    "/articles/revision/list",
+			reqBody: `{"id":1,"limit":100000}`,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "success",
+				Data: []any{},
+			},
+		},
+		{
+			name: "版本不存在",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Rollback(gomock.Any(), int64(1), int64(123), int64(10)).
+					Return(service.ErrInvalidRevision)
+				return svc
+			},
+			path:    "/articles/revision/rollback",
+			reqBody: `{"id":1,"revision_id":10}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "revision error",
+			},
+		},
+		{
+			name: "文章不存在",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().DiffRevisions(gomock.Any(), int64(1), int64(123), int64(10), int64(11)).
+					Return(nil, service.ErrArticleNotFound)
+				return svc
+			},
+			path:    "/articles/revision/diff",
+			reqBody: `{"id":1,"from":10,"to":11}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "article not found",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", &ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+
+			h := NewArticleHandler(tc.mock(ctrl), nil, nil, &logger.ZapLogger{})
+			h.RegisterRouters(server)
+
+			req, err := http.NewRequest(http.MethodPost,
+				tc.path, bytes.NewBuffer([]byte(tc.reqBody)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			var webRes Result
+			err = json.NewDecoder(resp.Body).Decode(&webRes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRes, webRes)
+		})
+	}
+}
+
+func TestCursorCodec(t *testing.T) {
+	c := domain.ArticleCursor{
+		Utime: time.UnixMilli(1700000000123),
+		Id:    42,
+	}
+	res, err := decodeCursor(encodeCursor(c))
+	require.NoError(t, err)
+	assert.Equal(t, c.Utime.UnixMilli(), res.Utime.UnixMilli())
+	assert.Equal(t, c.Id, res.Id)
+
+	// 空字符串是第一页
+	res, err = decodeCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, true, res.IsZero())
+
+	_, err = decodeCursor("not a cursor")
+	assert.NotEqual(t, nil, err)
+}
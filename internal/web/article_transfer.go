@@ -0,0 +1,251 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// maxImportSize 上传的压缩包大小上限
+const maxImportSize = 20 << 20
+
+// ArticleTransferHandler 文章的批量导入导出，都是后台任务，前端轮询进度
+type ArticleTransferHandler struct {
+	svc service.ArticleTransferService
+	l   logger2.V1
+}
+
+func NewArticleTransferHandler(svc service.ArticleTransferService, l logger2.V1) *ArticleTransferHandler {
+	return &ArticleTransferHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ArticleTransferHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/articles")
+	g.POST("/import", h.Import)
+	g.POST("/export", h.Export)
+	g.GET("/transfer/:id", h.Detail)
+	g.GET("/transfer/:id/download", h.Download)
+}
+
+// Import 表单字段 file 是 zip 压缩包，里面是 Markdown 或者 JSON 文件
+func (h *ArticleTransferHandler) Import(ctx *gin.Context) {
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "file is required",
+		})
+		return
+	}
+	if fh.Size > maxImportSize {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "file too large",
+		})
+		return
+	}
+	f, err := fh.Open()
+	if err != nil {
+		h.l.Error("open upload file error", logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxImportSize))
+	if err != nil {
+		h.l.Error("read upload file error", logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	id, err := h.svc.Import(ctx, claims.Uid, data)
+	if errors.Is(err, service.ErrInvalidBundle) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "invalid zip file",
+		})
+		return
+	}
+	if err != nil {
+		h.l.Error("import articles error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: id,
+	})
+}
+
+// Export format 是 markdown 或者 json，默认 markdown
+func (h *ArticleTransferHandler) Export(ctx *gin.Context) {
+	type Req struct {
+		Format string `json:"format"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	format := domain.TransferFormatMarkdown
+	if req.Format != "" {
+		format = domain.TransferFormatOf(req.Format)
+	}
+	id, err := h.svc.Export(ctx, claims.Uid, format)
+	if errors.Is(err, service.ErrInvalidTransferFormat) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "invalid format",
+		})
+		return
+	}
+	if err != nil {
+		h.l.Error("export articles error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: id,
+	})
+}
+
+func (h *ArticleTransferHandler) Detail(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "id error",
+		})
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	task, err := h.svc.GetTask(ctx, id, claims.Uid)
+	if err != nil {
+		h.transferError(ctx, err, id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: TransferTaskVO{
+			Id:       task.Id,
+			Type:     task.Type.String(),
+			Format:   task.Format.String(),
+			Status:   task.Status.String(),
+			Total:    task.Total,
+			Done:     task.Done,
+			Failed:   task.Failed,
+			Errors:   task.Errors,
+			Msg:      task.Msg,
+			Finished: task.Finished(),
+			Ctime:    task.Ctime.Format("2006-01-02 15:04:05"),
+			Utime:    task.Utime.Format("2006-01-02 15:04:05"),
+		},
+	})
+}
+
+// Download 把 zip 文件从存储里面边读边返回
+func (h *ArticleTransferHandler) Download(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "id error",
+		})
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	body, size, err := h.svc.Download(ctx, id, claims.Uid)
+	if err != nil {
+		h.transferError(ctx, err, id)
+		return
+	}
+	defer body.Close()
+	ctx.DataFromReader(http.StatusOK, size, "application/zip", body, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="articles-%d.zip"`, id),
+	})
+}
+
+func (h *ArticleTransferHandler) transferError(ctx *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, service.ErrTransferTaskNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "task not found",
+		})
+	case errors.Is(err, service.ErrTransferNotReady):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "export is not ready",
+		})
+	default:
+		h.l.Error("article transfer error",
+			logger2.Int64("task_id", id),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
@@ -6,11 +6,69 @@ type ArticleVO struct {
 	Id       int64  `json:"id"`
 	Title    string `json:"title"`
 	Abstract string `json:"abstract"`
-	Content  string `json:"content"`
-	Status   uint8  `json:"status"`
-	Author   string `json:"author"`
-	Ctime    string `json:"ctime"`
-	Utime    string `json:"utime"`
+	// Snippet 搜索结果里面高亮过的摘要
+	Snippet string `json:"snippet,omitempty"`
+	Content string `json:"content"`
+	// HTML 和 TOC 只有读者端详情才有
+	HTML   string      `json:"html,omitempty"`
+	TOC    []TOCItemVO `json:"toc,omitempty"`
+	Status uint8       `json:"status"`
+	Author string      `json:"author"`
+	// Authors 读者端的署名，所有者在前面，然后是编辑
+	Authors  []AuthorVO `json:"authors,omitempty"`
+	Category string     `json:"category"`
+	Tags     []string   `json:"tags"`
+	// Series 读者端详情才有，文章不在系列里面就没有
+	Series *SeriesNavVO `json:"series,omitempty"`
+	Ctime  string       `json:"ctime"`
+	Utime  string       `json:"utime"`
+
+	// WordCnt 中日韩文字一个字算一个词，ReadingTime 是预计阅读的分钟数
+	WordCnt     int `json:"word_cnt"`
+	CharCnt     int `json:"char_cnt"`
+	ImageCnt    int `json:"image_cnt"`
+	ReadingTime int `json:"reading_time"`
+
+	ReadCnt    int64 `json:"read_cnt"`
+	LikeCnt    int64 `json:"like_cnt"`
+	CollectCnt int64 `json:"collect_cnt"`
+	CommentCnt int64 `json:"comment_cnt"`
+	UvCnt      int64 `json:"uv_cnt"`
+	Liked      bool  `json:"liked"`
+	Collected  bool  `json:"collected"`
+}
+
+type AuthorVO struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type InviteReq struct {
+	Id   int64  `json:"id"`
+	Uid  int64  `json:"uid"`
+	Role string `json:"role"`
+}
+
+type RemoveCollaboratorReq struct {
+	Id  int64 `json:"id"`
+	Uid int64 `json:"uid"`
+}
+
+// CollaboratorVO role 是 editor 或者 viewer，status 是 pending、accepted 或者 declined
+type CollaboratorVO struct {
+	ArticleId int64  `json:"article_id"`
+	Uid       int64  `json:"uid"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Status    string `json:"status"`
+	Inviter   int64  `json:"inviter"`
+	Ctime     string `json:"ctime"`
+}
+
+type TOCItemVO struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Title string `json:"title"`
 }
 
 type LikeReq struct {
@@ -22,19 +80,156 @@ type ArticleReq struct {
 	Id      int64  `json:"id"`
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	// Category 为空就是没有分类
+	Category string   `json:"category"`
+	Tags     []string `json:"tags"`
+	// PublishAt 毫秒时间戳，发表时带上就是定时发布
+	PublishAt int64 `json:"publish_at"`
+}
+
+type TagVO struct {
+	Name string `json:"name"`
+	Cnt  int64  `json:"cnt"`
+}
+
+type SearchReq struct {
+	Q      string `form:"q"`
+	Offset int    `form:"offset"`
+	Limit  int    `form:"limit"`
+}
+
+type TagListReq struct {
+	Tag    string `form:"tag"`
+	Offset int    `form:"offset"`
+	Limit  int    `form:"limit"`
+}
+
+type CategoryListReq struct {
+	Category string `form:"category"`
+	Offset   int    `form:"offset"`
+	Limit    int    `form:"limit"`
+}
+
+type ScheduledVO struct {
+	Id        int64  `json:"id"`
+	Title     string `json:"title"`
+	Abstract  string `json:"abstract"`
+	PublishAt string `json:"publish_at"`
+}
+
+// TrashVO 回收站里的文章，ExpireAt 之后会被彻底删除
+type TrashVO struct {
+	Id       int64  `json:"id"`
+	Title    string `json:"title"`
+	Abstract string `json:"abstract"`
+	Dtime    string `json:"dtime"`
+	ExpireAt string `json:"expire_at"`
+}
+
+type RescheduleReq struct {
+	Id        int64 `json:"id"`
+	PublishAt int64 `json:"publish_at"`
 }
 
 type ListReq struct {
 	OffSet int `json:"off_set"`
 	Limit  int `json:"limit"`
+	// Cursor 带上这个字段就按照游标翻页，第一页传空字符串
+	// 下一页传上一次返回的 cursor，这个时候忽略 OffSet
+	Cursor *string `json:"cursor"`
+	// Sort 为空按照更新时间倒序，longest 按照字数从多到少，shortest 从少到多
+	// 按照字数排序的时候只支持 OffSet 翻页
+	Sort string `json:"sort"`
+}
+
+// ArticleListVO 游标分页的结果，Cursor 为空说明没有下一页了
+type ArticleListVO struct {
+	Articles []ArticleVO `json:"articles"`
+	Cursor   string      `json:"cursor"`
+}
+
+type RevisionVO struct {
+	Id      int64  `json:"id"`
+	Version int64  `json:"version"`
+	Title   string `json:"title"`
+	Ctime   string `json:"ctime"`
+	// Editor 保存出这个版本的人
+	Editor int64 `json:"editor"`
+}
+
+// DiffLineVO op 为 " "、"+" 或者 "-"
+type DiffLineVO struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionListReq struct {
+	Id     int64 `json:"id"`
+	OffSet int   `json:"off_set"`
+	Limit  int   `json:"limit"`
+}
+
+type RevisionDiffReq struct {
+	Id   int64 `json:"id"`
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+type RollbackReq struct {
+	Id         int64 `json:"id"`
+	RevisionId int64 `json:"revision_id"`
 }
 
 func (req ArticleReq) toDomain(uid int64) domain.Article {
 	return domain.Article{
+		Id:      req.Id,
 		Title:   req.Title,
 		Content: req.Content,
 		Author: domain.Author{
 			Id: uid,
 		},
+		Category: req.Category,
+		Tags:     req.Tags,
+	}
+}
+
+// TransferTaskVO 导入导出任务的进度，Finished 之后前端就不用再轮询了
+type TransferTaskVO struct {
+	Id       int64    `json:"id"`
+	Type     string   `json:"type"`
+	Format   string   `json:"format"`
+	Status   string   `json:"status"`
+	Total    int      `json:"total"`
+	Done     int      `json:"done"`
+	Failed   int      `json:"failed"`
+	Errors   []string `json:"errors,omitempty"`
+	Msg      string   `json:"msg,omitempty"`
+	Finished bool     `json:"finished"`
+	Ctime    string   `json:"ctime"`
+	Utime    string   `json:"utime"`
+}
+
+// ReviewVO 文章的复审，Hits 只在管理后台返回
+type ReviewVO struct {
+	ArticleId int64    `json:"article_id"`
+	Author    int64    `json:"author"`
+	Status    string   `json:"status"`
+	Hits      []string `json:"hits,omitempty"`
+	Reason    string   `json:"reason,omitempty"`
+	Reviewer  int64    `json:"reviewer,omitempty"`
+	Ctime     string   `json:"ctime"`
+	Utime     string   `json:"utime"`
+}
+
+func toReviewVO(idx int, src domain.ArticleReview) ReviewVO {
+	return ReviewVO{
+		ArticleId: src.ArticleId,
+		Author:    src.Author.Id,
+		Status:    src.Status.String(),
+		Hits:      src.Hits,
+		Reason:    src.Reason,
+		Reviewer:  src.Reviewer,
+		Ctime:     src.Ctime.Format("2006-01-02 15:04:05"),
+		Utime:     src.Utime.Format("2006-01-02 15:04:05"),
 	}
 }
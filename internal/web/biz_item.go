@@ -0,0 +1,63 @@
+package web
+
+import (
+	"context"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+)
+
+// BizItemVO 点赞、收藏、阅读历史里面的一个资源
+// 只有文章有标题和摘要，Removed 表示文章已经撤回或者删除了
+type BizItemVO struct {
+	Biz      string `json:"biz"`
+	BizId    int64  `json:"biz_id"`
+	Title    string `json:"title"`
+	Abstract string `json:"abstract"`
+	Author   string `json:"author"`
+	Removed  bool   `json:"removed"`
+}
+
+// articleFiller 一次查出列表里面的文章，补上标题和摘要
+type articleFiller struct {
+	artSvc service.ArticleService
+	// biz 文章的 biz，只有文章会补充标题和摘要
+	biz string
+}
+
+func newArticleFiller(artSvc service.ArticleService) articleFiller {
+	return articleFiller{
+		artSvc: artSvc,
+		biz:    "article",
+	}
+}
+
+func (f articleFiller) fill(ctx context.Context, items []*BizItemVO) error {
+	aids := make([]int64, 0, len(items))
+	for _, item := range items {
+		if item.Biz == f.biz {
+			aids = append(aids, item.BizId)
+		}
+	}
+	arts, err := f.artSvc.ListPubByIds(ctx, aids)
+	if err != nil {
+		return err
+	}
+	artMap := make(map[int64]domain.Article, len(arts))
+	for _, art := range arts {
+		artMap[art.Id] = art
+	}
+	for _, item := range items {
+		if item.Biz != f.biz {
+			continue
+		}
+		art, ok := artMap[item.BizId]
+		if !ok {
+			item.Removed = true
+			continue
+		}
+		item.Title = art.Title
+		item.Abstract = art.Abstract()
+		item.Author = art.Author.Name
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	domain2 "we_book/interactive/domain"
+	service2 "we_book/interactive/service"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// CollectionHandler 收藏以及收藏夹管理
+type CollectionHandler struct {
+	svc     service2.CollectionService
+	intrSvc service2.InteractiveService
+	artSvc  service.ArticleService
+	l       logger2.V1
+	biz     string
+}
+
+func NewCollectionHandler(svc service2.CollectionService, intrSvc service2.InteractiveService,
+	artSvc service.ArticleService, l logger2.V1) *CollectionHandler {
+	return &CollectionHandler{
+		svc:     svc,
+		intrSvc: intrSvc,
+		artSvc:  artSvc,
+		l:       l,
+		biz:     "article",
+	}
+}
+
+func (h *CollectionHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/collections")
+	g.POST("/create", h.Create)
+	g.POST("/update", h.Update)
+	g.POST("/delete", h.Delete)
+	g.GET("/list", h.List)
+	g.GET("/items", h.ListItems)
+
+	g.POST("/collect", h.Collect)
+	g.POST("/uncollect", h.Uncollect)
+	g.POST("/move", h.Move)
+}
+
+func (h *CollectionHandler) Create(ctx *gin.Context) {
+	var req CollectionReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	id, err := h.svc.Create(ctx, domain2.Collection{
+		Uid:    claims.Uid,
+		Name:   req.Name,
+		Public: req.Public,
+	})
+	if err != nil {
+		h.collectionError(ctx, err, 0)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: id,
+	})
+}
+
+// Update 重命名以及修改是否公开
+func (h *CollectionHandler) Update(ctx *gin.Context) {
+	var req CollectionReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Update(ctx, domain2.Collection{
+		Id:     req.Id,
+		Uid:    claims.Uid,
+		Name:   req.Name,
+		Public: req.Public,
+	})
+	if err != nil {
+		h.collectionError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// Delete 里面的收藏会一起取消
+func (h *CollectionHandler) Delete(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Delete(ctx, req.Id, claims.Uid)
+	if err != nil {
+		h.collectionError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// List 看别人的收藏夹的时候只有公开的
+func (h *CollectionHandler) List(ctx *gin.Context) {
+	var req CollectionListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	uid := req.Uid
+	if uid <= 0 {
+		uid = claims.Uid
+	}
+	res, err := h.svc.List(ctx, uid, claims.Uid)
+	if err != nil {
+		h.l.Error("list collections error",
+			logger2.Int64("uid", uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map(res, func(idx int, src domain2.Collection) CollectionVO {
+			return toCollectionVO(src)
+		}),
+	})
+}
+
+// ListItems 收藏夹里面的文章，带上标题
+func (h *CollectionHandler) ListItems(ctx *gin.Context) {
+	var req CollectionItemListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	items, err := h.svc.ListItems(ctx, req.Cid, claims.Uid, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.collectionError(ctx, err, req.Cid)
+		return
+	}
+	arts, err := h.artSvc.ListPubByIds(ctx, slice.Map(items, func(idx int, src domain2.CollectionItem) int64 {
+		return src.BizId
+	}))
+	if err != nil {
+		h.l.Error("list collected articles error",
+			logger2.Int64("cid", req.Cid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toCollectionItemVOs(items, arts),
+	})
+}
+
+func (h *CollectionHandler) Collect(ctx *gin.Context) {
+	var req CollectReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.intrSvc.Collect(ctx, h.biz, req.Id, req.Cid, claims.Uid)
+	if err != nil {
+		h.collectionError(ctx, err, req.Cid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *CollectionHandler) Uncollect(ctx *gin.Context) {
+	var req CollectReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Uncollect(ctx, h.biz, req.Id, claims.Uid)
+	if err != nil {
+		h.collectionError(ctx, err, req.Cid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// Move 把收藏的文章移动到 Cid 收藏夹
+func (h *CollectionHandler) Move(ctx *gin.Context) {
+	var req CollectReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Move(ctx, h.biz, req.Id, req.Cid, claims.Uid)
+	if err != nil {
+		h.collectionError(ctx, err, req.Cid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *CollectionHandler) collectionError(ctx *gin.Context, err error, cid int64) {
+	switch {
+	case errors.Is(err, service2.ErrCollectionNotFound):
+		// 别人的私密收藏夹也当作不存在
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "collection not found",
+		})
+	case errors.Is(err, service2.ErrInvalidCollectionName):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "name error",
+		})
+	case errors.Is(err, service2.ErrAlreadyCollected):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "already collected",
+		})
+	case errors.Is(err, service2.ErrNotCollected):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "not collected",
+		})
+	default:
+		h.l.Error("collection error",
+			logger2.Int64("cid", cid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package web
+
+import (
+	"github.com/ecodeclub/ekit/slice"
+	domain2 "we_book/interactive/domain"
+	"we_book/internal/domain"
+)
+
+type CollectionReq struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	Public bool   `json:"public"`
+}
+
+// CollectReq Id 是文章 ID，Cid 是收藏夹 ID，0 是默认收藏夹
+type CollectReq struct {
+	Id  int64 `json:"id"`
+	Cid int64 `json:"cid"`
+}
+
+// CollectionListReq Uid 不传就是自己
+type CollectionListReq struct {
+	Uid int64 `form:"uid"`
+}
+
+type CollectionItemListReq struct {
+	Cid    int64 `form:"cid"`
+	Offset int   `form:"offset"`
+	Limit  int   `form:"limit"`
+}
+
+type CollectionVO struct {
+	Id      int64  `json:"id"`
+	Name    string `json:"name"`
+	Public  bool   `json:"public"`
+	ItemCnt int64  `json:"item_cnt"`
+}
+
+// CollectionItemVO 收藏的文章，Removed 表示文章已经撤回或者删除了，只能取消收藏
+type CollectionItemVO struct {
+	Id       int64  `json:"id"`
+	Title    string `json:"title"`
+	Abstract string `json:"abstract"`
+	Author   string `json:"author"`
+	Removed  bool   `json:"removed"`
+	Ctime    string `json:"ctime"`
+}
+
+func toCollectionVO(c domain2.Collection) CollectionVO {
+	return CollectionVO{
+		Id:      c.Id,
+		Name:    c.Name,
+		Public:  c.Public,
+		ItemCnt: c.ItemCnt,
+	}
+}
+
+func toCollectionItemVOs(items []domain2.CollectionItem, arts []domain.Article) []CollectionItemVO {
+	artMap := make(map[int64]domain.Article, len(arts))
+	for _, art := range arts {
+		artMap[art.Id] = art
+	}
+	return slice.Map(items, func(idx int, src domain2.CollectionItem) CollectionItemVO {
+		res := CollectionItemVO{
+			Id:    src.BizId,
+			Ctime: src.Ctime.Format("2006-01-02 15:04:05"),
+		}
+		art, ok := artMap[src.BizId]
+		if !ok {
+			res.Removed = true
+			return res
+		}
+		res.Title = art.Title
+		res.Abstract = art.Abstract()
+		res.Author = art.Author.Name
+		return res
+	})
+}
+
+// UserBizListReq Biz 为空的时候不区分业务
+type UserBizListReq struct {
+	Biz    string `form:"biz"`
+	Offset int    `form:"offset"`
+	Limit  int    `form:"limit"`
+}
+
+// UserBizVO 我的点赞、我的收藏里面的一条
+type UserBizVO struct {
+	BizItemVO
+	// Cid 收藏夹 ID，点赞列表里面都是 0
+	Cid int64 `json:"cid"`
+	// Time 点赞或者收藏的时间
+	Time string `json:"time"`
+}
@@ -0,0 +1,200 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"we_book/comment/domain"
+	"we_book/comment/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+type CommentHandler struct {
+	svc service.CommentService
+	l   logger2.V1
+}
+
+func NewCommentHandler(svc service.CommentService, l logger2.V1) *CommentHandler {
+	return &CommentHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *CommentHandler) RegisterRoutes(server *gin.Engine) {
+	cg := server.Group("/comments")
+	cg.POST("/create", h.Create)
+	cg.POST("/delete", h.Delete)
+	cg.GET("/list", h.List)
+	cg.GET("/replies", h.Replies)
+}
+
+func (h *CommentHandler) Create(ctx *gin.Context) {
+	var req CommentReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	id, err := h.svc.Create(ctx, domain.Comment{
+		Biz:         req.Biz,
+		BizId:       req.BizId,
+		Commentator: domain.User{Id: claims.Uid},
+		Content:     req.Content,
+		ParentId:    req.ParentId,
+	})
+	if err != nil {
+		h.commentError(ctx, err, claims.Uid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: id,
+	})
+}
+
+func (h *CommentHandler) Delete(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Delete(ctx, req.Id, claims.Uid)
+	if err != nil {
+		h.commentError(ctx, err, claims.Uid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *CommentHandler) List(ctx *gin.Context) {
+	var req CommentListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	res, err := h.svc.ListRoots(ctx, req.Biz, req.BizId, req.Cursor, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list comments error",
+			logger2.String("biz", req.Biz),
+			logger2.Int64("biz_id", req.BizId),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toCommentListVO(res),
+	})
+}
+
+func (h *CommentHandler) Replies(ctx *gin.Context) {
+	var req ReplyListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	res, err := h.svc.ListReplies(ctx, req.RootId, req.Cursor, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list replies error",
+			logger2.Int64("root_id", req.RootId),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toCommentListVO(res),
+	})
+}
+
+func (h *CommentHandler) commentError(ctx *gin.Context, err error, uid int64) {
+	switch {
+	case errors.Is(err, service.ErrInvalidComment):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "comment is empty or too long",
+		})
+	case errors.Is(err, service.ErrUnknownBiz),
+		errors.Is(err, service.ErrInvalidParent),
+		errors.Is(err, service.ErrCommentNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "comment target error",
+		})
+	case errors.Is(err, service.ErrNoPermission):
+		h.l.Warn("delete comment without permission",
+			logger2.Int64("uid", uid))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "no permission",
+		})
+	default:
+		h.l.Error("comment error",
+			logger2.Int64("uid", uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
+
+// toCommentListVO Cursor 是最后一条的 ID，下一页带上它
+func toCommentListVO(cs []domain.Comment) CommentListVO {
+	res := CommentListVO{
+		Comments: slice.Map(cs, func(idx int, src domain.Comment) CommentVO {
+			return toCommentVO(src)
+		}),
+	}
+	if len(cs) > 0 {
+		res.Cursor = cs[len(cs)-1].Id
+	}
+	return res
+}
+
+func toCommentVO(c domain.Comment) CommentVO {
+	return CommentVO{
+		Id:       c.Id,
+		Uid:      c.Commentator.Id,
+		Content:  c.Content,
+		RootId:   c.RootId,
+		ParentId: c.ParentId,
+		Deleted:  c.Status == domain.CommentStatusDeleted,
+		ReplyCnt: c.ReplyCnt,
+		Replies: slice.Map(c.Replies, func(idx int, src domain.Comment) CommentVO {
+			return toCommentVO(src)
+		}),
+		Ctime: c.Ctime.Format("2006-01-02 15:04:05"),
+	}
+}
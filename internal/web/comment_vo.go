@@ -0,0 +1,39 @@
+package web
+
+type CommentReq struct {
+	Biz     string `json:"biz"`
+	BizId   int64  `json:"biz_id"`
+	Content string `json:"content"`
+	// ParentId 回复的评论，发表根评论的时候不传
+	ParentId int64 `json:"parent_id"`
+}
+
+type CommentListReq struct {
+	Biz    string `form:"biz"`
+	BizId  int64  `form:"biz_id"`
+	Cursor int64  `form:"cursor"`
+	Limit  int    `form:"limit"`
+}
+
+type ReplyListReq struct {
+	RootId int64 `form:"root_id"`
+	Cursor int64 `form:"cursor"`
+	Limit  int   `form:"limit"`
+}
+
+type CommentVO struct {
+	Id       int64       `json:"id"`
+	Uid      int64       `json:"uid"`
+	Content  string      `json:"content"`
+	RootId   int64       `json:"root_id"`
+	ParentId int64       `json:"parent_id"`
+	Deleted  bool        `json:"deleted"`
+	ReplyCnt int64       `json:"reply_cnt"`
+	Replies  []CommentVO `json:"replies,omitempty"`
+	Ctime    string      `json:"ctime"`
+}
+
+type CommentListVO struct {
+	Comments []CommentVO `json:"comments"`
+	Cursor   int64       `json:"cursor"`
+}
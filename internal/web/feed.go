@@ -0,0 +1,91 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	logger2 "we_book/pkg/logger"
+)
+
+// FeedHandler 给 RSS 阅读器用，直接返回 XML，错误用 HTTP 状态码表示
+type FeedHandler struct {
+	svc service.FeedService
+	l   logger2.V1
+}
+
+func NewFeedHandler(svc service.FeedService, l logger2.V1) *FeedHandler {
+	return &FeedHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+// RegisterRoutes 带上 ?author=uid 就是作者的订阅源
+func (h *FeedHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/feeds")
+	g.GET("/articles.rss", h.RSS)
+	g.GET("/articles.atom", h.Atom)
+}
+
+func (h *FeedHandler) RSS(ctx *gin.Context) {
+	h.feed(ctx, service.FeedFormatRSS, "application/rss+xml; charset=utf-8")
+}
+
+func (h *FeedHandler) Atom(ctx *gin.Context) {
+	h.feed(ctx, service.FeedFormatAtom, "application/atom+xml; charset=utf-8")
+}
+
+func (h *FeedHandler) feed(ctx *gin.Context, format string, contentType string) {
+	var uid int64
+	if author := ctx.Query("author"); author != "" {
+		var err error
+		uid, err = strconv.ParseInt(author, 10, 64)
+		if err != nil || uid <= 0 {
+			ctx.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+	}
+	doc, err := h.svc.Feed(ctx, format, uid)
+	if errors.Is(err, service.ErrFeedAuthorNotFound) {
+		ctx.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.l.Error("get feed error",
+			logger2.String("format", format),
+			logger2.Int64("uid", uid),
+			logger2.Error(err))
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	ctx.Header("ETag", doc.ETag)
+	ctx.Header("Last-Modified", doc.Modified.UTC().Format(http.TimeFormat))
+	ctx.Header("Cache-Control", "public, max-age=300")
+	if notModified(ctx.Request, doc) {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+	ctx.Data(http.StatusOK, contentType, doc.Content)
+}
+
+// notModified 有 If-None-Match 的时候忽略 If-Modified-Since
+func notModified(req *http.Request, doc domain.FeedDoc) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == doc.ETag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	ims, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !doc.Modified.After(ims)
+}
@@ -0,0 +1,159 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	svcmocks "we_book/internal/service/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestFeedHandler_Feed(t *testing.T) {
+	modified := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	doc := domain.FeedDoc{
+		Content:  []byte("<rss></rss>"),
+		ETag:     `"abc"`,
+		Modified: modified,
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) service.FeedService
+
+		url    string
+		header map[string]string
+
+		wantedCode int
+		wantedBody string
+	}{
+		{
+			name: "全站 RSS",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).Return(doc, nil)
+				return svc
+			},
+			url:        "/feeds/articles.rss",
+			wantedCode: http.StatusOK,
+			wantedBody: "<rss></rss>",
+		},
+		{
+			name: "作者的 Atom",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatAtom, int64(123)).Return(doc, nil)
+				return svc
+			},
+			url:        "/feeds/articles.atom?author=123",
+			wantedCode: http.StatusOK,
+			wantedBody: "<rss></rss>",
+		},
+		{
+			name: "ETag 没变",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).Return(doc, nil)
+				return svc
+			},
+			url:        "/feeds/articles.rss",
+			header:     map[string]string{"If-None-Match": `W/"xyz", "abc"`},
+			wantedCode: http.StatusNotModified,
+		},
+		{
+			name: "ETag 变了，忽略 If-Modified-Since",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).Return(doc, nil)
+				return svc
+			},
+			url: "/feeds/articles.rss",
+			header: map[string]string{
+				"If-None-Match":     `"xyz"`,
+				"If-Modified-Since": modified.Format(http.TimeFormat),
+			},
+			wantedCode: http.StatusOK,
+			wantedBody: "<rss></rss>",
+		},
+		{
+			name: "没有修改过",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).Return(doc, nil)
+				return svc
+			},
+			url:        "/feeds/articles.rss",
+			header:     map[string]string{"If-Modified-Since": modified.Format(http.TimeFormat)},
+			wantedCode: http.StatusNotModified,
+		},
+		{
+			name: "之后修改过",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).Return(doc, nil)
+				return svc
+			},
+			url:        "/feeds/articles.rss",
+			header:     map[string]string{"If-Modified-Since": modified.Add(-time.Hour).Format(http.TimeFormat)},
+			wantedCode: http.StatusOK,
+			wantedBody: "<rss></rss>",
+		},
+		{
+			name: "作者 ID 不对",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				return svcmocks.NewMockFeedService(ctrl)
+			},
+			url:        "/feeds/articles.rss?author=abc",
+			wantedCode: http.StatusBadRequest,
+		},
+		{
+			name: "作者不存在",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(123)).
+					Return(domain.FeedDoc{}, service.ErrFeedAuthorNotFound)
+				return svc
+			},
+			url:        "/feeds/articles.rss?author=123",
+			wantedCode: http.StatusNotFound,
+		},
+		{
+			name: "系统错误",
+			mock: func(ctrl *gomock.Controller) service.FeedService {
+				svc := svcmocks.NewMockFeedService(ctrl)
+				svc.EXPECT().Feed(gomock.Any(), service.FeedFormatRSS, int64(0)).
+					Return(domain.FeedDoc{}, errors.New("mock db error"))
+				return svc
+			},
+			url:        "/feeds/articles.rss",
+			wantedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			server := gin.Default()
+			h := NewFeedHandler(tc.mock(ctrl), logger.NewNoLogger())
+			h.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.wantedCode, resp.Code)
+			assert.Equal(t, tc.wantedBody, resp.Body.String())
+		})
+	}
+}
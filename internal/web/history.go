@@ -0,0 +1,224 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	domain2 "we_book/interactive/domain"
+	service2 "we_book/interactive/service"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// HistoryHandler 阅读历史，记录本身由阅读事件写入，这里只上报阅读进度
+type HistoryHandler struct {
+	svc  service2.ReadHistoryService
+	arts articleFiller
+	l    logger2.V1
+	// bizs 可以记录阅读进度的业务
+	bizs map[string]struct{}
+}
+
+func NewHistoryHandler(svc service2.ReadHistoryService, artSvc service.ArticleService,
+	l logger2.V1) *HistoryHandler {
+	arts := newArticleFiller(artSvc)
+	return &HistoryHandler{
+		svc:  svc,
+		arts: arts,
+		l:    l,
+		bizs: map[string]struct{}{arts.biz: {}},
+	}
+}
+
+func (h *HistoryHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/history")
+	g.GET("/list", h.List)
+	g.POST("/progress", h.Progress)
+	g.POST("/delete", h.Delete)
+	g.POST("/clear", h.Clear)
+}
+
+func (h *HistoryHandler) List(ctx *gin.Context) {
+	var req HistoryListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	hs, err := h.svc.List(ctx, claims.Uid, req.Biz, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list history error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	res := make([]HistoryVO, 0, len(hs))
+	for _, hi := range hs {
+		res = append(res, HistoryVO{
+			BizItemVO: BizItemVO{
+				Biz:   hi.Biz,
+				BizId: hi.BizId,
+			},
+			Progress: hi.Progress,
+			Rtime:    hi.Rtime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	items := make([]*BizItemVO, 0, len(res))
+	for i := range res {
+		items = append(items, &res[i].BizItemVO)
+	}
+	err = h.arts.fill(ctx, items)
+	if err != nil {
+		h.l.Error("list history articles error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: res,
+	})
+}
+
+// Progress 阅读的时候定时上报进度，同时刷新阅读时间
+func (h *HistoryHandler) Progress(ctx *gin.Context) {
+	var req HistoryReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	biz := h.bizOf(req)
+	if _, ok = h.bizs[biz]; !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "biz error",
+		})
+		return
+	}
+	if req.Id <= 0 {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "id error",
+		})
+		return
+	}
+	err := h.svc.Record(ctx, domain2.ReadHistory{
+		Uid:      claims.Uid,
+		Biz:      biz,
+		BizId:    req.Id,
+		Progress: req.Progress,
+	})
+	if errors.Is(err, service2.ErrInvalidProgress) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "progress error",
+		})
+		return
+	}
+	if err != nil {
+		h.l.Error("record read progress error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Int64("biz_id", req.Id),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *HistoryHandler) Delete(ctx *gin.Context) {
+	var req HistoryReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Delete(ctx, claims.Uid, h.bizOf(req), req.Id)
+	if err != nil {
+		h.l.Error("delete history error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Int64("biz_id", req.Id),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *HistoryHandler) Clear(ctx *gin.Context) {
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Clear(ctx, claims.Uid)
+	if err != nil {
+		h.l.Error("clear history error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *HistoryHandler) bizOf(req HistoryReq) string {
+	if req.Biz == "" {
+		return h.arts.biz
+	}
+	return req.Biz
+}
@@ -0,0 +1,112 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	domain2 "we_book/interactive/domain"
+	service2 "we_book/interactive/service"
+	intrmocks "we_book/interactive/service/mocks"
+	svcmocks "we_book/internal/service/mocks"
+	ijwt "we_book/internal/web/jwt"
+	"we_book/pkg/logger"
+)
+
+func TestHistoryHandler_Progress(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) service2.ReadHistoryService
+
+		reqBody string
+
+		wantRes Result
+	}{
+		{
+			name: "不传 biz 就是文章",
+			mock: func(ctrl *gomock.Controller) service2.ReadHistoryService {
+				svc := intrmocks.NewMockReadHistoryService(ctrl)
+				svc.EXPECT().Record(gomock.Any(), domain2.ReadHistory{
+					Uid:      123,
+					Biz:      "article",
+					BizId:    1,
+					Progress: 50,
+				}).Return(nil)
+				return svc
+			},
+			reqBody: `{"id":1,"progress":50}`,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "success",
+			},
+		},
+		{
+			name: "不支持的 biz",
+			mock: func(ctrl *gomock.Controller) service2.ReadHistoryService {
+				return intrmocks.NewMockReadHistoryService(ctrl)
+			},
+			reqBody: `{"biz":"unknown","id":1,"progress":50}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "biz error",
+			},
+		},
+		{
+			name: "id 不合法",
+			mock: func(ctrl *gomock.Controller) service2.ReadHistoryService {
+				return intrmocks.NewMockReadHistoryService(ctrl)
+			},
+			reqBody: `{"biz":"article","id":0,"progress":50}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "id error",
+			},
+		},
+		{
+			name: "进度不合法",
+			mock: func(ctrl *gomock.Controller) service2.ReadHistoryService {
+				svc := intrmocks.NewMockReadHistoryService(ctrl)
+				svc.EXPECT().Record(gomock.Any(), gomock.Any()).Return(service2.ErrInvalidProgress)
+				return svc
+			},
+			reqBody: `{"id":1,"progress":101}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "progress error",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", &ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+			h := NewHistoryHandler(tc.mock(ctrl), svcmocks.NewMockArticleService(ctrl), logger.NewNoLogger())
+			h.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodPost, "/history/progress", bytes.NewBuffer([]byte(tc.reqBody)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			var webRes Result
+			err = json.NewDecoder(resp.Body).Decode(&webRes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRes, webRes)
+		})
+	}
+}
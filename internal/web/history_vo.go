@@ -0,0 +1,23 @@
+package web
+
+// HistoryListReq Biz 为空的时候不区分业务
+type HistoryListReq struct {
+	Biz    string `form:"biz"`
+	Offset int    `form:"offset"`
+	Limit  int    `form:"limit"`
+}
+
+// HistoryReq Biz 不传就是文章，Progress 是 0-100 的阅读进度
+type HistoryReq struct {
+	Biz      string `json:"biz"`
+	Id       int64  `json:"id"`
+	Progress int    `json:"progress"`
+}
+
+// HistoryVO 阅读历史里面的一条
+type HistoryVO struct {
+	BizItemVO
+	Progress int `json:"progress"`
+	// Rtime 最近一次阅读的时间
+	Rtime string `json:"rtime"`
+}
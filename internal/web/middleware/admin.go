@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	ijwt "we_book/internal/web/jwt"
+)
+
+// AdminMiddlewareBuilder 管理后台只允许配置好的管理员访问
+// 要放在登录校验的后面，从 claims 里面拿到当前用户
+type AdminMiddlewareBuilder struct {
+	admins map[int64]struct{}
+}
+
+func NewAdminMiddlewareBuilder(uids []int64) *AdminMiddlewareBuilder {
+	admins := make(map[int64]struct{}, len(uids))
+	for _, uid := range uids {
+		admins[uid] = struct{}{}
+	}
+	return &AdminMiddlewareBuilder{admins: admins}
+}
+
+func (a *AdminMiddlewareBuilder) Build() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if _, ok = a.admins[claims.Uid]; !ok {
+			ctx.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"net/http"
+	"strings"
 	ijwt "we_book/internal/web/jwt"
 )
 
 type LoginJWTMiddlewareBuilder struct {
-	paths []string
+	paths    []string
+	prefixes []string
 	ijwt.Handler
 }
 
@@ -23,6 +25,12 @@ func (l *LoginJWTMiddlewareBuilder) IgnorePaths(path string) *LoginJWTMiddleware
 	return l
 }
 
+// IgnorePathPrefix 以 prefix 开头的路径都不校验，比如公开的静态文件
+func (l *LoginJWTMiddlewareBuilder) IgnorePathPrefix(prefix string) *LoginJWTMiddlewareBuilder {
+	l.prefixes = append(l.prefixes, prefix)
+	return l
+}
+
 func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// 不需要进行校验的
@@ -31,6 +39,11 @@ func (l *LoginJWTMiddlewareBuilder) Build() gin.HandlerFunc {
 				return
 			}
 		}
+		for _, prefix := range l.prefixes {
+			if strings.HasPrefix(ctx.Request.URL.Path, prefix) {
+				return
+			}
+		}
 
 		// 使用 JWT 进行校验
 		tokenHeader := ctx.GetHeader("Authorization")
@@ -0,0 +1,136 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"we_book/internal/migrator"
+	"we_book/internal/repository/dao/article"
+	logger2 "we_book/pkg/logger"
+)
+
+// MigratorHandler 管理后台切换文章的双写阶段、启动校验
+// 只注册在管理后台的 server 上，不对外暴露
+type MigratorHandler struct {
+	// scheduler 为 nil 说明文章的存储没有配置成 migrator
+	scheduler *migrator.Scheduler
+	l         logger2.V1
+}
+
+func NewMigratorHandler(scheduler *migrator.Scheduler, l logger2.V1) *MigratorHandler {
+	return &MigratorHandler{
+		scheduler: scheduler,
+		l:         l,
+	}
+}
+
+func (h *MigratorHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/migrator/article")
+	g.Use(h.requireMigrator)
+	g.GET("/pattern", h.Pattern)
+	g.POST("/pattern", h.UpdatePattern)
+	g.POST("/full/start", h.StartFull)
+	g.POST("/full/stop", h.StopFull)
+	g.POST("/incr/start", h.StartIncr)
+	g.POST("/incr/stop", h.StopIncr)
+}
+
+func (h *MigratorHandler) requireMigrator(ctx *gin.Context) {
+	if h.scheduler == nil {
+		ctx.AbortWithStatusJSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article storage is not migrator",
+		})
+	}
+}
+
+func (h *MigratorHandler) Pattern(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: h.scheduler.Pattern(),
+	})
+}
+
+// UpdatePattern pattern 是 src_only、src_first、dst_first、dst_only 之一
+func (h *MigratorHandler) UpdatePattern(ctx *gin.Context) {
+	type Req struct {
+		Pattern string `json:"pattern"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	err := h.scheduler.UpdatePattern(ctx, req.Pattern)
+	if errors.Is(err, article.ErrUnknownPattern) {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "unknown pattern",
+		})
+		return
+	}
+	if err != nil {
+		h.l.Error("update double write pattern error",
+			logger2.String("pattern", req.Pattern),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	h.l.Info("double write pattern updated", logger2.String("pattern", req.Pattern))
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *MigratorHandler) StartFull(ctx *gin.Context) {
+	h.scheduler.StartFull()
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *MigratorHandler) StopFull(ctx *gin.Context) {
+	h.scheduler.StopFull()
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// StartIncr utime 是毫秒数，interval 是没有新数据的时候等待的毫秒数
+func (h *MigratorHandler) StartIncr(ctx *gin.Context) {
+	type Req struct {
+		Utime    int64 `json:"utime"`
+		Interval int64 `json:"interval"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Utime <= 0 || req.Interval <= 0 {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "utime and interval must be positive",
+		})
+		return
+	}
+	h.scheduler.StartIncr(req.Utime, time.Duration(req.Interval)*time.Millisecond)
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *MigratorHandler) StopIncr(ctx *gin.Context) {
+	h.scheduler.StopIncr()
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
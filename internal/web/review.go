@@ -0,0 +1,143 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// ReviewHandler 管理后台处理没有通过自动审核的文章
+// 只注册在管理后台的 server 上，审核人就是登录的管理员
+type ReviewHandler struct {
+	svc service.ArticleService
+	l   logger2.V1
+}
+
+func NewReviewHandler(svc service.ArticleService, l logger2.V1) *ReviewHandler {
+	return &ReviewHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ReviewHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/reviews")
+	g.GET("/list", h.List)
+	g.POST("/approve", h.Approve)
+	g.POST("/reject", h.Reject)
+}
+
+// List status 默认是 pending，也可以是 approved、rejected、cancelled
+func (h *ReviewHandler) List(ctx *gin.Context) {
+	type Req struct {
+		Status string `form:"status"`
+		Offset int    `form:"offset"`
+		Limit  int    `form:"limit"`
+	}
+	var req Req
+	if err := ctx.BindQuery(&req); err != nil {
+		return
+	}
+	if req.Status == "" {
+		req.Status = domain.ReviewStatusPending.String()
+	}
+	status := domain.ReviewStatusOf(req.Status)
+	if status == domain.ReviewStatusUnknown {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "unknown status",
+		})
+		return
+	}
+	res, err := h.svc.ListReviews(ctx, status, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list reviews error",
+			logger2.String("status", req.Status),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[domain.ArticleReview, ReviewVO](res, toReviewVO),
+	})
+}
+
+func (h *ReviewHandler) Approve(ctx *gin.Context) {
+	type Req struct {
+		Aid int64 `json:"aid"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(*ijwt.UserClaims)
+	err := h.svc.Approve(ctx, req.Aid, uc.Uid)
+	if err != nil {
+		h.reviewError(ctx, err, req.Aid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// Reject reason 必填，作者可以看到
+func (h *ReviewHandler) Reject(ctx *gin.Context) {
+	type Req struct {
+		Aid    int64  `json:"aid"`
+		Reason string `json:"reason"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	uc := ctx.MustGet("claims").(*ijwt.UserClaims)
+	err := h.svc.Reject(ctx, req.Aid, uc.Uid, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, err, req.Aid)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *ReviewHandler) reviewError(ctx *gin.Context, err error, aid int64) {
+	switch {
+	case errors.Is(err, service.ErrReviewNotFound):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "review not found",
+		})
+	case errors.Is(err, service.ErrReviewNotPending):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "review is not pending",
+		})
+	case errors.Is(err, service.ErrInvalidReviewReason):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "reason is required",
+		})
+	default:
+		h.l.Error("article review error",
+			logger2.Int64("aid", aid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"we_book/internal/service"
+	svcmocks "we_book/internal/service/mocks"
+	ijwt "we_book/internal/web/jwt"
+	"we_book/pkg/logger"
+)
+
+func TestReviewHandler_Finish(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) service.ArticleService
+
+		path    string
+		reqBody string
+
+		wantRes Result
+	}{
+		{
+			name: "通过，审核人是登录的管理员",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Approve(gomock.Any(), int64(1), int64(123)).Return(nil)
+				return svc
+			},
+			path: "/reviews/approve",
+			// 请求里面带的审核人不算数
+			reqBody: `{"aid":1,"reviewer":456}`,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "success",
+			},
+		},
+		{
+			name: "驳回，审核人是登录的管理员",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Reject(gomock.Any(), int64(1), int64(123), "违规").Return(nil)
+				return svc
+			},
+			path:    "/reviews/reject",
+			reqBody: `{"aid":1,"reviewer":456,"reason":"违规"}`,
+			wantRes: Result{
+				Code: 2,
+				Msg:  "success",
+			},
+		},
+		{
+			name: "复审不存在",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Approve(gomock.Any(), int64(2), int64(123)).Return(service.ErrReviewNotFound)
+				return svc
+			},
+			path:    "/reviews/approve",
+			reqBody: `{"aid":2}`,
+			wantRes: Result{
+				Code: 4,
+				Msg:  "review not found",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", &ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+			h := NewReviewHandler(tc.mock(ctrl), logger.NewNoLogger())
+			h.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodPost, tc.path, bytes.NewBuffer([]byte(tc.reqBody)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			var webRes Result
+			err = json.NewDecoder(resp.Body).Decode(&webRes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRes, webRes)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package web
+
+import (
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"we_book/internal/search"
+	"we_book/internal/service"
+	logger2 "we_book/pkg/logger"
+)
+
+type SearchHandler struct {
+	svc service.SearchService
+	l   logger2.V1
+}
+
+func NewSearchHandler(svc service.SearchService, l logger2.V1) *SearchHandler {
+	return &SearchHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *SearchHandler) RegisterRoutes(server *gin.Engine) {
+	server.GET("/articles/pub/search", h.SearchArticles)
+}
+
+func (h *SearchHandler) SearchArticles(ctx *gin.Context) {
+	var req SearchReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	if req.Offset < 0 {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "offset error",
+		})
+		return
+	}
+	hits, err := h.svc.SearchArticles(ctx, req.Q, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("search article error",
+			logger2.String("q", req.Q),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map[search.Hit, ArticleVO](hits, func(idx int, src search.Hit) ArticleVO {
+			return ArticleVO{
+				Id:       src.Article.Id,
+				Title:    src.Article.Title,
+				Abstract: src.Article.Abstract(),
+				Snippet:  src.Snippet,
+				Category: src.Article.Category,
+				Tags:     src.Article.Tags,
+				Utime:    src.Article.Utime.Format("2006-01-02 15:04:05"),
+			}
+		}),
+	})
+}
@@ -0,0 +1,238 @@
+package web
+
+import (
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// SeriesHandler 作者把多篇文章组织成系列，读者按照章节阅读
+type SeriesHandler struct {
+	svc service.SeriesService
+	l   logger2.V1
+}
+
+func NewSeriesHandler(svc service.SeriesService, l logger2.V1) *SeriesHandler {
+	return &SeriesHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *SeriesHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/series")
+	g.POST("/create", h.Create)
+	g.POST("/update", h.Update)
+	g.POST("/reorder", h.Reorder)
+	g.POST("/delete", h.Delete)
+
+	pub := g.Group("/pub")
+	pub.GET("/list", h.ListPub)
+	pub.GET("/:id", h.PubDetail)
+}
+
+func (h *SeriesHandler) Create(ctx *gin.Context) {
+	var req SeriesReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	id, err := h.svc.Create(ctx, domain.Series{
+		Title:       req.Title,
+		Description: req.Description,
+		Author: domain.Author{
+			Id: claims.Uid,
+		},
+		ArticleIds: req.ArticleIds,
+	})
+	if err != nil {
+		h.seriesError(ctx, err, 0)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: id,
+	})
+}
+
+func (h *SeriesHandler) Update(ctx *gin.Context) {
+	var req SeriesReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Update(ctx, domain.Series{
+		Id:          req.Id,
+		Title:       req.Title,
+		Description: req.Description,
+		Author: domain.Author{
+			Id: claims.Uid,
+		},
+	})
+	if err != nil {
+		h.seriesError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+// Reorder 传完整的章节列表，可以同时增删章节和调整顺序
+func (h *SeriesHandler) Reorder(ctx *gin.Context) {
+	var req ReorderSeriesReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Reorder(ctx, req.Id, claims.Uid, req.ArticleIds)
+	if err != nil {
+		h.seriesError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *SeriesHandler) Delete(ctx *gin.Context) {
+	type Req struct {
+		Id int64 `json:"id"`
+	}
+	var req Req
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	err := h.svc.Delete(ctx, req.Id, claims.Uid)
+	if err != nil {
+		h.seriesError(ctx, err, req.Id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+	})
+}
+
+func (h *SeriesHandler) PubDetail(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "id error",
+		})
+		return
+	}
+	s, arts, err := h.svc.GetPub(ctx, id)
+	if err != nil {
+		h.seriesError(ctx, err, id)
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: toSeriesVO(s, arts),
+	})
+}
+
+// ListPub 某个作者的系列，不需要登录
+func (h *SeriesHandler) ListPub(ctx *gin.Context) {
+	var req SeriesListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	start := time.Now()
+	if req.Start > 0 {
+		start = time.UnixMilli(req.Start)
+	}
+	res, err := h.svc.ListPub(ctx, req.Uid, start, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list series error",
+			logger2.Int64("uid", req.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: slice.Map(res, func(idx int, src domain.Series) SeriesVO {
+			return toSeriesVO(src, nil)
+		}),
+	})
+}
+
+func (h *SeriesHandler) seriesError(ctx *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, service.ErrSeriesNotFound):
+		// 不是自己的系列也当作不存在
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "series not found",
+		})
+	case errors.Is(err, service.ErrInvalidSeries):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "title or articles error",
+		})
+	case errors.Is(err, service.ErrSeriesArticleConflict):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "article already in another series",
+		})
+	default:
+		h.l.Error("article series error",
+			logger2.Int64("sid", id),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+	}
+}
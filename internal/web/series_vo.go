@@ -0,0 +1,86 @@
+package web
+
+import (
+	"github.com/ecodeclub/ekit/slice"
+	"we_book/internal/domain"
+)
+
+type SeriesReq struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// ArticleIds 按照章节的顺序，更新的时候忽略
+	ArticleIds []int64 `json:"article_ids"`
+}
+
+type ReorderSeriesReq struct {
+	Id         int64   `json:"id"`
+	ArticleIds []int64 `json:"article_ids"`
+}
+
+// SeriesListReq Start 是毫秒时间戳，不传就是现在
+type SeriesListReq struct {
+	Uid    int64 `form:"uid"`
+	Start  int64 `form:"start"`
+	Offset int   `form:"offset"`
+	Limit  int   `form:"limit"`
+}
+
+type SeriesVO struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	AuthorId    int64  `json:"author_id"`
+	// Chapters 只有详情才有，只包含已经发表的章节
+	Chapters []ChapterVO `json:"chapters,omitempty"`
+	Ctime    string      `json:"ctime"`
+	Utime    string      `json:"utime"`
+}
+
+type ChapterVO struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// SeriesNavVO 文章详情里面的系列信息，Index 从 0 开始，Total 包括没有发表的章节
+type SeriesNavVO struct {
+	Id    int64      `json:"id"`
+	Title string     `json:"title"`
+	Index int        `json:"index"`
+	Total int        `json:"total"`
+	Prev  *ChapterVO `json:"prev,omitempty"`
+	Next  *ChapterVO `json:"next,omitempty"`
+}
+
+func toSeriesVO(s domain.Series, arts []domain.Article) SeriesVO {
+	return SeriesVO{
+		Id:          s.Id,
+		Title:       s.Title,
+		Description: s.Description,
+		AuthorId:    s.Author.Id,
+		Chapters: slice.Map(arts, func(idx int, src domain.Article) ChapterVO {
+			return ChapterVO{
+				Id:    src.Id,
+				Title: src.Title,
+			}
+		}),
+		Ctime: s.Ctime.Format("2006-01-02 15:04:05"),
+		Utime: s.Utime.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toSeriesNavVO(nav domain.SeriesNav) *SeriesNavVO {
+	res := &SeriesNavVO{
+		Id:    nav.Series.Id,
+		Title: nav.Series.Title,
+		Index: nav.Index,
+		Total: len(nav.Series.ArticleIds),
+	}
+	if nav.Prev != nil {
+		res.Prev = &ChapterVO{Id: nav.Prev.Id, Title: nav.Prev.Title}
+	}
+	if nav.Next != nil {
+		res.Next = &ChapterVO{Id: nav.Next.Id, Title: nav.Next.Title}
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+package web
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strings"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// UploadFilesPath 读取上传文件的路径前缀，不需要登录
+const UploadFilesPath = "/files/"
+
+// UploadHandler 文章里面的图片上传，上传之后返回可以直接写进 Markdown 的 URL
+type UploadHandler struct {
+	svc service.UploadService
+	l   logger2.V1
+}
+
+func NewUploadHandler(svc service.UploadService, l logger2.V1) *UploadHandler {
+	return &UploadHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *UploadHandler) RegisterRoutes(server *gin.Engine) {
+	server.POST("/uploads/image", h.UploadImage)
+	server.GET(UploadFilesPath+"*key", h.File)
+}
+
+// UploadImage 表单字段 file 是图片，大小和格式不对返回 4
+func (h *UploadHandler) UploadImage(ctx *gin.Context) {
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "file is required",
+		})
+		return
+	}
+	if fh.Size > service.UploadMaxSize {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "file too large",
+		})
+		return
+	}
+	f, err := fh.Open()
+	if err != nil {
+		h.l.Error("open upload file error", logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	defer f.Close()
+	// 多读一个字节，service 能判断出超过了大小
+	data, err := io.ReadAll(io.LimitReader(f, service.UploadMaxSize+1))
+	if err != nil {
+		h.l.Error("read upload file error", logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	upload, err := h.svc.UploadImage(ctx, claims.Uid, data)
+	switch {
+	case errors.Is(err, service.ErrUploadTooLarge):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "file too large",
+		})
+		return
+	case errors.Is(err, service.ErrUnsupportedUpload):
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  "only jpeg, png and gif are supported",
+		})
+		return
+	case err != nil:
+		h.l.Error("upload image error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: UploadVO{
+			URL:      upload.URL,
+			ThumbURL: upload.ThumbURL,
+			Width:    upload.Width,
+			Height:   upload.Height,
+			Size:     upload.Size,
+		},
+	})
+}
+
+// File key 里面有随机生成的名字，内容不会变，可以让浏览器和 CDN 一直缓存
+func (h *UploadHandler) File(ctx *gin.Context) {
+	key := strings.TrimPrefix(ctx.Param("key"), "/")
+	data, contentType, err := h.svc.Open(ctx, key)
+	if errors.Is(err, service.ErrUploadNotFound) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.l.Error("open upload error",
+			logger2.String("key", key),
+			logger2.Error(err))
+		ctx.Status(http.StatusInternalServerError)
+		return
+	}
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Data(http.StatusOK, contentType, data)
+}
+
+type UploadVO struct {
+	URL      string `json:"url"`
+	ThumbURL string `json:"thumb_url"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Size     int64  `json:"size"`
+}
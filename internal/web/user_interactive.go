@@ -0,0 +1,133 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	service2 "we_book/interactive/service"
+	"we_book/internal/service"
+	ijwt "we_book/internal/web/jwt"
+	logger2 "we_book/pkg/logger"
+)
+
+// UserInteractiveHandler 我的点赞、我的收藏
+type UserInteractiveHandler struct {
+	intrSvc service2.InteractiveService
+	arts    articleFiller
+	l       logger2.V1
+}
+
+func NewUserInteractiveHandler(intrSvc service2.InteractiveService,
+	artSvc service.ArticleService, l logger2.V1) *UserInteractiveHandler {
+	return &UserInteractiveHandler{
+		intrSvc: intrSvc,
+		arts:    newArticleFiller(artSvc),
+		l:       l,
+	}
+}
+
+func (h *UserInteractiveHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/users")
+	g.GET("/likes", h.ListLiked)
+	g.GET("/collections", h.ListCollected)
+}
+
+func (h *UserInteractiveHandler) ListLiked(ctx *gin.Context) {
+	var req UserBizListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	likes, err := h.intrSvc.ListLiked(ctx, claims.Uid, req.Biz, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list liked error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	res := make([]UserBizVO, 0, len(likes))
+	for _, like := range likes {
+		res = append(res, UserBizVO{
+			BizItemVO: BizItemVO{
+				Biz:   like.Biz,
+				BizId: like.BizId,
+			},
+			Time: like.Utime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	h.listResult(ctx, claims.Uid, res)
+}
+
+func (h *UserInteractiveHandler) ListCollected(ctx *gin.Context) {
+	var req UserBizListReq
+	if err := ctx.Bind(&req); err != nil {
+		return
+	}
+	claims, ok := ctx.MustGet("claims").(*ijwt.UserClaims)
+	if !ok {
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		h.l.Error("not find user session")
+		return
+	}
+	items, err := h.intrSvc.ListCollected(ctx, claims.Uid, req.Biz, req.Offset, pubPageLimit(req.Limit))
+	if err != nil {
+		h.l.Error("list collected error",
+			logger2.Int64("uid", claims.Uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	res := make([]UserBizVO, 0, len(items))
+	for _, item := range items {
+		res = append(res, UserBizVO{
+			BizItemVO: BizItemVO{
+				Biz:   item.Biz,
+				BizId: item.BizId,
+			},
+			Cid:  item.Cid,
+			Time: item.Ctime.Format("2006-01-02 15:04:05"),
+		})
+	}
+	h.listResult(ctx, claims.Uid, res)
+}
+
+// listResult 给文章补上标题和摘要之后返回
+func (h *UserInteractiveHandler) listResult(ctx *gin.Context, uid int64, res []UserBizVO) {
+	items := make([]*BizItemVO, 0, len(res))
+	for i := range res {
+		items = append(items, &res[i].BizItemVO)
+	}
+	err := h.arts.fill(ctx, items)
+	if err != nil {
+		h.l.Error("list liked or collected articles error",
+			logger2.Int64("uid", uid),
+			logger2.Error(err))
+		ctx.JSON(http.StatusOK, Result{
+			Code: 5,
+			Msg:  "system error",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, Result{
+		Code: 2,
+		Msg:  "success",
+		Data: res,
+	})
+}
@@ -0,0 +1,113 @@
+package web
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	domain2 "we_book/interactive/domain"
+	service2 "we_book/interactive/service"
+	intrmocks "we_book/interactive/service/mocks"
+	"we_book/internal/domain"
+	"we_book/internal/service"
+	svcmocks "we_book/internal/service/mocks"
+	ijwt "we_book/internal/web/jwt"
+	"we_book/pkg/logger"
+)
+
+func TestUserInteractiveHandler_ListLiked(t *testing.T) {
+	utime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.Local)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service2.InteractiveService, service.ArticleService)
+
+		url string
+
+		wantedRes []UserBizVO
+	}{
+		{
+			name: "补上文章标题，撤回的标记出来",
+			mock: func(ctrl *gomock.Controller) (service2.InteractiveService, service.ArticleService) {
+				intrSvc := intrmocks.NewMockInteractiveService(ctrl)
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				intrSvc.EXPECT().ListLiked(gomock.Any(), int64(123), "", 0, 10).
+					Return([]domain2.LikeRecord{
+						{Uid: 123, Biz: "article", BizId: 1, Utime: utime},
+						{Uid: 123, Biz: "comment", BizId: 7, Utime: utime},
+						{Uid: 123, Biz: "article", BizId: 2, Utime: utime},
+					}, nil)
+				artSvc.EXPECT().ListPubByIds(gomock.Any(), []int64{1, 2}).
+					Return([]domain.Article{
+						{Id: 1, Title: "标题", Content: "内容", Author: domain.Author{Name: "作者"}},
+					}, nil)
+				return intrSvc, artSvc
+			},
+			url: "/users/likes",
+			wantedRes: []UserBizVO{
+				{
+					BizItemVO: BizItemVO{Biz: "article", BizId: 1, Title: "标题", Abstract: "内容", Author: "作者"},
+					Time:      "2024-05-01 10:00:00",
+				},
+				{
+					BizItemVO: BizItemVO{Biz: "comment", BizId: 7},
+					Time:      "2024-05-01 10:00:00",
+				},
+				{
+					BizItemVO: BizItemVO{Biz: "article", BizId: 2, Removed: true},
+					Time:      "2024-05-01 10:00:00",
+				},
+			},
+		},
+		{
+			name: "只看文章",
+			mock: func(ctrl *gomock.Controller) (service2.InteractiveService, service.ArticleService) {
+				intrSvc := intrmocks.NewMockInteractiveService(ctrl)
+				artSvc := svcmocks.NewMockArticleService(ctrl)
+				intrSvc.EXPECT().ListLiked(gomock.Any(), int64(123), "article", 10, 5).
+					Return([]domain2.LikeRecord{}, nil)
+				artSvc.EXPECT().ListPubByIds(gomock.Any(), []int64{}).
+					Return([]domain.Article{}, nil)
+				return intrSvc, artSvc
+			},
+			url:       "/users/likes?biz=article&offset=10&limit=5",
+			wantedRes: []UserBizVO{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("claims", &ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+			intrSvc, artSvc := tc.mock(ctrl)
+			h := NewUserInteractiveHandler(intrSvc, artSvc, &logger.ZapLogger{})
+			h.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			resp := httptest.NewRecorder()
+			server.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			var webRes struct {
+				Code int         `json:"code"`
+				Data []UserBizVO `json:"data"`
+			}
+			err = json.NewDecoder(resp.Body).Decode(&webRes)
+			require.NoError(t, err)
+			assert.Equal(t, 2, webRes.Code)
+			assert.Equal(t, tc.wantedRes, webRes.Data)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package ioc
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"we_book/internal/web"
+	ijwt "we_book/internal/web/jwt"
+	"we_book/internal/web/middleware"
+)
+
+// AdminServer 管理后台，只在内网监听
+// 用网站登录之后拿到的 token 访问，只有 admin.uids 里面的用户可以用
+type AdminServer struct {
+	*gin.Engine
+	Addr string
+}
+
+func InitAdminServer(jwtHdl ijwt.Handler,
+	migratorHdl *web.MigratorHandler,
+	reviewHdl *web.ReviewHandler) *AdminServer {
+	type Config struct {
+		Addr string `yaml:"addr"`
+		// Uids 管理员的用户 ID，没有配置的话谁都不能用
+		Uids []int64 `yaml:"uids"`
+	}
+	c := Config{
+		Addr: "127.0.0.1:8082",
+	}
+	err := viper.UnmarshalKey("admin", &c)
+	if err != nil {
+		panic("admin config error")
+	}
+	server := gin.Default()
+	server.Use(middleware.NewLoginJWTMiddlewareBuilder(jwtHdl).Build(),
+		middleware.NewAdminMiddlewareBuilder(c.Uids).Build())
+	migratorHdl.RegisterRoutes(server)
+	reviewHdl.RegisterRoutes(server)
+	return &AdminServer{
+		Engine: server,
+		Addr:   c.Addr,
+	}
+}
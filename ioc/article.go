@@ -0,0 +1,113 @@
+package ioc
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/bwmarrin/snowflake"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+	"time"
+	"we_book/internal/migrator"
+	"we_book/internal/repository/dao/article"
+	"we_book/internal/repository/dao/outbox"
+	"we_book/pkg/blobx"
+)
+
+// InitArticleDAO 按照配置选择文章存在哪里，gorm 是 MySQL，mongo 是 MongoDB，
+// s3 和 local 是 MySQL 加上 blob，线上库的内容分别放在 OSS 和本地磁盘上，
+// migrator 是从 MySQL 迁移到 MongoDB 期间的双写
+func InitArticleDAO(db *gorm.DB, mdb *mongo.Database, m *migrator.Scheduler) article.ArticleDAO {
+	type Config struct {
+		Storage string `yaml:"storage"`
+	}
+	c := Config{
+		Storage: "gorm",
+	}
+	err := viper.UnmarshalKey("article", &c)
+	if err != nil {
+		panic("article config error")
+	}
+	switch c.Storage {
+	case "gorm":
+		return article.NewGORMArticleDAO(db)
+	case "mongo":
+		return initMongoArticleDAO(mdb)
+	case "migrator":
+		return m.DAO()
+	case "s3":
+		return article.NewBlobDAO(db, blobx.NewS3Storage(InitS3(), viper.GetString("s3.bucket")))
+	case "local":
+		blob, err := blobx.NewLocalStorage(viper.GetString("blob.dir"))
+		if err != nil {
+			panic(err)
+		}
+		return article.NewBlobDAO(db, blob)
+	default:
+		panic(fmt.Sprintf("unknown article storage %s", c.Storage))
+	}
+}
+
+func initMongoArticleDAO(mdb *mongo.Database) article.ArticleDAO {
+	err := article.InitCollection(mdb)
+	if err != nil {
+		panic(err)
+	}
+	node, err := snowflake.NewNode(viper.GetInt64("mongo.node"))
+	if err != nil {
+		panic(err)
+	}
+	return article.NewMongoDBDAO(mdb, node)
+}
+
+// InitOutboxDAO 发件箱要和文章存在同一个数据库里面，才能在一个事务里面写
+// 迁移期间两个库都会写发件箱，两个都要投递
+func InitOutboxDAO(db *gorm.DB, mdb *mongo.Database) outbox.OutboxDAO {
+	switch viper.GetString("article.storage") {
+	case "mongo":
+		return outbox.NewMongoDBOutboxDAO(mdb)
+	case "migrator":
+		return outbox.NewMultiOutboxDAO(outbox.NewGORMOutboxDAO(db),
+			outbox.NewMongoDBOutboxDAO(mdb))
+	default:
+		return outbox.NewGORMOutboxDAO(db)
+	}
+}
+
+// InitMongoDB 文章存在 MongoDB 或者正在迁移到 MongoDB 的时候才连接，不然返回 nil
+func InitMongoDB() *mongo.Database {
+	switch viper.GetString("article.storage") {
+	case "mongo", "migrator":
+	default:
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	opts := options.Client().ApplyURI(viper.GetString("mongo.uri"))
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		panic(err)
+	}
+	return client.Database(viper.GetString("mongo.database"))
+}
+
+func InitS3() *s3.S3 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(
+			viper.GetString("s3.access_key_id"),
+			viper.GetString("s3.secret_access_key"), ""),
+		Region:   aws.String(viper.GetString("s3.region")),
+		Endpoint: aws.String(viper.GetString("s3.endpoint")),
+		// 腾讯云 COS 之类的兼容实现都要用 path style
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return s3.New(sess)
+}
@@ -0,0 +1,17 @@
+package ioc
+
+import (
+	"context"
+	"we_book/comment/service"
+	"we_book/internal/repository/article"
+)
+
+// InitCommentBizOwners 注册允许评论的业务
+func InitCommentBizOwners(artRepo article.ArticleRepository) service.BizOwners {
+	return service.BizOwners{
+		"article": func(ctx context.Context, bizId int64) (int64, error) {
+			art, err := artRepo.GetPubById(ctx, bizId)
+			return art.Author.Id, err
+		},
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"time"
+	commentDAO "we_book/comment/repository/dao"
+	intrDAO "we_book/interactive/repository/dao"
+	"we_book/internal/repository/dao"
 )
 
 //func InitDB() *gorm.DB {
@@ -39,6 +42,19 @@ func InitDB() *gorm.DB {
 	if err != nil {
 		panic("db connect error")
 	}
+	err = dao.InitTable(db)
+	if err != nil {
+		panic(err)
+	}
+	err = commentDAO.InitTable(db)
+	if err != nil {
+		panic(err)
+	}
+	// 顺便删掉互动表上已经被替换掉的旧索引
+	err = intrDAO.InitTable(db)
+	if err != nil {
+		panic(err)
+	}
 	return db
 }
 
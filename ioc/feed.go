@@ -0,0 +1,34 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"we_book/internal/repository"
+	"we_book/internal/repository/article"
+	"we_book/internal/service"
+	"we_book/pkg/logger"
+)
+
+func InitFeedService(repo article.ArticleRepository,
+	userRepo repository.UserRepository,
+	l logger.V1) service.FeedService {
+	type Config struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Link        string `yaml:"link"`
+		Size        int    `yaml:"size"`
+	}
+	c := Config{
+		Title: "we_book",
+		Size:  20,
+	}
+	err := viper.UnmarshalKey("feed", &c)
+	if err != nil {
+		panic("feed config error")
+	}
+	return service.NewFeedService(repo, userRepo, service.FeedConfig{
+		Title:       c.Title,
+		Description: c.Description,
+		Link:        c.Link,
+		Size:        c.Size,
+	}, l)
+}
@@ -0,0 +1,18 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"time"
+	intrSvc "we_book/interactive/service"
+	"we_book/internal/job"
+	"we_book/pkg/logger"
+)
+
+// InitHistoryTrimJob history.keep 是每个用户保留的阅读记录条数，默认 1000
+func InitHistoryTrimJob(svc intrSvc.ReadHistoryService, l logger.V1) *job.HistoryTrimJob {
+	keep := viper.GetInt("history.keep")
+	if keep <= 0 {
+		keep = 1000
+	}
+	return job.NewHistoryTrimJob(svc, l, time.Second*30, keep)
+}
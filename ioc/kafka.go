@@ -5,7 +5,9 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/spf13/viper"
 	"we_book/events"
+	"we_book/events/article"
 	events2 "we_book/interactive/events"
+	"we_book/internal/migrator"
 )
 
 type Config struct {
@@ -52,6 +54,16 @@ func NewSyncProducer(client sarama.Client) sarama.SyncProducer {
 	return res
 }
 
-func NewConsumers(c1 *events2.InteractiveReadEventBatchConsumer) []events.Consumer {
-	return []events.Consumer{c1}
+// NewConsumers relay 不是消费者，但是和消费者一样要在启动的时候跑起来
+func NewConsumers(c1 *events2.InteractiveReadEventBatchConsumer,
+	c2 *article.ArticleIndexConsumer,
+	c3 *article.HistoryReadEventConsumer,
+	relay *events.OutboxRelay,
+	fixer *migrator.Consumer) []events.Consumer {
+	res := []events.Consumer{c1, c2, c3, relay}
+	// 只有迁移文章的时候才需要修复数据
+	if fixer != nil {
+		res = append(res, fixer)
+	}
+	return res
 }
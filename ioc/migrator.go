@@ -0,0 +1,48 @@
+package ioc
+
+import (
+	"context"
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+	"time"
+	"we_book/internal/migrator"
+	"we_book/internal/repository/dao"
+	"we_book/internal/repository/dao/article"
+	"we_book/pkg/logger"
+)
+
+// InitArticleMigrator article.storage 是 migrator 的时候把文章从 MySQL 迁移到 MongoDB
+// 不迁移的时候返回 nil
+func InitArticleMigrator(db *gorm.DB, mdb *mongo.Database, producer migrator.Producer, l logger.V1) *migrator.Scheduler {
+	if viper.GetString("article.storage") != "migrator" {
+		return nil
+	}
+	// 双写阶段存在这张表里面，第一次启动的时候还没有
+	err := db.AutoMigrate(&dao.MigratorPattern{})
+	if err != nil {
+		panic(err)
+	}
+	src := article.NewGORMArticleDAO(db).(article.MigratableDAO)
+	dst := initMongoArticleDAO(mdb).(article.MigratableDAO)
+	s := migrator.NewScheduler(src, dst, dao.NewGORMMigratorPatternDAO(db), producer, l)
+	// 启动的时候必须拿到当前的双写阶段，不然可能读到旧的库
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	err = s.Reload(ctx)
+	if err != nil {
+		panic(err)
+	}
+	// 管理后台只会切换一个实例，其它实例靠这里跟上
+	go s.Watch(context.Background(), time.Second*5)
+	return s
+}
+
+// InitMigratorConsumer 不迁移的时候返回 nil，也就不会启动
+func InitMigratorConsumer(client sarama.Client, s *migrator.Scheduler, l logger.V1) *migrator.Consumer {
+	if s == nil {
+		return nil
+	}
+	return migrator.NewConsumer(client, s, l)
+}
@@ -0,0 +1,19 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"we_book/internal/service/moderation"
+)
+
+// InitModerator 敏感词来自配置，没有配置的话所有文章都能通过
+func InitModerator() moderation.Moderator {
+	type Config struct {
+		Keywords []string `yaml:"keywords"`
+	}
+	var c Config
+	err := viper.UnmarshalKey("moderation", &c)
+	if err != nil {
+		panic("moderation config error")
+	}
+	return moderation.NewKeywordModerator(c.Keywords)
+}
@@ -9,18 +9,43 @@ import (
 	"we_book/pkg/logger"
 )
 
-func InitScheduler(l logger.V1, local *job.LocalFuncExecutor, svc service.JobService) *job.Scheduler {
+func InitScheduler(l logger.V1, local *job.LocalFuncExecutor,
+	publish *job.PublishArticleExecutor,
+	transfer *job.ArticleTransferExecutor,
+	backfill *job.ArticleStatsBackfillJob,
+	svc service.JobService) *job.Scheduler {
 	res := job.NewScheduler(svc, l)
 	res.RegisterExecutor(local)
+	res.RegisterExecutor(publish)
+	res.RegisterExecutor(transfer)
+	// 老文章的字数统计和摘要只需要补一次
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := svc.AddOnce(ctx, domain.Job{
+		Name:         backfill.Name(),
+		Executor:     local.Name(),
+		NextExecTime: time.Now(),
+	})
+	if err != nil {
+		panic(err)
+	}
 	return res
 }
 
-func InitLocalFuncExecutor(svc service.RankingService) *job.LocalFuncExecutor {
+func InitLocalFuncExecutor(svc service.RankingService,
+	backfill *job.ArticleStatsBackfillJob) *job.LocalFuncExecutor {
 	res := job.NewLocalFuncExecutor()
 	res.RegisterFunc("ranking", func(ctx context.Context, j domain.Job) error {
 		ctx, cancel := context.WithTimeout(ctx, time.Second*20)
 		defer cancel()
 		return svc.TopN(ctx)
 	})
+	res.RegisterFunc(backfill.Name(), func(ctx context.Context, j domain.Job) error {
+		return backfill.Run()
+	})
 	return res
 }
+
+func InitArticleStatsBackfillJob(artSvc service.ArticleService, l logger.V1) *job.ArticleStatsBackfillJob {
+	return job.NewArticleStatsBackfillJob(artSvc, l, time.Second*30)
+}
@@ -4,6 +4,7 @@ import (
 	rlock "github.com/gotomicro/redis-lock"
 	"github.com/robfig/cron/v3"
 	"time"
+	intrSvc "we_book/interactive/service"
 	"we_book/internal/job"
 	"we_book/internal/service"
 	"we_book/pkg/logger"
@@ -15,12 +16,43 @@ func InitRankingJob(svc service.RankingService,
 	return job.NewRankingJob(svc, rlockClient, l, time.Second*30)
 }
 
-func InitJobs(l logger.V1, rankingJob *job.RankingJob) *cron.Cron {
+func InitPurgeTrashJob(artSvc service.ArticleService,
+	intrSvc intrSvc.InteractiveService,
+	l logger.V1) *job.PurgeTrashJob {
+	return job.NewPurgeTrashJob(artSvc, intrSvc, l, time.Second*30)
+}
+
+func InitUvSyncJob(intrSvc intrSvc.InteractiveService, l logger.V1) *job.UvSyncJob {
+	return job.NewUvSyncJob(intrSvc, l, time.Second*30)
+}
+
+func InitJobs(l logger.V1, rankingJob *job.RankingJob, purgeTrashJob *job.PurgeTrashJob,
+	uploadGCJob *job.UploadGCJob, historyTrimJob *job.HistoryTrimJob, uvSyncJob *job.UvSyncJob) *cron.Cron {
 	res := cron.New(cron.WithSeconds())
 	cbd := job.NewCronJobBuilder(l)
 	_, err := res.AddJob("0 */3 * * * ?", cbd.Build(rankingJob))
 	if err != nil {
 		panic(err)
 	}
+	// 每个小时清理一次回收站
+	_, err = res.AddJob("0 0 * * * ?", cbd.Build(purgeTrashJob))
+	if err != nil {
+		panic(err)
+	}
+	// 每天凌晨清理一次没有被引用的图片
+	_, err = res.AddJob("0 30 3 * * ?", cbd.Build(uploadGCJob))
+	if err != nil {
+		panic(err)
+	}
+	// 每天凌晨清理一次超出条数的阅读记录
+	_, err = res.AddJob("0 0 4 * * ?", cbd.Build(historyTrimJob))
+	if err != nil {
+		panic(err)
+	}
+	// 每分钟把独立访客数落库一次
+	_, err = res.AddJob("0 * * * * ?", cbd.Build(uvSyncJob))
+	if err != nil {
+		panic(err)
+	}
 	return res
 }
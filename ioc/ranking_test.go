@@ -0,0 +1,54 @@
+package ioc
+
+import (
+	rlock "github.com/gotomicro/redis-lock"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	intrSvcmocks "we_book/interactive/service/mocks"
+	"we_book/internal/job"
+	svcmocks "we_book/internal/service/mocks"
+	"we_book/pkg/logger"
+)
+
+func TestInitJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	l := logger.NewNoLogger()
+
+	artSvc := svcmocks.NewMockArticleService(ctrl)
+	artSvc.EXPECT().ListExpiredTrash(gomock.Any(), gomock.Any()).Return(nil, nil)
+	intrSvc := intrSvcmocks.NewMockInteractiveService(ctrl)
+	intrSvc.EXPECT().SyncUvCnt(gomock.Any(), gomock.Any()).Return(0, nil)
+	uploadSvc := svcmocks.NewMockUploadService(ctrl)
+	uploadSvc.EXPECT().GC(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil)
+	historySvc := intrSvcmocks.NewMockReadHistoryService(ctrl)
+	historySvc.EXPECT().Trim(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil)
+
+	// 连不上 Redis，排名任务拿不到锁就直接跳过了
+	rlockClient := rlock.NewClient(redis.NewClient(&redis.Options{
+		Addr:       "127.0.0.1:1",
+		MaxRetries: -1,
+	}))
+	c := InitJobs(l,
+		job.NewRankingJob(nil, rlockClient, l, time.Second),
+		job.NewPurgeTrashJob(artSvc, intrSvc, l, time.Second),
+		job.NewUploadGCJob(uploadSvc, l, time.Second, time.Hour),
+		job.NewHistoryTrimJob(historySvc, l, time.Second, 1000),
+		job.NewUvSyncJob(intrSvc, l, time.Second))
+
+	c.Start()
+	defer c.Stop()
+	entries := c.Entries()
+	require.Len(t, entries, 5)
+	now := time.Now()
+	for _, e := range entries {
+		// 启动之后每个任务都排上了下一次执行时间
+		assert.True(t, e.Next.After(now))
+		assert.True(t, e.Next.Before(now.Add(time.Hour*24+time.Second)))
+		e.Job.Run()
+	}
+}
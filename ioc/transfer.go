@@ -0,0 +1,43 @@
+package ioc
+
+import (
+	"fmt"
+	"github.com/spf13/viper"
+	"we_book/internal/repository"
+	"we_book/internal/service"
+	"we_book/pkg/blobx"
+	"we_book/pkg/logger"
+)
+
+// InitArticleTransferService 导入导出的压缩包按照配置存在 OSS 或者本地磁盘上
+// 多个实例部署的时候要用 s3，不然执行任务的实例可能拿不到上传的压缩包
+func InitArticleTransferService(svc service.ArticleService,
+	repo repository.TransferTaskRepository,
+	jobRepo repository.JobRepository,
+	l logger.V1) service.ArticleTransferService {
+	type Config struct {
+		Storage string `yaml:"storage"`
+		Dir     string `yaml:"dir"`
+	}
+	c := Config{
+		Storage: "local",
+		Dir:     "./data/transfer",
+	}
+	err := viper.UnmarshalKey("transfer", &c)
+	if err != nil {
+		panic("transfer config error")
+	}
+	var blob blobx.Storage
+	switch c.Storage {
+	case "s3":
+		blob = blobx.NewS3Storage(InitS3(), viper.GetString("s3.bucket"))
+	case "local":
+		blob, err = blobx.NewLocalStorage(c.Dir)
+		if err != nil {
+			panic(err)
+		}
+	default:
+		panic(fmt.Sprintf("unknown transfer storage %s", c.Storage))
+	}
+	return service.NewArticleTransferService(svc, repo, jobRepo, blob, l)
+}
@@ -0,0 +1,54 @@
+package ioc
+
+import (
+	"fmt"
+	"github.com/spf13/viper"
+	"time"
+	"we_book/internal/job"
+	"we_book/internal/repository"
+	"we_book/internal/service"
+	"we_book/pkg/blobx"
+	"we_book/pkg/logger"
+)
+
+// InitUploadService 图片按照配置存在 OSS 或者本地磁盘上
+// base_url 默认是本站的 /files，用了 CDN 的话配置成 CDN 的地址，回源到 /files
+func InitUploadService(repo repository.UploadRepository, l logger.V1) service.UploadService {
+	type Config struct {
+		Storage string `yaml:"storage"`
+		Dir     string `yaml:"dir"`
+	}
+	c := Config{
+		Storage: "local",
+		Dir:     "./data/upload",
+	}
+	err := viper.UnmarshalKey("upload", &c)
+	if err != nil {
+		panic("upload config error")
+	}
+	var blob blobx.Storage
+	switch c.Storage {
+	case "s3":
+		blob = blobx.NewS3Storage(InitS3(), viper.GetString("s3.bucket"))
+	case "local":
+		blob, err = blobx.NewLocalStorage(c.Dir)
+		if err != nil {
+			panic(err)
+		}
+	default:
+		panic(fmt.Sprintf("unknown upload storage %s", c.Storage))
+	}
+	baseURL := viper.GetString("upload.base_url")
+	if baseURL == "" {
+		baseURL = "/files"
+	}
+	return service.NewUploadService(repo, blob, baseURL, l)
+}
+
+func InitUploadGCJob(svc service.UploadService, l logger.V1) *job.UploadGCJob {
+	grace := viper.GetDuration("upload.gc_grace")
+	if grace <= 0 {
+		grace = time.Hour * 24
+	}
+	return job.NewUploadGCJob(svc, l, time.Second*30, grace)
+}
@@ -19,11 +19,29 @@ import (
 func InitWebServer(mdls []gin.HandlerFunc,
 	userHdl *web.UserHandler,
 	articlesHdl *web.ArticleHandler,
+	searchHdl *web.SearchHandler,
+	commentHdl *web.CommentHandler,
+	feedHdl *web.FeedHandler,
+	transferHdl *web.ArticleTransferHandler,
+	seriesHdl *web.SeriesHandler,
+	uploadHdl *web.UploadHandler,
+	collectionHdl *web.CollectionHandler,
+	userIntrHdl *web.UserInteractiveHandler,
+	historyHdl *web.HistoryHandler,
 	handler *web.OAuth2WeChatHandler) *gin.Engine {
 	server := gin.Default()
 	server.Use(mdls...)
 	userHdl.RegisterRoutes(server)
 	articlesHdl.RegisterRouters(server)
+	searchHdl.RegisterRoutes(server)
+	commentHdl.RegisterRoutes(server)
+	feedHdl.RegisterRoutes(server)
+	transferHdl.RegisterRoutes(server)
+	seriesHdl.RegisterRoutes(server)
+	uploadHdl.RegisterRoutes(server)
+	collectionHdl.RegisterRoutes(server)
+	userIntrHdl.RegisterRoutes(server)
+	historyHdl.RegisterRoutes(server)
 	handler.RegisterRoutes(server)
 	return server
 }
@@ -46,6 +64,17 @@ func InitMiddlewares(redisClient redis.Cmdable,
 			IgnorePaths("/oauth2/wechat/authurl").
 			IgnorePaths("/oauth2/wechat/callback").
 			IgnorePaths("/users/refresh_token").
+			IgnorePaths("/articles/pub/tag").
+			IgnorePaths("/articles/pub/category").
+			IgnorePaths("/articles/pub/tags/popular").
+			IgnorePaths("/articles/pub/search").
+			IgnorePaths("/comments/list").
+			IgnorePaths("/comments/replies").
+			IgnorePaths("/feeds/articles.rss").
+			IgnorePaths("/feeds/articles.atom").
+			// 系列的公开列表和详情，详情的路径里面带着 ID
+			IgnorePathPrefix("/series/pub/").
+			IgnorePathPrefix(web.UploadFilesPath).
 			Build(),
 		//ratelimit.NewBuilder(redisClient, time.Second, 100).Build(),
 		sessions.Sessions("my_session", store),
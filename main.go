@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
@@ -35,14 +36,29 @@ func main() {
 			panic(err)
 		}
 	}
+	go func() {
+		err := app.scheduler.Scheduler(context.Background())
+		if err != nil {
+			zap.L().Error("scheduler exit", zap.Error(err))
+		}
+	}()
+	// 回收站清理、图片回收这些定时任务都靠它调度
+	app.corn.Start()
+	go func() {
+		err := app.admin.Run(app.admin.Addr)
+		if err != nil {
+			zap.L().Error("admin server exit", zap.Error(err))
+		}
+	}()
+	server := app.web
+	server.Run(":8080")
+	// 等正在执行的任务结束，最多等 10 秒
 	ctx := app.corn.Stop()
 	tm := time.NewTimer(time.Second * 10)
 	select {
 	case <-tm.C:
 	case <-ctx.Done():
 	}
-	server := app.web
-	server.Run(":8080")
 }
 
 func InitPrometheus() {
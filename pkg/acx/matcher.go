@@ -0,0 +1,147 @@
+// Package acx 基于 Aho–Corasick 自动机的多关键词匹配，扫描一遍文本就能找出所有关键词
+package acx
+
+import (
+	"strings"
+	"unicode"
+)
+
+type node struct {
+	children map[rune]int
+	// fail 匹配失败的时候跳转到的节点，也就是当前前缀最长的真后缀
+	fail int
+	// word 以这个节点结尾的关键词，不是结尾就是空字符串
+	word string
+	// output 沿着 fail 链最近的一个关键词结尾节点，没有就是 -1
+	output int
+}
+
+// Matcher 构造完成之后只读，可以并发使用
+// 匹配不区分大小写，空白字符会被忽略，"敏 感" 也能匹配 "敏感"
+type Matcher struct {
+	nodes []node
+}
+
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{
+		nodes: []node{newNode()},
+	}
+	for _, w := range words {
+		m.insert(w)
+	}
+	m.build()
+	return m
+}
+
+func newNode() node {
+	return node{
+		children: map[rune]int{},
+		output:   -1,
+	}
+}
+
+func (m *Matcher) insert(word string) {
+	word = strings.TrimSpace(word)
+	cur := 0
+	for _, r := range word {
+		r, ok := normalize(r)
+		if !ok {
+			continue
+		}
+		next, ok := m.nodes[cur].children[r]
+		if !ok {
+			next = len(m.nodes)
+			m.nodes = append(m.nodes, newNode())
+			m.nodes[cur].children[r] = next
+		}
+		cur = next
+	}
+	if cur != 0 {
+		m.nodes[cur].word = word
+	}
+}
+
+// build 按照层次遍历设置 fail 指针
+func (m *Matcher) build() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].children {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].children {
+			m.nodes[child].fail = m.next(m.nodes[cur].fail, r)
+			fail := m.nodes[child].fail
+			if m.nodes[fail].word != "" {
+				m.nodes[child].output = fail
+			} else {
+				m.nodes[child].output = m.nodes[fail].output
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// next 从 cur 开始读入 r 之后到达的节点
+func (m *Matcher) next(cur int, r rune) int {
+	for {
+		if child, ok := m.nodes[cur].children[r]; ok {
+			return child
+		}
+		if cur == 0 {
+			return 0
+		}
+		cur = m.nodes[cur].fail
+	}
+}
+
+// FindAll 返回文本里面出现过的关键词，按照第一次出现的顺序，不重复
+func (m *Matcher) FindAll(text string) []string {
+	var res []string
+	seen := map[int]struct{}{}
+	cur := 0
+	for _, r := range text {
+		r, ok := normalize(r)
+		if !ok {
+			continue
+		}
+		cur = m.next(cur, r)
+		hit := cur
+		if m.nodes[hit].word == "" {
+			hit = m.nodes[hit].output
+		}
+		for ; hit >= 0; hit = m.nodes[hit].output {
+			if _, ok := seen[hit]; ok {
+				continue
+			}
+			seen[hit] = struct{}{}
+			res = append(res, m.nodes[hit].word)
+		}
+	}
+	return res
+}
+
+// Contains 文本里面有没有任何一个关键词
+func (m *Matcher) Contains(text string) bool {
+	cur := 0
+	for _, r := range text {
+		r, ok := normalize(r)
+		if !ok {
+			continue
+		}
+		cur = m.next(cur, r)
+		if m.nodes[cur].word != "" || m.nodes[cur].output >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize 统一成小写，空白字符跳过
+func normalize(r rune) (rune, bool) {
+	if unicode.IsSpace(r) {
+		return 0, false
+	}
+	return unicode.ToLower(r), true
+}
@@ -0,0 +1,57 @@
+package acx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_FindAll(t *testing.T) {
+	testCases := []struct {
+		name  string
+		words []string
+		text  string
+
+		want []string
+	}{
+		{
+			name:  "经典的例子",
+			words: []string{"he", "she", "his", "hers"},
+			text:  "ushers",
+			want:  []string{"she", "he", "hers"},
+		},
+		{
+			name:  "中文，重复出现只返回一次",
+			words: []string{"赌博", "博彩"},
+			text:  "这里有赌博彩票，还有赌博",
+			want:  []string{"赌博", "博彩"},
+		},
+		{
+			name:  "不区分大小写，忽略空白",
+			words: []string{"Bad Word"},
+			text:  "this is a BADWORD and b a d w o r d",
+			want:  []string{"Bad Word"},
+		},
+		{
+			name:  "没有命中",
+			words: []string{"敏感"},
+			text:  "正常的文章",
+		},
+		{
+			name: "没有关键词",
+			text: "随便什么",
+		},
+		{
+			name:  "空白的关键词忽略掉",
+			words: []string{" ", ""},
+			text:  "a b",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMatcher(tc.words)
+			assert.Equal(t, tc.want, m.FindAll(tc.text))
+			assert.Equal(t, len(tc.want) > 0, m.Contains(tc.text))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package blobx
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = s.Get(ctx, "1")
+	assert.Equal(t, ErrNotFound, err)
+
+	err = s.Put(ctx, "1", []byte("第一版"))
+	require.NoError(t, err)
+	err = s.Put(ctx, "1", []byte("第二版"))
+	require.NoError(t, err)
+	data, err := s.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "第二版", string(data))
+
+	err = s.Delete(ctx, "1")
+	require.NoError(t, err)
+	_, err = s.Get(ctx, "1")
+	assert.Equal(t, ErrNotFound, err)
+	// 删除不存在的 key
+	err = s.Delete(ctx, "1")
+	assert.NoError(t, err)
+}
+
+func TestLocalStorage_Path(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// 跳不出 dir
+	err = s.Put(ctx, "../../escape", []byte("x"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "escape"))
+	assert.NoError(t, err)
+
+	err = s.Put(ctx, "articles/2", []byte("y"))
+	require.NoError(t, err)
+	data, err := s.Get(ctx, "articles/2")
+	require.NoError(t, err)
+	assert.Equal(t, "y", string(data))
+
+	err = s.Put(ctx, "/", []byte("z"))
+	assert.Error(t, err)
+}
+
+func TestLocalStorage_Stream(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, _, err = s.Open(ctx, "1")
+	assert.Equal(t, ErrNotFound, err)
+
+	err = s.PutReader(ctx, "1", strings.NewReader("第一版"))
+	require.NoError(t, err)
+	// 读到一半出错，保留原来的内容
+	err = s.PutReader(ctx, "1", io.MultiReader(strings.NewReader("第二"),
+		iotest.ErrReader(errors.New("mock read error"))))
+	assert.Error(t, err)
+
+	r, size, err := s.Open(ctx, "1")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "第一版", string(data))
+	assert.Equal(t, int64(len(data)), size)
+}
@@ -0,0 +1,94 @@
+package blobx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"io"
+	"mime"
+	"path"
+)
+
+// S3Storage 兼容 S3 协议的对象存储，腾讯云 COS、MinIO 都可以用
+type S3Storage struct {
+	client *s3.S3
+	// uploader PutReader 不知道数据有多长，用分片上传
+	uploader    *s3manager.Uploader
+	bucket      string
+	contentType string
+}
+
+func NewS3Storage(client *s3.S3, bucket string) *S3Storage {
+	return &S3Storage{
+		client:      client,
+		uploader:    s3manager.NewUploaderWithClient(client),
+		bucket:      bucket,
+		contentType: "text/plain;charset=utf-8",
+	}
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(s.contentTypeOf(key)),
+	})
+	return err
+}
+
+// PutReader 分片上传失败的时候 uploader 会取消掉已经上传的分片
+func (s *S3Storage) PutReader(ctx context.Context, key string, r io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String(s.contentTypeOf(key)),
+	})
+	return err
+}
+
+// contentTypeOf 图片这种有扩展名的按照扩展名，文章内容没有扩展名，用默认的
+func (s *S3Storage) contentTypeOf(key string) string {
+	if t := mime.TypeByExtension(path.Ext(key)); t != "" {
+		return t
+	}
+	return s.contentType
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	body, _, err := s.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	res, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, 0, ErrNotFound
+		}
+		return nil, 0, err
+	}
+	return res.Body, aws.Int64Value(res.ContentLength), nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	// S3 删除不存在的 key 也是成功的
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
@@ -0,0 +1,23 @@
+package blobx
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Storage 存放大块的二进制数据，比如文章内容
+// key 由调用方决定，同一个 key 再次 Put 会覆盖
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// PutReader 边读边写，比较大的数据不用整个放在内存里，r 返回 error 的时候不会留下写了一半的数据
+	PutReader(ctx context.Context, key string, r io.Reader) error
+	// Get key 不存在的时候返回 ErrNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Open 流式读取，同时返回数据的长度，用完之后要 Close，key 不存在的时候返回 ErrNotFound
+	Open(ctx context.Context, key string) (io.ReadCloser, int64, error)
+	// Delete key 不存在的时候不返回 error
+	Delete(ctx context.Context, key string) error
+}
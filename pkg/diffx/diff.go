@@ -0,0 +1,137 @@
+package diffx
+
+import "strings"
+
+type Op uint8
+
+const (
+	OpEqual Op = iota
+	OpInsert
+	OpDelete
+)
+
+func (o Op) String() string {
+	switch o {
+	case OpInsert:
+		return "+"
+	case OpDelete:
+		return "-"
+	default:
+		return " "
+	}
+}
+
+// Line 是 diff 结果中的一行
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines 按行比较两段文本
+func Lines(a, b string) []Line {
+	return Diff(split(a), split(b))
+}
+
+// Diff 使用 Myers 算法求最短编辑脚本
+// 先裁掉公共的前缀和后缀，文章修改通常只动中间的一小段
+func Diff(a, b []string) []Line {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	res := make([]Line, 0, len(a)+len(b))
+	for _, s := range a[:prefix] {
+		res = append(res, Line{Op: OpEqual, Text: s})
+	}
+	res = append(res, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, s := range a[len(a)-suffix:] {
+		res = append(res, Line{Op: OpEqual, Text: s})
+	}
+	return res
+}
+
+func myers(a, b []string) []Line {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	// trace[d] 只保存第 d 步用到的 [-d-1, d+1] 区间，避免 O((n+m)^2) 的内存
+	trace := make([][]int, 0, 16)
+	for d := 0; d <= maxD; d++ {
+		snapshot := make([]int, 2*d+3)
+		copy(snapshot, v[offset-d-1:offset+d+2])
+		trace = append(trace, snapshot)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, a, b)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(trace [][]int, a, b []string) []Line {
+	x, y := len(a), len(b)
+	res := make([]Line, 0, len(a)+len(b))
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		// v 的下标 0 对应 k = -d-1
+		at := func(k int) int {
+			return v[k+d+1]
+		}
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			res = append(res, Line{Op: OpEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				res = append(res, Line{Op: OpInsert, Text: b[y-1]})
+			} else {
+				res = append(res, Line{Op: OpDelete, Text: a[x-1]})
+			}
+			x, y = prevX, prevY
+		}
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,76 @@
+package diffx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want []Line
+	}{
+		{
+			name: "都为空",
+			want: []Line{},
+		},
+		{
+			name: "完全相同",
+			a:    "a\nb",
+			b:    "a\nb",
+			want: []Line{{OpEqual, "a"}, {OpEqual, "b"}},
+		},
+		{
+			name: "新增一行",
+			a:    "a\nc",
+			b:    "a\nb\nc",
+			want: []Line{{OpEqual, "a"}, {OpInsert, "b"}, {OpEqual, "c"}},
+		},
+		{
+			name: "删除一行",
+			a:    "a\nb\nc",
+			b:    "a\nc",
+			want: []Line{{OpEqual, "a"}, {OpDelete, "b"}, {OpEqual, "c"}},
+		},
+		{
+			name: "修改一行",
+			a:    "标题\n旧的内容\n结尾",
+			b:    "标题\n新的内容\n结尾",
+			want: []Line{{OpEqual, "标题"}, {OpDelete, "旧的内容"},
+				{OpInsert, "新的内容"}, {OpEqual, "结尾"}},
+		},
+		{
+			name: "从空到有",
+			b:    "a\nb",
+			want: []Line{{OpInsert, "a"}, {OpInsert, "b"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := Lines(tc.a, tc.b)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+// TestLinesApply 验证 diff 结果可以还原出两边的文本
+func TestLinesApply(t *testing.T) {
+	a := "a\nb\nc\na\nb\nb\na"
+	b := "c\nb\na\nb\na\nc"
+	res := Lines(a, b)
+	var src, dst []string
+	for _, l := range res {
+		if l.Op != OpInsert {
+			src = append(src, l.Text)
+		}
+		if l.Op != OpDelete {
+			dst = append(dst, l.Text)
+		}
+	}
+	assert.Equal(t, a, strings.Join(src, "\n"))
+	assert.Equal(t, b, strings.Join(dst, "\n"))
+}
@@ -0,0 +1,164 @@
+package feedx
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Feed 和格式无关的订阅源，RSS 和 Atom 都从它生成
+type Feed struct {
+	Title       string
+	Link        string
+	Description string
+	Author      string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	Title string
+	// Link 同时作为 RSS 的 guid 和 Atom 的 id
+	Link        string
+	Description string
+	Author      string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Guid        rssGuid  `xml:"guid"`
+	Description string   `xml:"description"`
+	Author      string   `xml:"author,omitempty"`
+	Categories  []string `xml:"category"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	Id      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	Id         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// RSS 生成 RSS 2.0
+func RSS(f Feed) ([]byte, error) {
+	items := make([]rssItem, 0, len(f.Items))
+	for _, it := range f.Items {
+		items = append(items, rssItem{
+			Title:       it.Title,
+			Link:        it.Link,
+			Guid:        rssGuid{IsPermaLink: true, Value: it.Link},
+			Description: it.Description,
+			Author:      it.Author,
+			Categories:  it.Categories,
+			PubDate:     it.Published.UTC().Format(time.RFC1123Z),
+		})
+	}
+	return marshal(rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   f.Description,
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+			Items:         items,
+		},
+	})
+}
+
+// Atom 生成 Atom 1.0，条目没有作者的时候用订阅源的作者
+func Atom(f Feed) ([]byte, error) {
+	entries := make([]atomEntry, 0, len(f.Items))
+	for _, it := range f.Items {
+		entries = append(entries, atomEntry{
+			Title:      it.Title,
+			Id:         it.Link,
+			Link:       atomLink{Href: it.Link, Rel: "alternate"},
+			Published:  it.Published.UTC().Format(time.RFC3339),
+			Updated:    it.Updated.UTC().Format(time.RFC3339),
+			Author:     atomAuthorOf(it.Author),
+			Summary:    it.Description,
+			Categories: atomCategories(it.Categories),
+		})
+	}
+	return marshal(atomFeed{
+		Title:   f.Title,
+		Id:      f.Link,
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Link:    atomLink{Href: f.Link, Rel: "alternate"},
+		Author:  atomAuthorOf(f.Author),
+		Entries: entries,
+	})
+}
+
+func atomAuthorOf(name string) *atomAuthor {
+	if name == "" {
+		return nil
+	}
+	return &atomAuthor{Name: name}
+}
+
+func atomCategories(cs []string) []atomCategory {
+	res := make([]atomCategory, 0, len(cs))
+	for _, c := range cs {
+		res = append(res, atomCategory{Term: c})
+	}
+	return res
+}
+
+func marshal(v any) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
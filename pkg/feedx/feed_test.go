@@ -0,0 +1,73 @@
+package feedx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeed = Feed{
+	Title:       "we_book",
+	Link:        "https://example.com",
+	Description: "最新文章",
+	Author:      "we_book",
+	Updated:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	Items: []Item{
+		{
+			Title:       "a < b",
+			Link:        "https://example.com/articles/1",
+			Description: "摘要",
+			Categories:  []string{"go"},
+			Published:   time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			Updated:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+	},
+}
+
+func TestRSS(t *testing.T) {
+	data, err := RSS(testFeed)
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>we_book</title>
+    <link>https://example.com</link>
+    <description>最新文章</description>
+    <lastBuildDate>Wed, 01 May 2024 10:00:00 +0000</lastBuildDate>
+    <item>
+      <title>a &lt; b</title>
+      <link>https://example.com/articles/1</link>
+      <guid isPermaLink="true">https://example.com/articles/1</guid>
+      <description>摘要</description>
+      <category>go</category>
+      <pubDate>Wed, 01 May 2024 09:00:00 +0000</pubDate>
+    </item>
+  </channel>
+</rss>`, string(data))
+}
+
+func TestAtom(t *testing.T) {
+	data, err := Atom(testFeed)
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>we_book</title>
+  <id>https://example.com</id>
+  <updated>2024-05-01T10:00:00Z</updated>
+  <link href="https://example.com" rel="alternate"></link>
+  <author>
+    <name>we_book</name>
+  </author>
+  <entry>
+    <title>a &lt; b</title>
+    <id>https://example.com/articles/1</id>
+    <link href="https://example.com/articles/1" rel="alternate"></link>
+    <published>2024-05-01T09:00:00Z</published>
+    <updated>2024-05-01T10:00:00Z</updated>
+    <summary>摘要</summary>
+    <category term="go"></category>
+  </entry>
+</feed>`, string(data))
+}
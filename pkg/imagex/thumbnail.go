@@ -0,0 +1,87 @@
+// Package imagex 图片的缩略图，只用标准库，支持 JPEG、PNG 和 GIF
+package imagex
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+)
+
+var ErrTooLarge = errors.New("image is too large")
+
+// Decode 先只读图片头检查尺寸，防止很小的文件解码出来占用大量内存
+// maxPixels 是宽乘高的上限
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("invalid image size")
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// Thumbnail 等比例缩小到长边不超过 maxSide，本来就比较小的图片不放大
+// 每个目标像素取源图片对应区域的平均值，缩小的时候不会有明显的锯齿
+// 直接从 src 读像素，除了缩略图本身不再分配和原图一样大的内存
+func Thumbnail(src image.Image, maxSide int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	tw, th := w, h
+	if w >= h && w > maxSide {
+		tw, th = maxSide, h*maxSide/w
+	} else if h > w && h > maxSide {
+		tw, th = w*maxSide/h, maxSide
+	}
+	if tw < 1 {
+		tw = 1
+	}
+	if th < 1 {
+		th = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := y*h/th, (y+1)*h/th
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < tw; x++ {
+			x0, x1 := x*w/tw, (x+1)*w/tw
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					// RGBA 返回的是预乘过 alpha 的值，透明的部分铺白色，缩略图统一编码成 JPEG
+					sr, sg, sb, sa := src.At(b.Min.X+sx, b.Min.Y+sy).RGBA()
+					r += uint64(sr + 0xffff - sa)
+					g += uint64(sg + 0xffff - sa)
+					bl += uint64(sb + 0xffff - sa)
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(bl / n >> 8)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// EncodeJPEG 缩略图用的 JPEG 编码
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80})
+	return buf.Bytes(), err
+}
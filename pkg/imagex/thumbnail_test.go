@@ -0,0 +1,103 @@
+package imagex
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThumbnail(t *testing.T) {
+	testCases := []struct {
+		name    string
+		w, h    int
+		maxSide int
+
+		wantW, wantH int
+	}{
+		{
+			name:    "横图按照宽度缩小",
+			w:       800,
+			h:       400,
+			maxSide: 200,
+			wantW:   200,
+			wantH:   100,
+		},
+		{
+			name:    "竖图按照高度缩小",
+			w:       300,
+			h:       900,
+			maxSide: 300,
+			wantW:   100,
+			wantH:   300,
+		},
+		{
+			name:    "小图不放大",
+			w:       50,
+			h:       40,
+			maxSide: 200,
+			wantW:   50,
+			wantH:   40,
+		},
+		{
+			name:    "特别细长的图至少一个像素",
+			w:       1000,
+			h:       1,
+			maxSide: 100,
+			wantW:   100,
+			wantH:   1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := image.NewNRGBA(image.Rect(0, 0, tc.w, tc.h))
+			res := Thumbnail(src, tc.maxSide)
+			assert.Equal(t, tc.wantW, res.Bounds().Dx())
+			assert.Equal(t, tc.wantH, res.Bounds().Dy())
+		})
+	}
+}
+
+func TestThumbnail_Transparent(t *testing.T) {
+	// 透明的图片缩小之后是白色的
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	res := Thumbnail(src, 2)
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, res.At(0, 0))
+}
+
+func TestThumbnail_SubImage(t *testing.T) {
+	// 左半边黑色右半边白色，只取右半边缩小之后还是白色
+	src := image.NewNRGBA(image.Rect(0, 0, 8, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 8; x++ {
+			c := color.NRGBA{A: 0xff}
+			if x >= 4 {
+				c = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+	res := Thumbnail(src.SubImage(image.Rect(4, 0, 8, 4)), 2)
+	assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, res.At(1, 1))
+	res = Thumbnail(src, 2)
+	assert.Equal(t, color.RGBA{A: 0xff}, res.At(0, 0))
+}
+
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 100, 100))))
+
+	img, err := Decode(buf.Bytes(), 100*100)
+	require.NoError(t, err)
+	assert.Equal(t, 100, img.Bounds().Dx())
+
+	_, err = Decode(buf.Bytes(), 100*99)
+	assert.Equal(t, ErrTooLarge, err)
+
+	_, err = Decode([]byte("not an image"), 100)
+	assert.Equal(t, image.ErrFormat, err)
+}
@@ -0,0 +1,185 @@
+package markdown
+
+import (
+	"bytes"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	mdhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Heading 目录里面的一项，Id 和 HTML 里面标题的 id 一致
+type Heading struct {
+	Level int
+	Id    string
+	Title string
+}
+
+// Document 渲染的结果
+type Document struct {
+	// HTML 已经过滤掉了 script、iframe 这些不安全的内容
+	HTML string
+	TOC  []Heading
+	// Text 去掉格式之后的纯文本，不包括代码块和 HTML
+	Text string
+}
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		// 允许作者写 HTML，输出之前统一过滤
+		goldmark.WithRendererOptions(mdhtml.WithUnsafe()),
+	)
+	policy = newPolicy()
+	// textPolicy 从过滤过的 HTML 里面取纯文本，代码块不要
+	textPolicy = newTextPolicy()
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// 代码高亮要用到语言
+	p.AllowAttrs("class").
+		Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).
+		OnElements("code")
+	// 标题的锚点可能是中文
+	p.AllowAttrs("id").
+		Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	return p
+}
+
+func newTextPolicy() *bluemonday.Policy {
+	p := bluemonday.StrictPolicy()
+	p.SkipElementsContent("pre")
+	return p
+}
+
+// Render 把 Markdown 渲染成过滤过的 HTML，同时生成目录和纯文本
+func Render(src string) (Document, error) {
+	source := []byte(src)
+	doc := md.Parser().Parse(text.NewReader(source),
+		parser.WithContext(parser.NewContext(parser.WithIDs(newHeadingIDs()))))
+	var buf bytes.Buffer
+	err := md.Renderer().Render(&buf, source, doc)
+	if err != nil {
+		return Document{}, err
+	}
+	res := policy.Sanitize(buf.String())
+	return Document{
+		HTML: res,
+		TOC:  headings(doc, source),
+		Text: plainText(res),
+	}, nil
+}
+
+// PlainText 只要纯文本的时候用
+func PlainText(src string) string {
+	doc, err := Render(src)
+	if err != nil {
+		return ""
+	}
+	return doc.Text
+}
+
+// plainText 块和块之间、换行都当作一个空格
+// 用过滤之后的 HTML，script 这些被过滤掉的内容不会出现在纯文本里面
+func plainText(res string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(textPolicy.Sanitize(res))), " ")
+}
+
+func headings(doc ast.Node, source []byte) []Heading {
+	var res []Heading
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		var id string
+		if val, ok := h.AttributeString("id"); ok {
+			if bs, ok := val.([]byte); ok {
+				id = string(bs)
+			}
+		}
+		res = append(res, Heading{
+			Level: h.Level,
+			Id:    id,
+			Title: inlineText(h, source),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	return res
+}
+
+// inlineText 标题里面的文字，HTML 和图片都不要
+func inlineText(node ast.Node, source []byte) string {
+	var sb strings.Builder
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch v := n.(type) {
+		case *ast.RawHTML, *ast.Image:
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			sb.Write(v.Segment.Value(source))
+			if v.SoftLineBreak() || v.HardLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(v.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// headingIDs 和 goldmark 默认的一样，只是保留了中文这些非 ASCII 的字母和数字
+// 默认的实现会把纯中文的标题变成 heading、heading-1 这种没有意义的锚点
+type headingIDs struct {
+	values map[string]struct{}
+}
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{values: make(map[string]struct{})}
+}
+
+func (h *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	var sb strings.Builder
+	for _, r := range strings.TrimSpace(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			sb.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			sb.WriteByte('-')
+		}
+	}
+	res := sb.String()
+	if res == "" {
+		res = "heading"
+		if kind != ast.KindHeading {
+			res = "id"
+		}
+	}
+	id := res
+	for i := 1; ; i++ {
+		if _, ok := h.values[id]; !ok {
+			break
+		}
+		id = res + "-" + strconv.Itoa(i)
+	}
+	h.values[id] = struct{}{}
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.values[string(value)] = struct{}{}
+}
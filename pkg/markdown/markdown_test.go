@@ -0,0 +1,88 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+
+		wantHTML string
+		wantTOC  []Heading
+		wantText string
+	}{
+		{
+			name:     "普通段落",
+			src:      "hello **world**",
+			wantHTML: "<p>hello <strong>world</strong></p>\n",
+			wantText: "hello world",
+		},
+		{
+			name: "标题生成目录",
+			src:  "# Intro\n\ntext\n\n## Usage `go`\n",
+			wantHTML: "<h1 id=\"intro\">Intro</h1>\n<p>text</p>\n" +
+				"<h2 id=\"usage-go\">Usage <code>go</code></h2>\n",
+			wantTOC: []Heading{
+				{Level: 1, Id: "intro", Title: "Intro"},
+				{Level: 2, Id: "usage-go", Title: "Usage go"},
+			},
+			wantText: "Intro text Usage go",
+		},
+		{
+			name: "中文标题的锚点保留中文，重复的加上序号",
+			src:  "# 快速开始\n\n## 安装 Go 1.21\n\n## 快速开始\n",
+			wantHTML: "<h1 id=\"快速开始\">快速开始</h1>\n" +
+				"<h2 id=\"安装-go-121\">安装 Go 1.21</h2>\n" +
+				"<h2 id=\"快速开始-1\">快速开始</h2>\n",
+			wantTOC: []Heading{
+				{Level: 1, Id: "快速开始", Title: "快速开始"},
+				{Level: 2, Id: "安装-go-121", Title: "安装 Go 1.21"},
+				{Level: 2, Id: "快速开始-1", Title: "快速开始"},
+			},
+			wantText: "快速开始 安装 Go 1.21 快速开始",
+		},
+		{
+			name:     "过滤 script 和 iframe",
+			src:      "<script>alert(1)</script>\n\nhi <iframe src=\"https://evil.com\"></iframe>",
+			wantHTML: "\n<p>hi </p>\n",
+			wantText: "hi",
+		},
+		{
+			name:     "行内的 script 内容不进入纯文本",
+			src:      "a <script>x()</script> b",
+			wantHTML: "<p>a  b</p>\n",
+			wantText: "a b",
+		},
+		{
+			name:     "过滤不安全的属性",
+			src:      "<a href=\"javascript:alert(1)\" onclick=\"x()\">link</a> [ok](https://a.com)",
+			wantHTML: "<p>link <a href=\"https://a.com\" rel=\"nofollow\">ok</a></p>\n",
+			wantText: "link ok",
+		},
+		{
+			name:     "代码块保留语言，不进入纯文本",
+			src:      "before\n\n```go\nfmt.Println(1)\n```\n",
+			wantHTML: "<p>before</p>\n<pre><code class=\"language-go\">fmt.Println(1)\n</code></pre>\n",
+			wantText: "before",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := Render(tc.src)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantHTML, doc.HTML)
+			assert.Equal(t, tc.wantTOC, doc.TOC)
+			assert.Equal(t, tc.wantText, doc.Text)
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	assert.Equal(t, "标题 第一行 第二行 列表",
+		PlainText("# 标题\n\n第一行\n第二行\n\n- *列表*\n\n![图片](a.png)"))
+}
@@ -0,0 +1,65 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Stats 文章的字数统计，代码块和被过滤掉的 HTML 不算
+type Stats struct {
+	// Words 中日韩文字一个字算一个词，其他文字按照空白和标点分词
+	Words int
+	// CJK 中日韩文字的字数
+	CJK int
+	// Latin 除了中日韩文字以外的词数
+	Latin int
+	// Chars 去掉空白之后的字符数
+	Chars int
+	// Images 图片数，Markdown 和 HTML 的图片都算
+	Images int
+}
+
+// Count 统计 Markdown 的字数和图片数
+func Count(src string) Stats {
+	doc, err := Render(src)
+	if err != nil {
+		return Stats{}
+	}
+	res := countText(doc.Text)
+	res.Images = strings.Count(doc.HTML, "<img ")
+	return res
+}
+
+func countText(text string) Stats {
+	var res Stats
+	// inWord 上一个字符是不是在一个非中日韩的词里面
+	inWord := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			inWord = false
+			continue
+		}
+		res.Chars++
+		switch {
+		case isCJK(r):
+			res.CJK++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				res.Latin++
+				inWord = true
+			}
+		default:
+			// 标点把词分开，但是 don't、e-mail 这种算一个词
+			if r != '\'' && r != '-' {
+				inWord = false
+			}
+		}
+	}
+	res.Words = res.CJK + res.Latin
+	return res
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,47 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+
+		want Stats
+	}{
+		{
+			name: "中英文混排",
+			src:  "# 标题\n\n学习 Go 语言, it's fun!",
+			want: Stats{Words: 9, CJK: 6, Latin: 3, Chars: 17},
+		},
+		{
+			name: "代码块不算",
+			src:  "before\n\n```go\nfmt.Println(1)\n```\n",
+			want: Stats{Words: 1, Latin: 1, Chars: 6},
+		},
+		{
+			name: "Markdown 和 HTML 的图片都算",
+			src:  "![a](a.png)\n\n<img src=\"b.png\">\n\n文字",
+			want: Stats{Words: 2, CJK: 2, Chars: 2, Images: 2},
+		},
+		{
+			name: "日文和韩文",
+			src:  "ひらがな 한국어",
+			want: Stats{Words: 7, CJK: 7, Chars: 7},
+		},
+		{
+			name: "空文章",
+			src:  "",
+			want: Stats{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Count(tc.src))
+		})
+	}
+}
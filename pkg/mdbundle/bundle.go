@@ -0,0 +1,293 @@
+// Package mdbundle 读写文章的压缩包，用来批量导入导出
+//
+// 压缩包里面可以是带 front matter 的 Markdown 文件，一个文件一篇文章，
+// 也可以是 JSON 文件，一个文件是一个文章数组。
+package mdbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxFiles 一个压缩包里面最多的文件数
+	MaxFiles = 1000
+	// MaxFileSize 单篇文章的大小上限，Markdown 是单个文件解压之后的大小，JSON 是单篇文章的内容
+	MaxFileSize = 1 << 20
+	// MaxJSONFileSize JSON 文件里面是所有的文章，单独限制
+	MaxJSONFileSize = 64 << 20
+	// JSONFileName 导出 JSON 的时候使用的文件名
+	JSONFileName = "articles.json"
+)
+
+var (
+	ErrTooManyFiles = errors.New("too many files in bundle")
+	ErrFileTooLarge = errors.New("file too large")
+	ErrEmptyName    = errors.New("entry name is empty")
+)
+
+// Meta 文章的 front matter，字段名和 Hexo、Hugo 的习惯保持一致
+type Meta struct {
+	Title    string   `yaml:"title" json:"title"`
+	Category string   `yaml:"category,omitempty" json:"category,omitempty"`
+	Tags     []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	// Status draft、published、private 或者 scheduled，空的就是 draft
+	Status string `yaml:"status,omitempty" json:"status,omitempty"`
+	// Date 创建时间，定时发布的文章是发布时间
+	Date    time.Time `yaml:"date,omitempty" json:"date"`
+	Updated time.Time `yaml:"updated,omitempty" json:"updated"`
+}
+
+// Entry 一篇文章
+type Entry struct {
+	// Name 压缩包里面的文件名，JSON 里面的文章是 "文件名#下标"
+	Name string `json:"-"`
+	Meta
+	Content string `json:"content"`
+}
+
+// Result 一篇文章的解析结果，Err 不为 nil 的时候 Entry 只有 Name
+type Result struct {
+	Entry Entry
+	Err   error
+}
+
+// Read 按照文件名的顺序读取压缩包里面所有的文章
+// 单个文件有问题放在对应的 Result 里面，不影响其他文件；
+// 压缩包本身有问题才返回 error
+func Read(data []byte) ([]Result, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var files []*zip.File
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || skip(f.Name) {
+			continue
+		}
+		files = append(files, f)
+	}
+	if len(files) > MaxFiles {
+		return nil, ErrTooManyFiles
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	res := make([]Result, 0, len(files))
+	for _, f := range files {
+		content, err := readFile(f)
+		if err != nil {
+			res = append(res, Result{Entry: Entry{Name: f.Name}, Err: err})
+			continue
+		}
+		if strings.EqualFold(path.Ext(f.Name), ".json") {
+			res = append(res, parseJSON(f.Name, content)...)
+			continue
+		}
+		e, err := Parse(f.Name, content)
+		res = append(res, Result{Entry: e, Err: err})
+	}
+	return res, nil
+}
+
+// skip 跳过不认识的文件，以及 macOS 打包时带上的隐藏文件
+func skip(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+		return true
+	}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown", ".json":
+		return false
+	default:
+		return true
+	}
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	var limit uint64 = MaxFileSize
+	if strings.EqualFold(path.Ext(f.Name), ".json") {
+		limit = MaxJSONFileSize
+	}
+	if f.UncompressedSize64 > limit {
+		return nil, ErrFileTooLarge
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	// 头部里面的大小可以伪造，读的时候再限制一次
+	content, err := io.ReadAll(io.LimitReader(rc, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(content)) > limit {
+		return nil, ErrFileTooLarge
+	}
+	return content, nil
+}
+
+func parseJSON(name string, data []byte) []Result {
+	var entries []Entry
+	err := json.Unmarshal(data, &entries)
+	if err != nil {
+		return []Result{{Entry: Entry{Name: name}, Err: err}}
+	}
+	res := make([]Result, 0, len(entries))
+	for i, e := range entries {
+		e.Name = fmt.Sprintf("%s#%d", name, i)
+		if len(e.Content) > MaxFileSize {
+			res = append(res, Result{Entry: Entry{Name: e.Name}, Err: ErrFileTooLarge})
+			continue
+		}
+		res = append(res, Result{Entry: e})
+	}
+	return res
+}
+
+// Parse 解析一个 Markdown 文件，front matter 是可选的
+// front matter 里面没有标题的时候，用文件名当标题
+func Parse(name string, data []byte) (Entry, error) {
+	e := Entry{Name: name}
+	text := strings.TrimPrefix(string(data), "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	front, body, ok := splitFrontMatter(text)
+	if ok {
+		err := yaml.Unmarshal([]byte(front), &e.Meta)
+		if err != nil {
+			return e, fmt.Errorf("invalid front matter: %w", err)
+		}
+	}
+	e.Content = body
+	if e.Title == "" {
+		e.Title = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+	return e, nil
+}
+
+func splitFrontMatter(text string) (string, string, bool) {
+	if !strings.HasPrefix(text, "---\n") {
+		return "", text, false
+	}
+	rest := text[len("---\n"):]
+	// 结束的分隔线可能是第一行，也就是 front matter 是空的
+	if strings.HasPrefix(rest, "---\n") || rest == "---" {
+		return "", strings.TrimPrefix(strings.TrimPrefix(rest, "---"), "\n"), true
+	}
+	idx := strings.Index(rest, "\n---\n")
+	if idx < 0 {
+		if strings.HasSuffix(rest, "\n---") {
+			return rest[:len(rest)-len("\n---")], "", true
+		}
+		return "", text, false
+	}
+	return rest[:idx], rest[idx+len("\n---\n"):], true
+}
+
+// Marshal 生成带 front matter 的 Markdown 文件
+func Marshal(e Entry) ([]byte, error) {
+	front, err := yaml.Marshal(e.Meta)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(front)
+	buf.WriteString("---\n")
+	buf.WriteString(e.Content)
+	return buf.Bytes(), nil
+}
+
+// Writer 把文章写成压缩包，边写边输出，不会把所有文章都放在内存里
+// Markdown 格式每次 Add 写一个文件，JSON 格式所有文章写在同一个数组里面
+type Writer struct {
+	zw   *zip.Writer
+	json bool
+	// jw JSON 格式下 JSONFileName 的 writer，第一次 Add 的时候创建
+	jw io.Writer
+}
+
+func NewMarkdownWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w)}
+}
+
+func NewJSONWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w), json: true}
+}
+
+// Add Markdown 格式下 Name 就是文件名，不能为空
+func (w *Writer) Add(e Entry) error {
+	if w.json {
+		return w.addJSON(e)
+	}
+	if e.Name == "" {
+		return ErrEmptyName
+	}
+	data, err := Marshal(e)
+	if err != nil {
+		return err
+	}
+	fw, err := w.create(e.Name)
+	if err != nil {
+		return err
+	}
+	_, err = fw.Write(data)
+	return err
+}
+
+// addJSON 输出的格式和 json.MarshalIndent(entries, "", "  ") 一样
+func (w *Writer) addJSON(e Entry) error {
+	data, err := json.MarshalIndent(e, "  ", "  ")
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if w.jw == nil {
+		w.jw, err = w.create(JSONFileName)
+		if err != nil {
+			return err
+		}
+		sep = "[\n  "
+	}
+	_, err = io.WriteString(w.jw, sep)
+	if err != nil {
+		return err
+	}
+	_, err = w.jw.Write(data)
+	return err
+}
+
+func (w *Writer) Close() error {
+	if w.json {
+		end := "\n]"
+		if w.jw == nil {
+			fw, err := w.create(JSONFileName)
+			if err != nil {
+				return err
+			}
+			w.jw, end = fw, "[]"
+		}
+		_, err := io.WriteString(w.jw, end)
+		if err != nil {
+			return err
+		}
+	}
+	return w.zw.Close()
+}
+
+func (w *Writer) create(name string) (io.Writer, error) {
+	return w.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+}
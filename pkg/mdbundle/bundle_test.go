@@ -0,0 +1,220 @@
+package mdbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fileName string
+		data     string
+		want     Entry
+		wantErr  bool
+	}{
+		{
+			name:     "完整的 front matter",
+			fileName: "posts/hello.md",
+			data: "---\ntitle: 你好\ncategory: go\ntags: [go, web]\nstatus: published\n" +
+				"date: 2024-05-01 10:00:00\nupdated: \"2024-05-02T08:00:00Z\"\n---\n# 正文\n",
+			want: Entry{
+				Name: "posts/hello.md",
+				Meta: Meta{
+					Title:    "你好",
+					Category: "go",
+					Tags:     []string{"go", "web"},
+					Status:   "published",
+					Date:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+					Updated:  time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+				},
+				Content: "# 正文\n",
+			},
+		},
+		{
+			name:     "没有 front matter，用文件名当标题",
+			fileName: "posts/hello.md",
+			data:     "# 正文\n",
+			want: Entry{
+				Name:    "posts/hello.md",
+				Meta:    Meta{Title: "hello"},
+				Content: "# 正文\n",
+			},
+		},
+		{
+			name:     "Windows 换行和 BOM",
+			fileName: "a.md",
+			data:     "\ufeff---\r\ntitle: abc\r\n---\r\nbody\r\n",
+			want: Entry{
+				Name:    "a.md",
+				Meta:    Meta{Title: "abc"},
+				Content: "body\n",
+			},
+		},
+		{
+			name:     "空的 front matter",
+			fileName: "a.md",
+			data:     "---\n---\nbody",
+			want: Entry{
+				Name:    "a.md",
+				Meta:    Meta{Title: "a"},
+				Content: "body",
+			},
+		},
+		{
+			name:     "front matter 格式错误",
+			fileName: "a.md",
+			data:     "---\ntitle: [abc\n---\nbody",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := Parse(tc.fileName, []byte(tc.data))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, e)
+		})
+	}
+}
+
+func TestMarkdownWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewMarkdownWriter(&buf)
+	entries := []Entry{
+		{
+			Name: "2-b.md",
+			Meta: Meta{
+				Title:  "b",
+				Tags:   []string{"go"},
+				Status: "draft",
+				Date:   time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			},
+			Content: "内容 b\n",
+		},
+		{
+			Name:    "1-a.md",
+			Meta:    Meta{Title: "a", Status: "published"},
+			Content: "内容 a\n",
+		},
+	}
+	for _, e := range entries {
+		require.NoError(t, w.Add(e))
+	}
+	require.NoError(t, w.Close())
+
+	res, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	// 按照文件名排序
+	assert.Equal(t, []Result{{Entry: entries[1]}, {Entry: entries[0]}}, res)
+	assert.Equal(t, ErrEmptyName, NewMarkdownWriter(&buf).Add(Entry{}))
+}
+
+func TestJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewJSONWriter(&buf)
+	entries := []Entry{
+		{Meta: Meta{Title: "a", Category: "go"}, Content: "内容 a"},
+		{Meta: Meta{Title: "b"}, Content: "内容 b"},
+	}
+	for _, e := range entries {
+		require.NoError(t, w.Add(e))
+	}
+	require.NoError(t, w.Close())
+
+	res, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for i, r := range res {
+		require.NoError(t, r.Err)
+		assert.True(t, strings.HasPrefix(r.Entry.Name, JSONFileName+"#"))
+		assert.Equal(t, entries[i].Meta, r.Entry.Meta)
+		assert.Equal(t, entries[i].Content, r.Entry.Content)
+	}
+	// 边写边输出的结果和一次性序列化的一样
+	want, err := json.MarshalIndent(entries, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), readJSONFile(t, buf.Bytes()))
+
+	buf.Reset()
+	require.NoError(t, NewJSONWriter(&buf).Close())
+	assert.Equal(t, "[]", readJSONFile(t, buf.Bytes()))
+}
+
+func readJSONFile(t *testing.T, data []byte) string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	rc, err := zr.File[0].Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRead(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := map[string]string{
+		"__MACOSX/._a.md": "ignored",
+		".hidden.md":      "ignored",
+		"image.png":       "ignored",
+		"bad.json":        "{",
+		"big.md":          strings.Repeat("a", MaxFileSize+1),
+		"ok.markdown":     "hello",
+	}
+	for name, content := range files {
+		fw, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	res, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "bad.json", res[0].Entry.Name)
+	assert.Error(t, res[0].Err)
+	assert.Equal(t, "big.md", res[1].Entry.Name)
+	assert.Equal(t, ErrFileTooLarge, res[1].Err)
+	assert.Equal(t, Result{Entry: Entry{Name: "ok.markdown", Meta: Meta{Title: "ok"}, Content: "hello"}}, res[2])
+
+	_, err = Read([]byte("not a zip"))
+	assert.Error(t, err)
+}
+
+func TestRead_JSONSizeLimit(t *testing.T) {
+	// 整个 JSON 文件超过了单篇文章的上限，但是每一篇都没有超过
+	entries := []Entry{
+		{Meta: Meta{Title: "a"}, Content: strings.Repeat("a", MaxFileSize/2+1)},
+		{Meta: Meta{Title: "b"}, Content: strings.Repeat("b", MaxFileSize/2+1)},
+		{Meta: Meta{Title: "c"}, Content: strings.Repeat("c", MaxFileSize+1)},
+	}
+	var buf bytes.Buffer
+	w := NewJSONWriter(&buf)
+	for _, e := range entries {
+		require.NoError(t, w.Add(e))
+	}
+	require.NoError(t, w.Close())
+
+	res, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.NoError(t, res[0].Err)
+	assert.Equal(t, entries[0].Content, res[0].Entry.Content)
+	assert.NoError(t, res[1].Err)
+	assert.Equal(t, Result{Entry: Entry{Name: JSONFileName + "#2"}, Err: ErrFileTooLarge}, res[2])
+}
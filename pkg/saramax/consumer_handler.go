@@ -12,13 +12,11 @@ type Handler[T any] struct {
 }
 
 func (h Handler[T]) Setup(session sarama.ConsumerGroupSession) error {
-	//TODO implement me
-	panic("implement me")
+	return nil
 }
 
 func (h Handler[T]) Cleanup(session sarama.ConsumerGroupSession) error {
-	//TODO implement me
-	panic("implement me")
+	return nil
 }
 
 func (h Handler[T]) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
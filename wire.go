@@ -4,17 +4,23 @@ package main
 
 import (
 	"github.com/google/wire"
+	commentRepo "we_book/comment/repository"
+	commentDAO "we_book/comment/repository/dao"
+	commentSvc "we_book/comment/service"
+	events2 "we_book/events"
 	article "we_book/events/article"
 	"we_book/interactive/events"
 	repository2 "we_book/interactive/repository"
 	cache2 "we_book/interactive/repository/cache"
 	dao2 "we_book/interactive/repository/dao"
 	service2 "we_book/interactive/service"
+	"we_book/internal/job"
+	"we_book/internal/migrator"
 	"we_book/internal/repository"
 	article2 "we_book/internal/repository/article"
 	"we_book/internal/repository/cache"
 	"we_book/internal/repository/dao"
-	article3 "we_book/internal/repository/dao/article"
+	"we_book/internal/search"
 	"we_book/internal/service"
 	"we_book/internal/web"
 	ijwt "we_book/internal/web/jwt"
@@ -34,23 +40,120 @@ var rankingServerProvider = wire.NewSet(
 	service.NewBatchRankingService,
 )
 
+var searchProvider = wire.NewSet(
+	search.NewMemoryIndex,
+	wire.Bind(new(search.Indexer), new(*search.MemoryIndex)),
+	wire.Bind(new(search.Searcher), new(*search.MemoryIndex)),
+	service.NewSearchService,
+	web.NewSearchHandler,
+	article.NewArticleIndexConsumer,
+)
+
+var transferProvider = wire.NewSet(
+	dao.NewGORMTransferTaskDAO,
+	repository.NewTransferTaskRepository,
+	ioc.InitArticleTransferService,
+	web.NewArticleTransferHandler,
+)
+
+var seriesProvider = wire.NewSet(
+	dao.NewGORMSeriesDAO,
+	repository.NewSeriesRepository,
+	service.NewSeriesService,
+	web.NewSeriesHandler,
+)
+
+var uploadProvider = wire.NewSet(
+	dao.NewGORMUploadDAO,
+	repository.NewUploadRepository,
+	ioc.InitUploadService,
+	ioc.InitUploadGCJob,
+	web.NewUploadHandler,
+)
+
+var collectionProvider = wire.NewSet(
+	dao2.NewGORMCollectionDAO,
+	repository2.NewCollectionRepository,
+	service2.NewCollectionService,
+	web.NewCollectionHandler,
+	web.NewUserInteractiveHandler,
+)
+
+var historyProvider = wire.NewSet(
+	dao2.NewGORMReadHistoryDAO,
+	repository2.NewReadHistoryRepository,
+	service2.NewReadHistoryService,
+	article.NewHistoryReadEventConsumer,
+	ioc.InitHistoryTrimJob,
+	web.NewHistoryHandler,
+)
+
+var reviewProvider = wire.NewSet(
+	dao.NewGORMReviewDAO,
+	repository.NewReviewRepository,
+	ioc.InitModerator,
+	web.NewReviewHandler,
+)
+
+var migratorProvider = wire.NewSet(
+	migrator.NewSaramaProducer,
+	ioc.InitArticleMigrator,
+	ioc.InitMigratorConsumer,
+	web.NewMigratorHandler,
+	ioc.InitAdminServer,
+)
+
+var commentProvider = wire.NewSet(
+	commentDAO.NewGORMCommentDAO,
+	commentRepo.NewCommentRepository,
+	commentSvc.NewCommentService,
+	ioc.InitCommentBizOwners,
+	web.NewCommentHandler,
+)
+
+var jobProvider = wire.NewSet(
+	dao.NewGORMJobDAO,
+	repository.NewPreemptCronJobRepository,
+	service.NewCronJobService,
+	job.NewPublishArticleExecutor,
+	job.NewArticleTransferExecutor,
+	ioc.InitArticleStatsBackfillJob,
+	ioc.InitLocalFuncExecutor,
+	ioc.InitScheduler,
+)
+
 func InitWebServer() *App {
 	wire.Build(
 		// 首先引入最基本的第三方依赖
 		ioc.InitDB,
 		ioc.InitRedis,
 		ioc.InitLogger,
+		ioc.InitMongoDB,
 
 		ioc.InitKafka,
 
 		interactiveSvcProvider,
 		rankingServerProvider,
 		ioc.InitRankingJob,
+		ioc.InitPurgeTrashJob,
+		ioc.InitUvSyncJob,
 		ioc.InitJobs,
 		ioc.InitRLockClient,
+		jobProvider,
+		searchProvider,
+		commentProvider,
+		transferProvider,
+		seriesProvider,
+		reviewProvider,
+		uploadProvider,
+		collectionProvider,
+		historyProvider,
+		migratorProvider,
 
 		ioc.NewSyncProducer,
 		ioc.NewConsumers,
+		ioc.InitOutboxDAO,
+		events2.NewOutboxRelay,
 
 		// consumer
 		events.NewInteractiveReadEventBatchConsumer,
@@ -58,18 +161,22 @@ func InitWebServer() *App {
 
 		// 初始化 DAO
 		dao.NewUserDAO,
-		article3.NewGORMArticleDAO,
+		ioc.InitArticleDAO,
+		dao.NewGORMCollaboratorDAO,
 
 		cache.NewUserCache,
 		cache.NewRedisCodeCache,
+		cache.NewRedisArticleCache,
 
 		repository.NewUserRepository,
 		repository.NewCodeRepository,
 		article2.NewArticleRepository,
+		repository.NewCollaboratorRepository,
 
 		service.NewUserService,
 		service.NewCodeService,
 		service.NewArticleService,
+		ioc.InitFeedService,
 
 		// 基于内存实现存储
 		ioc.InitSMSService,
@@ -78,6 +185,7 @@ func InitWebServer() *App {
 		web.NewUserHandler,
 		web.NewArticleHandler,
 		web.NewOAuth2WeChatHandler,
+		web.NewFeedHandler,
 
 		ijwt.NewRedisJWTHandler,
 
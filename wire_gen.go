@@ -8,17 +8,23 @@ package main
 
 import (
 	"github.com/google/wire"
+	repository3 "we_book/comment/repository"
+	dao3 "we_book/comment/repository/dao"
+	service3 "we_book/comment/service"
+	events2 "we_book/events"
 	article3 "we_book/events/article"
 	"we_book/interactive/events"
 	repository2 "we_book/interactive/repository"
 	cache2 "we_book/interactive/repository/cache"
 	dao2 "we_book/interactive/repository/dao"
 	service2 "we_book/interactive/service"
+	"we_book/internal/job"
+	"we_book/internal/migrator"
 	"we_book/internal/repository"
 	article2 "we_book/internal/repository/article"
 	"we_book/internal/repository/cache"
 	"we_book/internal/repository/dao"
-	"we_book/internal/repository/dao/article"
+	"we_book/internal/search"
 	"we_book/internal/service"
 	"we_book/internal/web"
 	"we_book/internal/web/jwt"
@@ -42,31 +48,93 @@ func InitWebServer() *App {
 	smsService := ioc.InitSMSService()
 	codeService := service.NewCodeService(codeRepository, smsService)
 	userHandler := web.NewUserHandler(userService, codeService, handler)
-	articleDAO := article.NewGORMArticleDAO(db)
-	articleRepository := article2.NewArticleRepository(articleDAO)
 	client := ioc.InitKafka()
 	syncProducer := ioc.NewSyncProducer(client)
+	migratorProducer := migrator.NewSaramaProducer(syncProducer)
+	database := ioc.InitMongoDB()
+	migratorScheduler := ioc.InitArticleMigrator(db, database, migratorProducer, v1)
+	articleDAO := ioc.InitArticleDAO(db, database, migratorScheduler)
+	articleCache := cache.NewRedisArticleCache(cmdable)
+	uploadDAO := dao.NewGORMUploadDAO(db)
+	uploadRepository := repository.NewUploadRepository(uploadDAO)
+	collaboratorDAO := dao.NewGORMCollaboratorDAO(db)
+	collaboratorRepository := repository.NewCollaboratorRepository(collaboratorDAO, userRepository, v1)
+	articleRepository := article2.NewArticleRepository(articleDAO, articleCache, userRepository, uploadRepository, collaboratorRepository, v1)
+	jobDAO := dao.NewGORMJobDAO(db)
+	jobRepository := repository.NewPreemptCronJobRepository(jobDAO)
 	producer := article3.NewKafkaProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, v1, producer)
-	articleHandler := web.NewArticleHandler(articleService, v1)
-	wechatService := ioc.InitWechatService(v1)
-	wechatHandlerConfig := ioc.NewWechatHandlerConfig()
-	oAuth2WeChatHandler := web.NewOAuth2WeChatHandler(wechatService, userService, handler, wechatHandlerConfig)
-	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WeChatHandler)
+	reviewDAO := dao.NewGORMReviewDAO(db)
+	reviewRepository := repository.NewReviewRepository(reviewDAO)
+	moderator := ioc.InitModerator()
+	articleService := service.NewArticleService(articleRepository, jobRepository, collaboratorRepository, reviewRepository, moderator, v1, producer)
 	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
 	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
 	interactiveRepository := repository2.NewCacheInteractiveRepository(interactiveCache, interactiveDAO, v1)
-	interactiveReadEventBatchConsumer := events.NewInteractiveReadEventBatchConsumer(client, interactiveRepository, v1)
-	v2 := ioc.NewConsumers(interactiveReadEventBatchConsumer)
 	interactiveService := service2.NewInteractiveService(interactiveRepository, v1)
-	rankingService := service.NewBatchRankingService(articleService, interactiveService)
+	seriesDAO := dao.NewGORMSeriesDAO(db)
+	seriesRepository := repository.NewSeriesRepository(seriesDAO)
+	seriesService := service.NewSeriesService(seriesRepository, articleRepository, v1)
+	articleHandler := web.NewArticleHandler(articleService, seriesService, interactiveService, v1)
+	memoryIndex := search.NewMemoryIndex()
+	searchService := service.NewSearchService(memoryIndex)
+	searchHandler := web.NewSearchHandler(searchService, v1)
+	commentDAO := dao3.NewGORMCommentDAO(db)
+	commentRepository := repository3.NewCommentRepository(commentDAO, v1)
+	bizOwners := ioc.InitCommentBizOwners(articleRepository)
+	commentService := service3.NewCommentService(commentRepository, interactiveService, bizOwners, v1)
+	commentHandler := web.NewCommentHandler(commentService, v1)
+	feedService := ioc.InitFeedService(articleRepository, userRepository, v1)
+	feedHandler := web.NewFeedHandler(feedService, v1)
+	transferTaskDAO := dao.NewGORMTransferTaskDAO(db)
+	transferTaskRepository := repository.NewTransferTaskRepository(transferTaskDAO)
+	articleTransferService := ioc.InitArticleTransferService(articleService, transferTaskRepository, jobRepository, v1)
+	articleTransferHandler := web.NewArticleTransferHandler(articleTransferService, v1)
+	seriesHandler := web.NewSeriesHandler(seriesService, v1)
+	uploadService := ioc.InitUploadService(uploadRepository, v1)
+	uploadHandler := web.NewUploadHandler(uploadService, v1)
+	collectionDAO := dao2.NewGORMCollectionDAO(db)
+	collectionRepository := repository2.NewCollectionRepository(collectionDAO, interactiveDAO, interactiveCache, v1)
+	collectionService := service2.NewCollectionService(collectionRepository, v1)
+	collectionHandler := web.NewCollectionHandler(collectionService, interactiveService, articleService, v1)
+	userInteractiveHandler := web.NewUserInteractiveHandler(interactiveService, articleService, v1)
+	readHistoryDAO := dao2.NewGORMReadHistoryDAO(db)
+	readHistoryRepository := repository2.NewReadHistoryRepository(readHistoryDAO)
+	readHistoryService := service2.NewReadHistoryService(readHistoryRepository, v1)
+	historyHandler := web.NewHistoryHandler(readHistoryService, articleService, v1)
+	wechatService := ioc.InitWechatService(v1)
+	wechatHandlerConfig := ioc.NewWechatHandlerConfig()
+	oAuth2WeChatHandler := web.NewOAuth2WeChatHandler(wechatService, userService, handler, wechatHandlerConfig)
+	engine := ioc.InitWebServer(v, userHandler, articleHandler, searchHandler, commentHandler, feedHandler, articleTransferHandler, seriesHandler, uploadHandler, collectionHandler, userInteractiveHandler, historyHandler, oAuth2WeChatHandler)
+	interactiveReadEventBatchConsumer := events.NewInteractiveReadEventBatchConsumer(client, interactiveRepository, v1)
+	articleIndexConsumer := article3.NewArticleIndexConsumer(client, memoryIndex, articleRepository, v1)
+	historyReadEventConsumer := article3.NewHistoryReadEventConsumer(client, readHistoryService, v1)
+	outboxDAO := ioc.InitOutboxDAO(db, database)
 	rlockClient := ioc.InitRLockClient(cmdable)
+	outboxRelay := events2.NewOutboxRelay(outboxDAO, syncProducer, rlockClient, v1)
+	consumer := ioc.InitMigratorConsumer(client, migratorScheduler, v1)
+	v2 := ioc.NewConsumers(interactiveReadEventBatchConsumer, articleIndexConsumer, historyReadEventConsumer, outboxRelay, consumer)
+	rankingService := service.NewBatchRankingService(articleService, interactiveService)
 	rankingJob := ioc.InitRankingJob(rankingService, rlockClient, v1)
-	cron := ioc.InitJobs(v1, rankingJob)
+	purgeTrashJob := ioc.InitPurgeTrashJob(articleService, interactiveService, v1)
+	uploadGCJob := ioc.InitUploadGCJob(uploadService, v1)
+	historyTrimJob := ioc.InitHistoryTrimJob(readHistoryService, v1)
+	uvSyncJob := ioc.InitUvSyncJob(interactiveService, v1)
+	cron := ioc.InitJobs(v1, rankingJob, purgeTrashJob, uploadGCJob, historyTrimJob, uvSyncJob)
+	articleStatsBackfillJob := ioc.InitArticleStatsBackfillJob(articleService, v1)
+	localFuncExecutor := ioc.InitLocalFuncExecutor(rankingService, articleStatsBackfillJob)
+	publishArticleExecutor := job.NewPublishArticleExecutor(articleService, v1)
+	jobService := service.NewCronJobService(jobRepository, v1)
+	articleTransferExecutor := job.NewArticleTransferExecutor(articleTransferService)
+	scheduler := ioc.InitScheduler(v1, localFuncExecutor, publishArticleExecutor, articleTransferExecutor, articleStatsBackfillJob, jobService)
+	migratorHandler := web.NewMigratorHandler(migratorScheduler, v1)
+	reviewHandler := web.NewReviewHandler(articleService, v1)
+	adminServer := ioc.InitAdminServer(handler, migratorHandler, reviewHandler)
 	app := &App{
-		web:      engine,
-		consumer: v2,
-		corn:     cron,
+		web:       engine,
+		consumer:  v2,
+		corn:      cron,
+		scheduler: scheduler,
+		admin:     adminServer,
 	}
 	return app
 }
@@ -76,3 +144,23 @@ func InitWebServer() *App {
 var interactiveSvcProvider = wire.NewSet(service2.NewInteractiveService, repository2.NewCacheInteractiveRepository, dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache)
 
 var rankingServerProvider = wire.NewSet(repository.NewRankingRepository, cache.NewRankingRedisCache, service.NewBatchRankingService)
+
+var jobProvider = wire.NewSet(dao.NewGORMJobDAO, repository.NewPreemptCronJobRepository, service.NewCronJobService, job.NewPublishArticleExecutor, job.NewArticleTransferExecutor, ioc.InitArticleStatsBackfillJob, ioc.InitLocalFuncExecutor, ioc.InitScheduler)
+
+var searchProvider = wire.NewSet(search.NewMemoryIndex, wire.Bind(new(search.Indexer), new(*search.MemoryIndex)), wire.Bind(new(search.Searcher), new(*search.MemoryIndex)), service.NewSearchService, web.NewSearchHandler, article3.NewArticleIndexConsumer)
+
+var commentProvider = wire.NewSet(dao3.NewGORMCommentDAO, repository3.NewCommentRepository, service3.NewCommentService, ioc.InitCommentBizOwners, web.NewCommentHandler)
+
+var transferProvider = wire.NewSet(dao.NewGORMTransferTaskDAO, repository.NewTransferTaskRepository, ioc.InitArticleTransferService, web.NewArticleTransferHandler)
+
+var seriesProvider = wire.NewSet(dao.NewGORMSeriesDAO, repository.NewSeriesRepository, service.NewSeriesService, web.NewSeriesHandler)
+
+var uploadProvider = wire.NewSet(dao.NewGORMUploadDAO, repository.NewUploadRepository, ioc.InitUploadService, ioc.InitUploadGCJob, web.NewUploadHandler)
+
+var collectionProvider = wire.NewSet(dao2.NewGORMCollectionDAO, repository2.NewCollectionRepository, service2.NewCollectionService, web.NewCollectionHandler, web.NewUserInteractiveHandler)
+
+var historyProvider = wire.NewSet(dao2.NewGORMReadHistoryDAO, repository2.NewReadHistoryRepository, service2.NewReadHistoryService, article3.NewHistoryReadEventConsumer, ioc.InitHistoryTrimJob, web.NewHistoryHandler)
+
+var reviewProvider = wire.NewSet(dao.NewGORMReviewDAO, repository.NewReviewRepository, ioc.InitModerator, web.NewReviewHandler)
+
+var migratorProvider = wire.NewSet(migrator.NewSaramaProducer, ioc.InitArticleMigrator, ioc.InitMigratorConsumer, web.NewMigratorHandler, ioc.InitAdminServer)